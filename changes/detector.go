@@ -0,0 +1,324 @@
+// Package changes detects record-level changes on top of any ReadConnector.
+//
+// Many providers filter incremental reads coarsely (by day, or client-side), and some cannot
+// filter by update time at all, which forces whole objects to be re-ingested on every sync.
+// The Detector fingerprints every returned row with a content hash kept in a pluggable Store
+// and emits only rows that are new or changed, annotated with the changed field names.
+// When an object is read in full, records whose ids were not seen anymore are reported as deleted.
+//
+// Usage:
+//
+//	detector, err := changes.NewDetector(conn, changes.NewMemoryStore(), connectionID)
+//	result, err := detector.ReadChanges(ctx, common.ReadParams{
+//		ObjectName: "contacts",
+//		Fields:     connectors.Fields("id", "email", "name"),
+//	})
+//	for _, change := range result.Changes {
+//		fmt.Println(change.Kind, change.Id, change.ChangedFields)
+//	}
+//	err = detector.Acknowledge(ctx, result)
+package changes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// Kind classifies a detected change.
+type Kind string
+
+const (
+	KindCreate Kind = "create"
+	KindUpdate Kind = "update"
+	KindDelete Kind = "delete"
+)
+
+// Change is a row which is new, changed or gone since it was last read.
+type Change struct {
+	Kind Kind
+	// Id is the record id, same as Row.Id.
+	Id string // nolint:revive
+	// ChangedFields lists the names of fields whose values differ from the previous read, sorted.
+	// For created records all returned fields are listed, for deleted records it is empty.
+	ChangedFields []string
+	// Row is the record as returned by the connector. Deleted records only have Id set.
+	Row common.ReadResultRow
+}
+
+// Result is a page of detected changes.
+type Result struct {
+	Changes []Change
+	// Unidentified holds rows returned without an id, which cannot be compared with earlier reads.
+	Unidentified []common.ReadResultRow
+	// NextPage and Done are passed through from the underlying read.
+	NextPage common.NextPageToken
+	Done     bool
+
+	// key, snapshots and deleted are persisted once the result is acknowledged.
+	key       string
+	snapshots map[string]Snapshot
+	deleted   []string
+}
+
+// Detector emits new, changed and deleted records of a ReadConnector.
+//
+// Snapshots are stored only once the caller acknowledges the result, therefore changes
+// of a page which the caller failed to process are reported again by the next read.
+// Deletions are only inferred from full scans: reads without Since, Until, Filter or BuilderFilter
+// which are paginated from the first page until Done. Scan progress is kept in memory.
+type Detector struct {
+	reader connectors.ReadConnector
+	store  Store
+	// namespace separates snapshots of different connections sharing the store.
+	namespace string
+
+	mutex sync.Mutex
+	// scans holds ids seen so far by the full scan in progress, per store key.
+	scans map[string]datautils.StringSet
+}
+
+// NewDetector creates a change detector for the connector, keeping snapshots in the store.
+// The namespace identifies the connection, usually by its id, so that one store can be shared
+// by connections to different accounts of the same provider.
+func NewDetector(conn connectors.Connector, store Store, namespace string) (*Detector, error) {
+	reader, ok := conn.(connectors.ReadConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support read", common.ErrNotImplemented, conn.Provider())
+	}
+
+	if store == nil {
+		return nil, ErrMissingStore
+	}
+
+	if namespace == "" {
+		return nil, ErrMissingNamespace
+	}
+
+	return &Detector{
+		reader:    reader,
+		store:     store,
+		namespace: namespace,
+		scans:     make(map[string]datautils.StringSet),
+	}, nil
+}
+
+// ReadChanges reads a page of records and returns the rows which changed since they were last read.
+// The result must be passed to Acknowledge once the changes are processed.
+func (d *Detector) ReadChanges(ctx context.Context, params common.ReadParams) (*Result, error) {
+	result, err := d.reader.Read(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	key := d.storeKey(params.ObjectName)
+	fullScan := isFullScan(params)
+
+	ids := make([]string, 0, len(result.Data))
+	for _, row := range result.Data {
+		ids = append(ids, row.Id)
+	}
+
+	previous, err := d.store.Get(ctx, key, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, snapshots, unidentified := diffRows(result.Data, previous)
+
+	var deleted []string
+
+	if fullScan {
+		deleted, err = d.trackScan(ctx, key, params.NextPage == "", ids, result.Done)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, deletions(deleted)...)
+	}
+
+	return &Result{
+		Changes:      changes,
+		Unidentified: unidentified,
+		NextPage:     result.NextPage,
+		Done:         result.Done,
+		key:          key,
+		snapshots:    snapshots,
+		deleted:      deleted,
+	}, nil
+}
+
+// Acknowledge stores the snapshots of the result, so that its changes are not reported again.
+// Records reported as deleted are forgotten.
+func (d *Detector) Acknowledge(ctx context.Context, result *Result) error {
+	if result == nil || result.key == "" {
+		return ErrUnknownResult
+	}
+
+	if err := d.store.Put(ctx, result.key, result.snapshots); err != nil {
+		return err
+	}
+
+	if len(result.deleted) == 0 {
+		return nil
+	}
+
+	return d.store.Delete(ctx, result.key, result.deleted)
+}
+
+// diffRows compares rows against previous snapshots and returns changes with the new snapshots.
+// Rows without an id are returned apart.
+func diffRows(
+	rows []common.ReadResultRow, previous map[string]Snapshot,
+) ([]Change, map[string]Snapshot, []common.ReadResultRow) {
+	changes := make([]Change, 0, len(rows))
+	snapshots := make(map[string]Snapshot, len(rows))
+
+	var unidentified []common.ReadResultRow
+
+	for _, row := range rows {
+		if row.Id == "" {
+			unidentified = append(unidentified, row)
+
+			continue
+		}
+
+		hash, fields := fieldHashes(row.Fields)
+		snapshots[row.Id] = Snapshot{Hash: hash, Fields: fields}
+
+		before, ok := previous[row.Id]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:          KindCreate,
+				Id:            row.Id,
+				ChangedFields: sortedKeys(fields),
+				Row:           row,
+			})
+
+			continue
+		}
+
+		if before.Hash == hash {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Kind:          KindUpdate,
+			Id:            row.Id,
+			ChangedFields: changedFields(before.Fields, fields),
+			Row:           row,
+		})
+	}
+
+	return changes, snapshots, unidentified
+}
+
+// trackScan records ids seen by a full scan, and when the scan is done
+// returns the sorted ids of stored records which were not seen.
+func (d *Detector) trackScan(
+	ctx context.Context, key string, firstPage bool, ids []string, done bool,
+) ([]string, error) {
+	d.mutex.Lock()
+
+	seen, ok := d.scans[key]
+	if firstPage || !ok {
+		// A scan resumed without its first page cannot prove deletions.
+		if !firstPage {
+			d.mutex.Unlock()
+
+			return nil, nil
+		}
+
+		seen = datautils.NewStringSet()
+		d.scans[key] = seen
+	}
+
+	seen.Add(ids)
+
+	if done {
+		delete(d.scans, key)
+	}
+
+	d.mutex.Unlock()
+
+	if !done {
+		return nil, nil
+	}
+
+	stored, err := d.store.IDs(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	gone := make([]string, 0)
+
+	for _, id := range stored {
+		if !seen.Has(id) {
+			gone = append(gone, id)
+		}
+	}
+
+	slices.Sort(gone)
+
+	return gone, nil
+}
+
+func deletions(ids []string) []Change {
+	changes := make([]Change, len(ids))
+	for index, id := range ids {
+		changes[index] = Change{
+			Kind: KindDelete,
+			Id:   id,
+			Row:  common.ReadResultRow{Id: id},
+		}
+	}
+
+	return changes
+}
+
+func (d *Detector) storeKey(objectName string) string {
+	return d.namespace + "/" + string(d.reader.Provider()) + "/" + strings.ToLower(objectName)
+}
+
+func isFullScan(params common.ReadParams) bool {
+	return params.Since.IsZero() && params.Until.IsZero() &&
+		params.Filter == "" && params.BuilderFilter == nil && !params.Deleted
+}
+
+func changedFields(before, after map[string]string) []string {
+	changed := datautils.NewStringSet()
+
+	for name, hash := range after {
+		if before[name] != hash {
+			changed.AddOne(name)
+		}
+	}
+
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed.AddOne(name)
+		}
+	}
+
+	result := changed.List()
+	slices.Sort(result)
+
+	return result
+}
+
+func sortedKeys(fields map[string]string) []string {
+	result := make([]string, 0, len(fields))
+	for name := range fields {
+		result = append(result, name)
+	}
+
+	slices.Sort(result)
+
+	return result
+}
@@ -0,0 +1,203 @@
+// nolint
+package changes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedSource serves the current records two per page.
+type pagedSource struct {
+	records []common.ReadResultRow
+}
+
+func (s *pagedSource) read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	start := 0
+	if params.NextPage == "2" {
+		start = 2
+	}
+
+	end := min(start+2, len(s.records))
+	done := end == len(s.records)
+
+	result := &common.ReadResult{Data: s.records[start:end], Rows: int64(end - start), Done: done}
+	if !done {
+		result.NextPage = "2"
+	}
+
+	return result, nil
+}
+
+func row(id string, fields map[string]any) common.ReadResultRow {
+	return common.ReadResultRow{Id: id, Fields: fields}
+}
+
+func readAll(t *testing.T, detector *Detector) []Change {
+	t.Helper()
+
+	var (
+		changes []Change
+		page    common.NextPageToken
+	)
+
+	for {
+		result, err := detector.ReadChanges(t.Context(), common.ReadParams{
+			ObjectName: "contacts",
+			Fields:     connectors.Fields("name", "score"),
+			NextPage:   page,
+		})
+		require.NoError(t, err)
+		require.NoError(t, detector.Acknowledge(t.Context(), result))
+
+		changes = append(changes, result.Changes...)
+
+		if result.Done {
+			return changes
+		}
+
+		page = result.NextPage
+	}
+}
+
+func TestReadChanges(t *testing.T) {
+	t.Parallel()
+
+	source := &pagedSource{records: []common.ReadResultRow{
+		row("1", map[string]any{"name": "Ann", "score": float64(1)}),
+		row("2", map[string]any{"name": "Bob", "score": float64(2)}),
+		row("3", map[string]any{"name": "Cid", "score": nil}),
+	}}
+
+	conn, err := mock.NewConnector(mock.WithRead(source.read))
+	require.NoError(t, err)
+
+	detector, err := NewDetector(conn, NewMemoryStore(), "connection-1")
+	require.NoError(t, err)
+
+	changes := readAll(t, detector)
+	require.Len(t, changes, 3)
+
+	for _, change := range changes {
+		assert.Equal(t, KindCreate, change.Kind)
+		assert.Equal(t, []string{"name", "score"}, change.ChangedFields)
+	}
+
+	assert.Empty(t, readAll(t, detector), "unchanged records are not emitted")
+
+	source.records = []common.ReadResultRow{
+		row("1", map[string]any{"name": "Ann", "score": int64(1)}), // same value, different Go type
+		row("3", map[string]any{"name": "Cid", "score": float64(5)}),
+		row("4", map[string]any{"name": "Dan", "score": nil}),
+	}
+
+	changes = readAll(t, detector)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, KindUpdate, changes[0].Kind)
+	assert.Equal(t, "3", changes[0].Id)
+	assert.Equal(t, []string{"score"}, changes[0].ChangedFields)
+
+	assert.Equal(t, KindCreate, changes[1].Kind)
+	assert.Equal(t, "4", changes[1].Id)
+
+	assert.Equal(t, KindDelete, changes[2].Kind)
+	assert.Equal(t, "2", changes[2].Id)
+}
+
+func TestIncrementalReadDoesNotInferDeletes(t *testing.T) {
+	t.Parallel()
+
+	records := []common.ReadResultRow{row("1", map[string]any{"name": "Ann"})}
+
+	conn, err := mock.NewConnector(mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+		return &common.ReadResult{Data: records, Rows: int64(len(records)), Done: true}, nil
+	}))
+	require.NoError(t, err)
+
+	store := NewMemoryStore()
+	require.NoError(t, store.Put(t.Context(), "connection-1/mock/contacts", map[string]Snapshot{"9": {Hash: "x"}}))
+
+	detector, err := NewDetector(conn, store, "connection-1")
+	require.NoError(t, err)
+
+	result, err := detector.ReadChanges(t.Context(), common.ReadParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("name"),
+		Since:      time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Changes, 1)
+	assert.Equal(t, KindCreate, result.Changes[0].Kind)
+	require.NoError(t, detector.Acknowledge(t.Context(), result))
+
+	ids, err := store.IDs(t.Context(), "connection-1/mock/contacts")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "9"}, ids)
+}
+
+func TestConnectionsSharingStore(t *testing.T) {
+	t.Parallel()
+
+	source := &pagedSource{records: []common.ReadResultRow{row("1", map[string]any{"name": "Ann"})}}
+
+	conn, err := mock.NewConnector(mock.WithRead(source.read))
+	require.NoError(t, err)
+
+	store := NewMemoryStore()
+
+	first, err := NewDetector(conn, store, "connection-1")
+	require.NoError(t, err)
+
+	second, err := NewDetector(conn, store, "connection-2")
+	require.NoError(t, err)
+
+	assert.Len(t, readAll(t, first), 1)
+	assert.Len(t, readAll(t, second), 1, "snapshots of another connection are not shared")
+
+	_, err = NewDetector(conn, store, "")
+	require.ErrorIs(t, err, ErrMissingNamespace)
+}
+
+func TestUnacknowledgedChangesAreReportedAgain(t *testing.T) {
+	t.Parallel()
+
+	records := []common.ReadResultRow{
+		row("1", map[string]any{"name": "Ann"}),
+		row("", map[string]any{"name": "Nobody"}),
+	}
+
+	conn, err := mock.NewConnector(mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+		return &common.ReadResult{Data: records, Rows: int64(len(records)), Done: true}, nil
+	}))
+	require.NoError(t, err)
+
+	detector, err := NewDetector(conn, NewMemoryStore(), "connection-1")
+	require.NoError(t, err)
+
+	params := common.ReadParams{ObjectName: "contacts", Fields: connectors.Fields("name")}
+
+	result, err := detector.ReadChanges(t.Context(), params)
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1)
+	assert.Equal(t, []common.ReadResultRow{records[1]}, result.Unidentified, "rows without id are returned apart")
+
+	result, err = detector.ReadChanges(t.Context(), params)
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1, "changes are reported until acknowledged")
+
+	require.NoError(t, detector.Acknowledge(t.Context(), result))
+
+	result, err = detector.ReadChanges(t.Context(), params)
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+
+	require.ErrorIs(t, detector.Acknowledge(t.Context(), &Result{}), ErrUnknownResult)
+}
@@ -0,0 +1,12 @@
+package changes
+
+import "errors"
+
+var (
+	// ErrMissingStore is returned when a Detector is created without a snapshot store.
+	ErrMissingStore = errors.New("change detection requires a snapshot store")
+	// ErrMissingNamespace is returned when a Detector is created without a namespace.
+	ErrMissingNamespace = errors.New("change detection requires a namespace")
+	// ErrUnknownResult is returned when acknowledging a result which was not read by a Detector.
+	ErrUnknownResult = errors.New("result was not read by the change detector")
+)
@@ -0,0 +1,95 @@
+package changes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+
+	"github.com/amp-labs/connectors/internal/hashing"
+)
+
+// Type markers keep values of different JSON types apart, e.g. "1" and 1 or nil and "".
+const (
+	markerNil int64 = iota
+	markerString
+	markerNumber
+	markerBool
+	markerList
+	markerObject
+	markerOther
+)
+
+// fieldHashes returns the content hash of every field, together with the hash of the whole record.
+// Hashes are deterministic: object keys are sorted, numbers use a canonical binary representation
+// and consecutive values are separated by the hashing builder.
+func fieldHashes(fields map[string]any) (string, map[string]string) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	recordHash := sha256.New()
+	record := hashing.NewBuilder(recordHash)
+	result := make(map[string]string, len(fields))
+
+	for _, name := range names {
+		fieldHash := sha256.New()
+		hashValue(hashing.NewBuilder(fieldHash), fields[name])
+		result[name] = sum(fieldHash)
+
+		record.String(name).String(result[name])
+	}
+
+	return sum(recordHash), result
+}
+
+// hashValue writes a JSON compatible value, numbers of any type holding the same value hash identically.
+func hashValue(builder *hashing.Builder, value any) { // nolint:cyclop
+	switch typed := value.(type) {
+	case nil:
+		builder.Int64(markerNil)
+	case string:
+		builder.Int64(markerString).String(typed)
+	case bool:
+		builder.Int64(markerBool).Bool(typed)
+	case float64:
+		builder.Int64(markerNumber).Float64(typed)
+	case float32:
+		builder.Int64(markerNumber).Float64(float64(typed))
+	case int:
+		builder.Int64(markerNumber).Float64(float64(typed))
+	case int64:
+		builder.Int64(markerNumber).Float64(float64(typed))
+	case []any:
+		builder.Int64(markerList).Int64(int64(len(typed)))
+
+		for _, item := range typed {
+			hashValue(builder, item)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		builder.Int64(markerObject).Int64(int64(len(keys)))
+
+		for _, key := range keys {
+			builder.String(key)
+			hashValue(builder, typed[key])
+		}
+	default:
+		builder.Int64(markerOther).String(fmt.Sprintf("%#v", typed))
+	}
+}
+
+// sum encodes the hash, sha256 never fails to write therefore builder errors are not checked.
+func sum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package changes
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Snapshot is the stored fingerprint of a record as last seen by the Detector.
+type Snapshot struct {
+	// Hash is the content hash of the whole record.
+	Hash string `json:"hash"`
+	// Fields holds the content hash of every field, used to report changed field names.
+	Fields map[string]string `json:"fields"`
+}
+
+// Store persists record snapshots between reads. Implementations must be safe for concurrent use.
+// Snapshots are partitioned by a key which the Detector derives from its namespace, the provider and object name.
+type Store interface {
+	// Get returns stored snapshots for the given record ids. Unknown ids are omitted.
+	Get(ctx context.Context, key string, ids []string) (map[string]Snapshot, error)
+	// Put creates or replaces snapshots.
+	Put(ctx context.Context, key string, snapshots map[string]Snapshot) error
+	// Delete removes snapshots of the given record ids.
+	Delete(ctx context.Context, key string, ids []string) error
+	// IDs lists all record ids with a stored snapshot.
+	IDs(ctx context.Context, key string) ([]string, error)
+}
+
+// MemoryStore is an in-process Store, useful for tests and short-lived syncs.
+type MemoryStore struct {
+	mutex     sync.RWMutex
+	snapshots map[string]map[string]Snapshot
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		snapshots: make(map[string]map[string]Snapshot),
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string, ids []string) (map[string]Snapshot, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make(map[string]Snapshot, len(ids))
+
+	for _, id := range ids {
+		if snapshot, ok := s.snapshots[key][id]; ok {
+			result[id] = snapshot
+		}
+	}
+
+	return result, nil
+}
+
+func (s *MemoryStore) Put(ctx context.Context, key string, snapshots map[string]Snapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.snapshots[key] == nil {
+		s.snapshots[key] = make(map[string]Snapshot, len(snapshots))
+	}
+
+	maps.Copy(s.snapshots[key], snapshots)
+
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string, ids []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, id := range ids {
+		delete(s.snapshots[key], id)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) IDs(ctx context.Context, key string) ([]string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return slices.Collect(maps.Keys(s.snapshots[key])), nil
+}
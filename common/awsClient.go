@@ -15,6 +15,8 @@ import (
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 type ctxKey string
@@ -22,13 +24,33 @@ type ctxKey string
 const AWSServiceContextKey ctxKey = "AWSService"
 
 // ErrRequestAWSMissingService is returned when deep connector implementation doesn't attach
-// AWS service name into the context, therefore the request cannot be constructed for sending.
+// AWS service name into the context and the client has no default service,
+// therefore the request cannot be constructed for sending.
 var ErrRequestAWSMissingService = errors.New("AWS request is missing Service name, supplied via context")
 
 type AWSClient struct {
-	client *http.Client
-	cfg    aws.Config
-	region string
+	client  *http.Client
+	cfg     aws.Config
+	region  string
+	service string
+}
+
+// AWSSigV4Params are the credentials and signing scope of NewAWSSigV4Client.
+type AWSSigV4Params struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials, empty for permanent ones.
+	SessionToken string
+
+	Region string
+	// Service is the signing name used when the request context has no AWSServiceContextKey.
+	Service string
+
+	// RoleARN, when set, is assumed via STS using the keys above.
+	// Temporary credentials are cached and renewed before they expire.
+	RoleARN         string
+	ExternalID      string
+	RoleSessionName string
 }
 
 // NewAWSClient constructs an AWSClient using Access Key ID and Secret.
@@ -63,12 +85,63 @@ func NewAWSClient(ctx context.Context, client *http.Client,
 	}, nil
 }
 
+// NewAWSSigV4Client constructs an AWSClient which signs every request for the given service and region.
+// The service can still be overridden per request via context key AWSServiceContextKey.
+func NewAWSSigV4Client(ctx context.Context, client *http.Client,
+	params AWSSigV4Params,
+) (AuthenticatedHTTPClient, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(params.Region),
+		config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(
+				params.AccessKeyID, params.SecretAccessKey, params.SessionToken),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.RoleARN) != 0 {
+		stsClient := sts.NewFromConfig(cfg, func(opts *sts.Options) {
+			opts.HTTPClient = client
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(stsClient, params.RoleARN,
+			func(opts *stscreds.AssumeRoleOptions) {
+				if len(params.ExternalID) != 0 {
+					opts.ExternalID = aws.String(params.ExternalID)
+				}
+
+				if len(params.RoleSessionName) != 0 {
+					opts.RoleSessionName = params.RoleSessionName
+				}
+			})
+
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+	}
+
+	return AWSClient{
+		client:  client,
+		cfg:     cfg,
+		region:  params.Region,
+		service: params.Service,
+	}, nil
+}
+
 func (c AWSClient) Do(req *http.Request) (*http.Response, error) {
 	// Sign the request
 	ctx := req.Context()
 
 	awsService, ok := ctx.Value(AWSServiceContextKey).(string)
 	if !ok || len(awsService) == 0 {
+		awsService = c.service
+	}
+
+	if len(awsService) == 0 {
 		return nil, ErrRequestAWSMissingService
 	}
 
@@ -0,0 +1,88 @@
+package common
+
+import (
+	"errors"
+	"net"
+)
+
+// ErrCredentialCheckNotConfigured is returned when a provider has no way to verify credentials:
+// neither an AuthHealthCheck in the catalog nor an authentication metadata endpoint.
+var ErrCredentialCheckNotConfigured = errors.New("credential health check is not configured")
+
+// CredentialStatus is the outcome of a credential health check.
+type CredentialStatus string
+
+const (
+	// CredentialStatusHealthy means the credentials were accepted by the provider.
+	CredentialStatusHealthy CredentialStatus = "healthy"
+	// CredentialStatusAuthInvalidated means the credentials were rejected and the connection must be re-established.
+	CredentialStatusAuthInvalidated CredentialStatus = "auth_invalidated"
+	// CredentialStatusForbidden means the credentials are valid but lack access, e.g. scopes were revoked.
+	CredentialStatusForbidden CredentialStatus = "forbidden"
+	// CredentialStatusAPIDisabled means the API is turned off for the customer's instance.
+	CredentialStatusAPIDisabled CredentialStatus = "api_disabled"
+	// CredentialStatusProviderDown means the provider could not be reached or failed,
+	// which says nothing about the credentials. The check should be retried later.
+	CredentialStatusProviderDown CredentialStatus = "provider_down"
+	// CredentialStatusUnknown means the check failed for a reason that could not be classified.
+	CredentialStatusUnknown CredentialStatus = "unknown"
+	// CredentialStatusUnverified means the check succeeded without asking the provider to accept the credentials,
+	// for example when the connector derives its auth metadata locally.
+	CredentialStatusUnverified CredentialStatus = "unverified"
+)
+
+// CredentialCheckResult describes a single credential health check.
+type CredentialCheckResult struct {
+	Status CredentialStatus
+	// StatusCode is the HTTP status of the health check request, if one was made.
+	StatusCode int
+	// Err is the failure reported by the provider, nil when healthy.
+	Err error
+}
+
+// Healthy reports whether the credentials were accepted.
+func (r *CredentialCheckResult) Healthy() bool {
+	return r.Status == CredentialStatusHealthy
+}
+
+// NewCredentialCheckResult classifies the outcome of a credential check using ClassOf.
+func NewCredentialCheckResult(statusCode int, err error) *CredentialCheckResult {
+	return &CredentialCheckResult{
+		Status:     CredentialStatusOf(err),
+		StatusCode: statusCode,
+		Err:        err,
+	}
+}
+
+// CredentialStatusOf maps an error returned by a credential check onto a CredentialStatus.
+func CredentialStatusOf(err error) CredentialStatus {
+	if err == nil {
+		return CredentialStatusHealthy
+	}
+
+	class := ClassOf(err)
+	if class == ErrorClassBadRequest || class == ErrorClassUnknown {
+		// Typed HTTP errors hide messages such as "API has not been used in project" behind a generic 4xx class.
+		if byMessage := classOfMessage(err.Error()); byMessage != ErrorClassUnknown {
+			class = byMessage
+		}
+	}
+
+	switch class { // nolint:exhaustive
+	case ErrorClassAuthInvalidated:
+		return CredentialStatusAuthInvalidated
+	case ErrorClassForbidden:
+		return CredentialStatusForbidden
+	case ErrorClassAPIDisabled:
+		return CredentialStatusAPIDisabled
+	case ErrorClassProvider5xx, ErrorClassProviderMigration, ErrorClassRateLimited, ErrorClassRetryable:
+		return CredentialStatusProviderDown
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return CredentialStatusProviderDown
+	}
+
+	return CredentialStatusUnknown
+}
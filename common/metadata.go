@@ -99,12 +99,54 @@ type DeleteMetadataParams struct {
 	// Maps object names to field names to delete.
 	// For example: {"Account": ["My_Custom_Field__c", "Another_Field__c"]}
 	Fields map[ObjectName][]string `json:"fields"`
+	// Maps object names to groups of fields to delete, for providers which organize fields in groups.
+	// For example: {"contacts": ["integrationcreatedproperties"]}
+	FieldGroups map[ObjectName][]string `json:"fieldGroups,omitempty"`
+	// Custom objects to delete together with their definitions.
+	Objects []ObjectName `json:"objects,omitempty"`
+	// Force deletes metadata even when records or other metadata still depend on it.
+	// Otherwise, such deletions are refused and reported with their dependencies.
+	Force bool `json:"force,omitempty"`
 }
 
 // DeleteMetadataResult contains results for all deleted fields.
 type DeleteMetadataResult struct {
 	// Indicates if the delete operation was successful.
+	// It is false when any deletion was refused.
 	Success bool `json:"success"`
+	// Maps object name -> field name -> delete result.
+	// Populated by providers that report the outcome per field.
+	Fields map[string]map[string]MetadataDeleteResult `json:"fields,omitempty"`
+	// Maps object name -> field group name -> delete result.
+	FieldGroups map[string]map[string]MetadataDeleteResult `json:"fieldGroups,omitempty"`
+	// Maps object name -> delete result.
+	Objects map[string]MetadataDeleteResult `json:"objects,omitempty"`
+}
+
+// DeleteMetadataAction represents the action taken during a delete operation.
+type DeleteMetadataAction string
+
+const (
+	// DeleteMetadataActionDelete indicates that the object/field was deleted.
+	DeleteMetadataActionDelete DeleteMetadataAction = "delete"
+	// DeleteMetadataActionNone indicates that the object/field did not exist.
+	DeleteMetadataActionNone DeleteMetadataAction = "none"
+	// DeleteMetadataActionRefused indicates that the object/field was kept,
+	// because it cannot be deleted or something still depends on it.
+	DeleteMetadataActionRefused DeleteMetadataAction = "refused"
+)
+
+// MetadataDeleteResult is the result of a delete operation for a single object, field or field group.
+type MetadataDeleteResult struct {
+	// Name is the name of the deleted object, field or field group.
+	Name string `json:"name"`
+	// Action indicates what action was taken (delete, none, refused).
+	Action DeleteMetadataAction `json:"action"`
+	// Dependencies describe what depends on the deleted item.
+	// They are the reason of a refusal, or what was removed along with the item when deletion was forced.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Warnings contains any warnings that occurred during the delete operation.
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 var ErrFieldTypeUnknown = errors.New("unrecognized field type")
@@ -55,6 +55,8 @@ type oauthClientParams struct {
 	unauthorized   func(token *oauth2.Token, req *http.Request, rsp *http.Response) (*http.Response, error)
 	debug          func(req *http.Request, rsp *http.Response)
 	isUnauthorized func(rsp *http.Response) (bool, error)
+	tokenStore     TokenStore
+	tokenLeaser    TokenLeaser
 }
 
 // WithOAuthClient sets the http client to use for the connector. Its usage is optional.
@@ -126,6 +128,18 @@ func WithTokenSource(tokenSource oauth2.TokenSource) OAuthOption {
 	}
 }
 
+// WithTokenStore shares the token with other processes using the same connection.
+// Before refreshing, the stored token is re-read and adopted if a peer has already refreshed it,
+// which is required for providers rotating refresh tokens. Refreshed tokens are saved with
+// compare-and-swap. The optional leaser serializes refreshes across processes.
+// It requires WithOAuthConfig and takes precedence over WithTokenSource.
+func WithTokenStore(store TokenStore, leaser TokenLeaser) OAuthOption {
+	return func(params *oauthClientParams) {
+		params.tokenStore = store
+		params.tokenLeaser = leaser
+	}
+}
+
 // WithTokenHeaderAttachment configures the HTTP header used to attach the OAuth 2.0
 // access token to outbound API requests.
 func WithTokenHeaderAttachment(tokenHeader *TokenHeaderAttachment) OAuthOption {
@@ -140,6 +154,15 @@ func (p *oauthClientParams) prepare() (*oauthClientParams, error) {
 		p.client = http.DefaultClient
 	}
 
+	if p.tokenStore != nil {
+		if p.config == nil {
+			return nil, ErrMissingOauthConfig
+		}
+
+		// The token may be loaded from the store instead.
+		return p, nil
+	}
+
 	if p.tokenSource == nil {
 		if p.token == nil {
 			return nil, ErrMissingRefreshToken
@@ -306,6 +329,10 @@ func cloneResponse(r *http.Response) *http.Response {
 }
 
 func getTokenSource(ctx context.Context, params *oauthClientParams) oauth2.TokenSource { //nolint:ireturn
+	if params.tokenStore != nil {
+		return newCoordinatedTokenSource(params)
+	}
+
 	if params.tokenSource != nil {
 		return params.tokenSource
 	}
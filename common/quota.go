@@ -0,0 +1,195 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QuotaStatus is the state of one provider quota, as reported by the latest response.
+type QuotaStatus struct {
+	// Scope names the quota, providers may enforce several at once, e.g. "daily" and "secondly".
+	Scope string
+	// Remaining requests or cost points before the provider starts rejecting requests.
+	Remaining int64
+	// Limit is the size of the quota, zero when the provider doesn't report it.
+	Limit int64
+	// Reset is when the quota is replenished, zero when unknown.
+	Reset time.Time
+	// ObservedAt is when the response was received.
+	ObservedAt time.Time
+}
+
+// Exhausted reports whether no requests remain until the reset.
+func (s QuotaStatus) Exhausted() bool {
+	return s.Remaining <= 0
+}
+
+// QuotaExtractor parses quota state from a provider response. The response may be an error response.
+// Extractors reading the body must restore it, see PeekResponseBody.
+type QuotaExtractor func(rsp *http.Response, observedAt time.Time) []QuotaStatus
+
+// QuotaTracker keeps the latest quota status per scope and notifies subscribers about updates.
+// Connectors embed it to implement connectors.QuotaConnector.
+type QuotaTracker struct {
+	extractor QuotaExtractor
+
+	mutex     sync.RWMutex
+	snapshot  map[string]QuotaStatus
+	callbacks []func(QuotaStatus)
+}
+
+// NewQuotaTracker creates a tracker using the provider specific extractor.
+func NewQuotaTracker(extractor QuotaExtractor) *QuotaTracker {
+	return &QuotaTracker{
+		extractor: extractor,
+		snapshot:  make(map[string]QuotaStatus),
+	}
+}
+
+// Client wraps the authenticated client, so that quota is extracted from every response.
+func (t *QuotaTracker) Client(client AuthenticatedHTTPClient) AuthenticatedHTTPClient { // nolint:ireturn
+	return &quotaClient{client: client, tracker: t}
+}
+
+// Quota returns the latest status of every quota seen so far, ordered by scope.
+func (t *QuotaTracker) Quota() []QuotaStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	statuses := make([]QuotaStatus, 0, len(t.snapshot))
+	for _, status := range t.snapshot {
+		statuses = append(statuses, status)
+	}
+
+	slices.SortFunc(statuses, func(a, b QuotaStatus) int {
+		return strings.Compare(a.Scope, b.Scope)
+	})
+
+	return statuses
+}
+
+// OnQuota registers a callback invoked with every quota update.
+// Callbacks run synchronously after each response and must not block.
+func (t *QuotaTracker) OnQuota(callback func(QuotaStatus)) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.callbacks = append(t.callbacks, callback)
+}
+
+// Observe records the quota state of the response.
+func (t *QuotaTracker) Observe(rsp *http.Response) {
+	statuses := t.extractor(rsp, time.Now())
+	if len(statuses) == 0 {
+		return
+	}
+
+	t.mutex.Lock()
+
+	for _, status := range statuses {
+		t.snapshot[status.Scope] = status
+	}
+
+	callbacks := slices.Clone(t.callbacks)
+
+	t.mutex.Unlock()
+
+	for _, status := range statuses {
+		for _, callback := range callbacks {
+			callback(status)
+		}
+	}
+}
+
+type quotaClient struct {
+	client  AuthenticatedHTTPClient
+	tracker *QuotaTracker
+}
+
+func (c *quotaClient) Do(req *http.Request) (*http.Response, error) {
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return rsp, err
+	}
+
+	c.tracker.Observe(rsp)
+
+	return rsp, nil
+}
+
+func (c *quotaClient) CloseIdleConnections() {
+	c.client.CloseIdleConnections()
+}
+
+// PeekResponseBody reads the response body and replaces it, so that it can be read again.
+func PeekResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+
+	rsp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, err
+}
+
+// RateLimitHeaders names the headers of the widespread X-RateLimit-* convention.
+type RateLimitHeaders struct {
+	Limit     string
+	Remaining string
+	// Reset holds either Unix seconds or seconds until the reset, see ResetIsDelay.
+	Reset        string
+	ResetIsDelay bool
+}
+
+// QuotaFromHeaders builds a quota status from rate limit headers.
+// It returns false when the remaining count is absent.
+func QuotaFromHeaders(header http.Header, names RateLimitHeaders, scope string,
+	observedAt time.Time,
+) (QuotaStatus, bool) {
+	remaining, ok := headerInt(header, names.Remaining)
+	if !ok {
+		return QuotaStatus{}, false
+	}
+
+	status := QuotaStatus{
+		Scope:      scope,
+		Remaining:  remaining,
+		ObservedAt: observedAt,
+	}
+
+	if limit, ok := headerInt(header, names.Limit); ok {
+		status.Limit = limit
+	}
+
+	if reset, ok := headerInt(header, names.Reset); ok {
+		if names.ResetIsDelay {
+			status.Reset = observedAt.Add(time.Duration(reset) * time.Second)
+		} else {
+			status.Reset = time.Unix(reset, 0)
+		}
+	}
+
+	return status, true
+}
+
+func headerInt(header http.Header, name string) (int64, bool) {
+	if name == "" {
+		return 0, false
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(header.Get(name)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,89 @@
+// nolint
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaTracker(t *testing.T) {
+	t.Parallel()
+
+	remaining := []string{"99", "98"}
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", remaining[calls])
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		calls++
+	}))
+	t.Cleanup(server.Close)
+
+	tracker := NewQuotaTracker(func(rsp *http.Response, observedAt time.Time) []QuotaStatus {
+		status, ok := QuotaFromHeaders(rsp.Header, RateLimitHeaders{
+			Limit:     "X-RateLimit-Limit",
+			Remaining: "X-RateLimit-Remaining",
+			Reset:     "X-RateLimit-Reset",
+		}, "core", observedAt)
+		if !ok {
+			return nil
+		}
+
+		return []QuotaStatus{status}
+	})
+
+	var updates []QuotaStatus
+
+	tracker.OnQuota(func(status QuotaStatus) {
+		updates = append(updates, status)
+	})
+
+	client := &HTTPClient{Client: tracker.Client(http.DefaultClient)}
+
+	for range remaining {
+		_, _, err := client.Get(context.Background(), server.URL)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, updates, 2)
+	assert.Equal(t, int64(99), updates[0].Remaining)
+
+	quota := tracker.Quota()
+	require.Len(t, quota, 1)
+	assert.Equal(t, "core", quota[0].Scope)
+	assert.Equal(t, int64(98), quota[0].Remaining)
+	assert.Equal(t, int64(100), quota[0].Limit)
+	assert.Equal(t, time.Unix(1700000000, 0), quota[0].Reset)
+	assert.False(t, quota[0].ObservedAt.IsZero())
+}
+
+func TestQuotaFromHeadersResetDelay(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Remaining", "0")
+	header.Set("Ratelimit-Reset", "30")
+
+	observedAt := time.Now()
+
+	status, ok := QuotaFromHeaders(header, RateLimitHeaders{
+		Limit:        "X-Rate-Limit",
+		Remaining:    "X-Rate-Limit-Remaining",
+		Reset:        "Ratelimit-Reset",
+		ResetIsDelay: true,
+	}, "account", observedAt)
+	require.True(t, ok)
+	assert.True(t, status.Exhausted())
+	assert.Zero(t, status.Limit)
+	assert.Equal(t, observedAt.Add(30*time.Second), status.Reset)
+
+	_, ok = QuotaFromHeaders(http.Header{}, RateLimitHeaders{Remaining: "X-Rate-Limit-Remaining"}, "account", observedAt)
+	assert.False(t, ok)
+}
@@ -0,0 +1,252 @@
+package readhelper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// ErrValueNotNormalizable is returned when a provider value cannot be coerced into
+// the canonical form described by its common.ValueType.
+var ErrValueNotNormalizable = errors.New("value cannot be normalized")
+
+// multiSelectSeparator is the delimiter used by providers (e.g. Salesforce, HubSpot)
+// that return multi-select values as a single joined string.
+const multiSelectSeparator = ";"
+
+// Epoch values above this threshold are treated as milliseconds rather than seconds.
+// 1e11 seconds is in the year 5138, while 1e11 milliseconds is in March 1973.
+const epochMillisThreshold = 1e11
+
+// nolint:gochecknoglobals
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700", // Salesforce: 2024-01-02T15:04:05.000+0000
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// NormalizeFields returns a copy of fields where each value is coerced into the canonical
+// representation of its common.ValueType, as described by NormalizeValue.
+//
+// Field names are matched against metadata case-insensitively since ReadResultRow.Fields
+// keys are always lowercase. Fields without metadata, and values that cannot be coerced,
+// are copied over unchanged. The input map is never modified.
+func NormalizeFields(fields map[string]any, metadata common.FieldsMetadata) map[string]any {
+	if fields == nil {
+		return nil
+	}
+
+	types := make(map[string]common.ValueType, len(metadata))
+	for name, field := range metadata {
+		types[strings.ToLower(name)] = field.ValueType
+	}
+
+	result := make(map[string]any, len(fields))
+
+	for name, value := range fields {
+		result[name] = value
+
+		valueType, ok := types[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		normalized, err := NormalizeValue(valueType, value)
+		if err != nil {
+			continue
+		}
+
+		result[name] = normalized
+	}
+
+	return result
+}
+
+// NormalizeValue coerces a provider value into the canonical Go/JSON form of the given type:
+//
+//   - date, datetime: RFC3339 string in UTC. Accepts ISO-8601 strings in any zone,
+//     date-only strings, and epoch seconds or milliseconds as numbers or numeric strings.
+//   - int: int64.
+//   - float: float64.
+//   - boolean: bool. Accepts "true"/"false", "1"/"0", "yes"/"no" and numbers.
+//   - multiSelect: []string. Accepts ";" joined strings and lists.
+//
+// Nil values stay nil, and empty strings become nil for all non-string types.
+// Other value types are returned unchanged.
+func NormalizeValue(valueType common.ValueType, value any) (any, error) {
+	if value == nil {
+		return nil, nil // nolint:nilnil
+	}
+
+	if text, ok := value.(string); ok && strings.TrimSpace(text) == "" && isNonTextType(valueType) {
+		return nil, nil // nolint:nilnil
+	}
+
+	switch valueType {
+	case common.ValueTypeDate, common.ValueTypeDateTime:
+		return normalizeDateTime(value)
+	case common.ValueTypeInt:
+		return normalizeInt(value)
+	case common.ValueTypeFloat:
+		return normalizeFloat(value)
+	case common.ValueTypeBoolean:
+		return normalizeBool(value)
+	case common.ValueTypeMultiSelect:
+		return normalizeMultiSelect(value)
+	default:
+		return value, nil
+	}
+}
+
+func isNonTextType(valueType common.ValueType) bool {
+	switch valueType {
+	case common.ValueTypeDate, common.ValueTypeDateTime,
+		common.ValueTypeInt, common.ValueTypeFloat,
+		common.ValueTypeBoolean, common.ValueTypeMultiSelect:
+		return true
+	default:
+		return false
+	}
+}
+
+func normalizeDateTime(value any) (any, error) {
+	if text, ok := value.(string); ok {
+		text = strings.TrimSpace(text)
+
+		for _, layout := range dateTimeLayouts {
+			if timestamp, err := time.Parse(layout, text); err == nil {
+				return formatTimestamp(timestamp), nil
+			}
+		}
+	}
+
+	number, err := toFloat(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v is not a timestamp", ErrValueNotNormalizable, value)
+	}
+
+	if math.Abs(number) >= epochMillisThreshold {
+		return formatTimestamp(time.UnixMilli(int64(number))), nil
+	}
+
+	return formatTimestamp(time.Unix(int64(number), 0)), nil
+}
+
+func formatTimestamp(timestamp time.Time) string {
+	return timestamp.UTC().Format(time.RFC3339Nano)
+}
+
+func normalizeInt(value any) (any, error) {
+	switch number := value.(type) {
+	case int:
+		return int64(number), nil
+	case int32:
+		return int64(number), nil
+	case int64:
+		return number, nil
+	case string:
+		if integer, err := strconv.ParseInt(strings.TrimSpace(number), 10, 64); err == nil {
+			return integer, nil
+		}
+	}
+
+	number, err := toFloat(value)
+	if err != nil || number != math.Trunc(number) {
+		return nil, fmt.Errorf("%w: %v is not an integer", ErrValueNotNormalizable, value)
+	}
+
+	return int64(number), nil
+}
+
+func normalizeFloat(value any) (any, error) {
+	number, err := toFloat(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return number, nil
+}
+
+func normalizeBool(value any) (any, error) {
+	switch flag := value.(type) {
+	case bool:
+		return flag, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(flag)) {
+		case "true", "1", "yes", "y", "on":
+			return true, nil
+		case "false", "0", "no", "n", "off":
+			return false, nil
+		}
+	default:
+		if number, err := toFloat(value); err == nil {
+			return number != 0, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v is not a boolean", ErrValueNotNormalizable, value)
+}
+
+func normalizeMultiSelect(value any) (any, error) {
+	switch options := value.(type) {
+	case []string:
+		return options, nil
+	case string:
+		parts := strings.Split(options, multiSelectSeparator)
+		result := make([]string, 0, len(parts))
+
+		for _, part := range parts {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+
+		return result, nil
+	case []any:
+		result := make([]string, 0, len(options))
+
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+
+			result = append(result, fmt.Sprint(option))
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: %v is not a multi-select", ErrValueNotNormalizable, value)
+	}
+}
+
+func toFloat(value any) (float64, error) {
+	switch number := value.(type) {
+	case float64:
+		return number, nil
+	case float32:
+		return float64(number), nil
+	case int:
+		return float64(number), nil
+	case int32:
+		return float64(number), nil
+	case int64:
+		return float64(number), nil
+	case string:
+		if parsed, err := strconv.ParseFloat(strings.TrimSpace(number), 64); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %v is not a number", ErrValueNotNormalizable, value)
+}
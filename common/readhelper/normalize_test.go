@@ -0,0 +1,181 @@
+// nolint
+package readhelper
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		valueType common.ValueType
+		input     any
+		expected  any
+		expectErr bool
+	}{
+		{
+			name:      "Nil stays nil",
+			valueType: common.ValueTypeDateTime,
+			input:     nil,
+			expected:  nil,
+		},
+		{
+			name:      "Empty string becomes nil for numbers",
+			valueType: common.ValueTypeFloat,
+			input:     "",
+			expected:  nil,
+		},
+		{
+			name:      "Empty string is kept for strings",
+			valueType: common.ValueTypeString,
+			input:     "",
+			expected:  "",
+		},
+		{
+			name:      "HubSpot epoch milliseconds as string",
+			valueType: common.ValueTypeDateTime,
+			input:     "1704207845123",
+			expected:  "2024-01-02T15:04:05.123Z",
+		},
+		{
+			name:      "Epoch seconds as number",
+			valueType: common.ValueTypeDateTime,
+			input:     float64(1704207845),
+			expected:  "2024-01-02T15:04:05Z",
+		},
+		{
+			name:      "ISO string in another zone is converted to UTC",
+			valueType: common.ValueTypeDateTime,
+			input:     "2024-01-02T17:04:05+02:00",
+			expected:  "2024-01-02T15:04:05Z",
+		},
+		{
+			name:      "Salesforce datetime offset without colon",
+			valueType: common.ValueTypeDateTime,
+			input:     "2024-01-02T15:04:05.000+0000",
+			expected:  "2024-01-02T15:04:05Z",
+		},
+		{
+			name:      "Salesforce date-only string",
+			valueType: common.ValueTypeDate,
+			input:     "2024-01-02",
+			expected:  "2024-01-02T00:00:00Z",
+		},
+		{
+			name:      "Unparseable datetime",
+			valueType: common.ValueTypeDateTime,
+			input:     "yesterday",
+			expectErr: true,
+		},
+		{
+			name:      "Integer from string",
+			valueType: common.ValueTypeInt,
+			input:     "42",
+			expected:  int64(42),
+		},
+		{
+			name:      "Integer from JSON number",
+			valueType: common.ValueTypeInt,
+			input:     float64(42),
+			expected:  int64(42),
+		},
+		{
+			name:      "Fractional value is not an integer",
+			valueType: common.ValueTypeInt,
+			input:     4.2,
+			expectErr: true,
+		},
+		{
+			name:      "Float from string",
+			valueType: common.ValueTypeFloat,
+			input:     "19.99",
+			expected:  19.99,
+		},
+		{
+			name:      "Boolean from string",
+			valueType: common.ValueTypeBoolean,
+			input:     "TRUE",
+			expected:  true,
+		},
+		{
+			name:      "Boolean from number",
+			valueType: common.ValueTypeBoolean,
+			input:     float64(0),
+			expected:  false,
+		},
+		{
+			name:      "Multi-select joined string",
+			valueType: common.ValueTypeMultiSelect,
+			input:     "red;green; blue;",
+			expected:  []string{"red", "green", "blue"},
+		},
+		{
+			name:      "Multi-select list",
+			valueType: common.ValueTypeMultiSelect,
+			input:     []any{"red", float64(2)},
+			expected:  []string{"red", "2"},
+		},
+		{
+			name:      "Other types are unchanged",
+			valueType: common.ValueTypeOther,
+			input:     map[string]any{"a": "b"},
+			expected:  map[string]any{"a": "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := NormalizeValue(tt.valueType, tt.input)
+			if tt.expectErr {
+				require.ErrorIs(t, err, ErrValueNotNormalizable)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output)
+		})
+	}
+}
+
+func TestNormalizeFields(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]any{
+		"amount":       "100",
+		"closedate":    "1704207845123",
+		"hs_tags":      "a;b",
+		"unknown":      "1",
+		"isprivate":    "invalid",
+		"hs_object_id": "123",
+	}
+
+	metadata := common.FieldsMetadata{
+		"amount":    {ValueType: common.ValueTypeFloat},
+		"closeDate": {ValueType: common.ValueTypeDateTime},
+		"hs_tags":   {ValueType: common.ValueTypeMultiSelect},
+		"isPrivate": {ValueType: common.ValueTypeBoolean},
+	}
+
+	output := NormalizeFields(fields, metadata)
+
+	assert.Equal(t, map[string]any{
+		"amount":       100.0,
+		"closedate":    "2024-01-02T15:04:05.123Z",
+		"hs_tags":      []string{"a", "b"},
+		"unknown":      "1",
+		"isprivate":    "invalid",
+		"hs_object_id": "123",
+	}, output)
+
+	// Input is left untouched.
+	assert.Equal(t, "100", fields["amount"])
+}
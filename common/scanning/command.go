@@ -0,0 +1,81 @@
+package scanning
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+var ErrCommandFailed = errors.New("secret command failed")
+
+// defaultCommandTimeout leaves time for interactive unlock prompts of password managers.
+const defaultCommandTimeout = time.Minute
+
+// CommandReader runs a command and uses its output as the value, trailing newlines removed.
+// A failing command, such as a lookup of a missing entry, is reported as ErrValueNotFound.
+// It fits password manager CLIs, for example:
+//
+//	[]string{"pass", "show", "connectors/hubspot/clientSecret"}
+//	[]string{"op", "read", "op://Engineering/HubSpot/client-secret"}
+type CommandReader struct {
+	KeyName string   `json:"string"  validate:"required"`
+	Command []string `json:"command" validate:"required,min=1"`
+	// Timeout defaults to one minute.
+	Timeout time.Duration `json:"timeout"`
+}
+
+func (r *CommandReader) Key() (string, error) {
+	if r.KeyName == "" {
+		return "", fmt.Errorf("%w: %v", ErrKeyNotFound, r.Command)
+	}
+
+	return r.KeyName, nil
+}
+
+func (r *CommandReader) Value() (any, error) {
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = defaultCommandTimeout
+	}
+
+	value, err := runCommand(timeout, r.Command, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrValueNotFound, r.KeyName, err)
+	}
+
+	if value == "" {
+		return "", fmt.Errorf("%w: %s", ErrValueNotFound, r.KeyName)
+	}
+
+	return value, nil
+}
+
+func runCommand(timeout time.Duration, command []string, stdin []byte) (string, error) {
+	if len(command) == 0 {
+		return "", fmt.Errorf("%w: empty command", ErrCommandFailed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) // nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	if err := cmd.Run(); err != nil {
+		// Stderr is reported, stdout may already contain part of the secret.
+		return "", fmt.Errorf("%w: %s: %w: %s", ErrCommandFailed, command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
@@ -174,6 +174,10 @@ func getFields(info providers.ProviderInfo,
 		lists.Add(requiredType, Fields.ClientId, Fields.ClientSecret)
 	case providers.Jwt:
 		lists.Add(requiredType, Fields.Secret)
+	case providers.AwsSigV4:
+		// Access key ID and secret access key, the same way the AWS connector reads them.
+		lists.Add(requiredType, Fields.Username, Fields.Password)
+		lists.Add(optionalType, Fields.Token)
 	case providers.Custom:
 		// Custom auth may have different fields, so we skip adding any default fields here.
 	default:
@@ -196,7 +200,12 @@ func getFields(info providers.ProviderInfo,
 	return lists, nil
 }
 
+// envNameFor prefixes the variable with the provider, unless the provider is not known yet.
 func envNameFor(providerName string, suffix string) string {
+	if providerName == "" {
+		return suffix
+	}
+
 	return fmt.Sprintf("%v_%v", envNameFormat(providerName), suffix)
 }
 
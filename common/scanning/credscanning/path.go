@@ -13,7 +13,8 @@ import (
 // LoadPath will give path to creds.json.
 // For provider called `dynamicsCRM` the file location will either be
 // * value of DYNAMICS_CRM_CRED_FILE env var, or
-// * ./dynamics-crm-creds.json.
+// * ./dynamics-crm-creds.json, or its encrypted copy ./dynamics-crm-creds.json.enc,
+// * or ./dynamics-crm-creds.env holding the credentials as variables.
 //
 // Suffixes are optional to specify.
 // Primarily, this is useful when the provider has multiple files, each designated to a connector module.
@@ -36,18 +37,36 @@ func LoadPath(providerName string, suffixes ...string) string {
 }
 
 func fileInOS(provider string, suffix string) string {
-	filePath := fmt.Sprintf("./%v-creds.json", provider+suffix)
+	filePath := existingCredsFile(fmt.Sprintf("./%v-creds.json", provider+suffix))
 
 	if !fileExists(filePath) {
 		// Fallback to file path without suffixes.
 		oldPath := filePath
-		filePath = fmt.Sprintf("./%v-creds.json", provider)
+		filePath = existingCredsFile(fmt.Sprintf("./%v-creds.json", provider))
 		slog.Warn("credentials file does not exist, using fallback", "path", oldPath, "newPath", filePath)
 	}
 
 	return filePath
 }
 
+// existingCredsFile prefers the plaintext file, and falls back to its encrypted counterpart
+// or to the .env file when only that exists.
+func existingCredsFile(filePath string) string {
+	if fileExists(filePath) {
+		return filePath
+	}
+
+	if fileExists(filePath + EncryptedFileExtension) {
+		return filePath + EncryptedFileExtension
+	}
+
+	if dotEnv := strings.TrimSuffix(filePath, ".json") + DotEnvFileExtension; fileExists(dotEnv) {
+		return dotEnv
+	}
+
+	return filePath
+}
+
 func pathFromENV(provider string, suffix string) string {
 	filePath := os.Getenv(
 		fmt.Sprintf("%v_CRED_FILE", envNameFormat(provider+suffix)),
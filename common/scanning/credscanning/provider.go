@@ -25,6 +25,7 @@ type ProviderCredentials struct {
 
 // NewJSONProviderCredentials reads JSON fields that must be present for a provider.
 // It performs validation and will tell you fields that are expected for this provider in JSON file.
+// Fields missing from the file are read from the secret sources given by WithSecretSourcesFromENV.
 //
 // Note: As of right now there is no way to infer if access token must be provided.
 // Therefore, explicitly state via arguments.
@@ -33,8 +34,10 @@ func NewJSONProviderCredentials(
 	withRequiredAccessToken bool,
 	customFields ...Field,
 ) (*ProviderCredentials, error) {
+	source := NewFileSource(filePath)
+
 	return createProviderCreds(
-		getProviderName(filePath), withRequiredAccessToken, filePath, customFields,
+		getProviderName(source), withRequiredAccessToken, WithSecretSourcesFromENV(source), customFields,
 	)
 }
 
@@ -45,12 +48,25 @@ func NewENVProviderCredentials(
 	customFields ...Field,
 ) (*ProviderCredentials, error) {
 	return createProviderCreds(
-		providerName, withRequiredAccessToken, "", customFields,
+		providerName, withRequiredAccessToken, ENVSource{}, customFields,
+	)
+}
+
+// NewProviderCredentials reads fields of a provider from any source,
+// such as a .env file, a keyring or a password manager command.
+func NewProviderCredentials(
+	providerName string,
+	source Source,
+	withRequiredAccessToken bool,
+	customFields ...Field,
+) (*ProviderCredentials, error) {
+	return createProviderCreds(
+		providerName, withRequiredAccessToken, source, customFields,
 	)
 }
 
 func createProviderCreds(
-	providerName string, withRequiredAccessToken bool, filePath string, customFields []Field,
+	providerName string, withRequiredAccessToken bool, source Source, customFields []Field,
 ) (*ProviderCredentials, error) {
 	// load provider from catalog to imply fields in JSON or ENV vars
 	catalog, err := providers.ReadCatalog()
@@ -74,7 +90,7 @@ func createProviderCreds(
 	// add readers for every field
 	for kind, fieldList := range fields {
 		for _, field := range fieldList {
-			reader := selectReader(field, filePath, providerName)
+			reader := source.Reader(field, providerName)
 			readers.Add(kind, reader)
 
 			if err = registry.AddReader(reader); err != nil {
@@ -91,14 +107,6 @@ func createProviderCreds(
 	return r, r.loadValues(readers)
 }
 
-func selectReader(field Field, filePath string, providerName string) scanning.Reader {
-	if len(filePath) != 0 {
-		return field.GetJSONReader(filePath)
-	}
-
-	return field.GetENVReader(providerName)
-}
-
 func (r ProviderCredentials) loadValues(readers datautils.NamedLists[scanning.Reader]) error { // nolint:funcorder
 	// validate JSON file or ENV has all Required variables
 	missingKeys := make([]string, 0)
@@ -139,10 +147,10 @@ func (r ProviderCredentials) Get(field Field) string {
 	return r.ProviderValues[field.Name]
 }
 
-func getProviderName(filePath string) string {
+func getProviderName(source Source) string {
 	registry := scanning.NewRegistry()
 
-	reader := Fields.Provider.GetJSONReader(filePath)
+	reader := source.Reader(Fields.Provider, "")
 
 	err := registry.AddReader(reader)
 	if err != nil {
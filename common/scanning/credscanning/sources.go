@@ -0,0 +1,191 @@
+package credscanning
+
+import (
+	"os"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/scanning"
+	"github.com/iancoleman/strcase"
+)
+
+const (
+	// EncryptedFileExtension marks credential files written by scanning.EncryptFile.
+	EncryptedFileExtension = ".enc"
+	// DotEnvFileExtension marks credential files holding variables named as for ENVSource.
+	DotEnvFileExtension = ".env"
+
+	// Environment variables unlocking encrypted credential files.
+	envPassphrase = "CREDS_PASSPHRASE"
+	envKeyFile    = "CREDS_KEY_FILE"
+
+	// Environment variables adding sources of secrets missing from the credentials file.
+	// CREDS_KEYRING is either "system" or the path of a scanning.FileKeyring file,
+	// CREDS_COMMAND is a CommandSource command, its arguments separated by spaces.
+	envKeyring = "CREDS_KEYRING"
+	envCommand = "CREDS_COMMAND"
+
+	systemKeyring = "system"
+)
+
+// Source decides where the value of each credential field is read from.
+type Source interface {
+	Reader(field Field, providerName string) scanning.Reader
+}
+
+// NewFileSource reads fields from a creds.json file. Files with the EncryptedFileExtension
+// are decrypted with the key given by EncryptionKeyFromENV, files with the DotEnvFileExtension
+// are read as a .env file, where the provider is named by the PROVIDER variable.
+func NewFileSource(filePath string) Source {
+	if strings.HasSuffix(filePath, EncryptedFileExtension) {
+		return EncryptedFileSource{FilePath: filePath, Key: EncryptionKeyFromENV()}
+	}
+
+	if strings.HasSuffix(filePath, DotEnvFileExtension) {
+		return DotEnvSource{FilePath: filePath}
+	}
+
+	return JSONFileSource{FilePath: filePath}
+}
+
+// WithSecretSourcesFromENV falls back to the keyring given by CREDS_KEYRING and to the command
+// given by CREDS_COMMAND for fields missing from the source, so that secrets can be kept out of creds files.
+func WithSecretSourcesFromENV(source Source) Source {
+	sources := FallbackSource{source}
+
+	switch keyring := os.Getenv(envKeyring); keyring {
+	case "":
+	case systemKeyring:
+		sources = append(sources, KeyringSource{Backend: scanning.SystemKeyring()})
+	default:
+		backend := scanning.FileKeyring{FilePath: keyring}
+		if strings.HasSuffix(keyring, EncryptedFileExtension) {
+			key := EncryptionKeyFromENV()
+			backend.Key = &key
+		}
+
+		sources = append(sources, KeyringSource{Backend: backend})
+	}
+
+	if command := strings.Fields(os.Getenv(envCommand)); len(command) != 0 {
+		sources = append(sources, CommandSource{Command: command})
+	}
+
+	if len(sources) == 1 {
+		return source
+	}
+
+	return sources
+}
+
+// FallbackSource reads every field from the first source which has a value for it.
+type FallbackSource []Source
+
+func (s FallbackSource) Reader(field Field, providerName string) scanning.Reader {
+	readers := make([]scanning.Reader, len(s))
+	for index, source := range s {
+		readers[index] = source.Reader(field, providerName)
+	}
+
+	return &scanning.FallbackReader{
+		KeyName: field.Name,
+		Readers: readers,
+	}
+}
+
+// EncryptionKeyFromENV reads the passphrase from CREDS_PASSPHRASE or the key file path from CREDS_KEY_FILE.
+func EncryptionKeyFromENV() scanning.EncryptionKey {
+	return scanning.EncryptionKey{
+		Passphrase: os.Getenv(envPassphrase),
+		KeyFile:    os.Getenv(envKeyFile),
+	}
+}
+
+// JSONFileSource reads fields from a plaintext creds.json file.
+type JSONFileSource struct {
+	FilePath string
+}
+
+func (s JSONFileSource) Reader(field Field, _ string) scanning.Reader {
+	return field.GetJSONReader(s.FilePath)
+}
+
+// EncryptedFileSource reads fields from a creds.json file encrypted by scanning.EncryptFile.
+type EncryptedFileSource struct {
+	FilePath string
+	Key      scanning.EncryptionKey
+}
+
+func (s EncryptedFileSource) Reader(field Field, _ string) scanning.Reader {
+	return &scanning.EncryptedJSONReader{
+		FilePath:   s.FilePath,
+		JSONPath:   jsonPathTo(field.PathJSON),
+		KeyName:    field.Name,
+		Encryption: s.Key,
+	}
+}
+
+// ENVSource reads fields from environment variables, such as HUBSPOT_CLIENT_ID.
+type ENVSource struct{}
+
+func (ENVSource) Reader(field Field, providerName string) scanning.Reader {
+	return field.GetENVReader(providerName)
+}
+
+// DotEnvSource reads fields from a .env file, variables are named as for ENVSource.
+type DotEnvSource struct {
+	FilePath string
+}
+
+func (s DotEnvSource) Reader(field Field, providerName string) scanning.Reader {
+	return &scanning.DotEnvReader{
+		FilePath: s.FilePath,
+		EnvName:  envNameFor(providerName, field.SuffixENV),
+		KeyName:  field.Name,
+	}
+}
+
+// KeyringSource reads fields from a keyring. Secrets are stored under the service
+// "connectors/<provider>" with the field name as account, e.g. "connectors/hub-spot" and "clientSecret".
+type KeyringSource struct {
+	Backend scanning.KeyringBackend
+}
+
+func (s KeyringSource) Reader(field Field, providerName string) scanning.Reader {
+	return &scanning.KeyringReader{
+		Service: KeyringService(providerName),
+		Account: field.Name,
+		KeyName: field.Name,
+		Backend: s.Backend,
+	}
+}
+
+// KeyringService is the keyring service holding credentials of the provider.
+func KeyringService(providerName string) string {
+	return "connectors/" + strcase.ToKebab(providerName)
+}
+
+// CommandSource runs a command for every field. Arguments may contain the placeholders
+// {provider}, replaced by the provider name in kebab case, and {field}, replaced by the field name:
+//
+//	CommandSource{Command: []string{"pass", "show", "connectors/{provider}/{field}"}}
+//	CommandSource{Command: []string{"op", "read", "op://Engineering/{provider}/{field}"}}
+type CommandSource struct {
+	Command []string
+}
+
+func (s CommandSource) Reader(field Field, providerName string) scanning.Reader {
+	replacer := strings.NewReplacer(
+		"{provider}", strcase.ToKebab(providerName),
+		"{field}", field.Name,
+	)
+
+	command := make([]string, len(s.Command))
+	for i, arg := range s.Command {
+		command[i] = replacer.Replace(arg)
+	}
+
+	return &scanning.CommandReader{
+		Command: command,
+		KeyName: field.Name,
+	}
+}
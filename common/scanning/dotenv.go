@@ -0,0 +1,36 @@
+package scanning
+
+import (
+	"fmt"
+
+	"github.com/joho/godotenv"
+)
+
+// DotEnvReader reads a variable from a .env file without changing the process environment.
+type DotEnvReader struct {
+	KeyName  string `json:"string"   validate:"required"`
+	FilePath string `json:"filePath" validate:"required"`
+	EnvName  string `json:"params"   validate:"required"`
+}
+
+func (r *DotEnvReader) Key() (string, error) {
+	if r.KeyName == "" {
+		return "", fmt.Errorf("%w: %s", ErrKeyNotFound, r.EnvName)
+	}
+
+	return r.KeyName, nil
+}
+
+func (r *DotEnvReader) Value() (any, error) {
+	variables, err := godotenv.Read(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	value := variables[r.EnvName]
+	if value == "" {
+		return "", fmt.Errorf("%w: %w: %s in %s", ErrValueNotFound, ErrEnvVarNotSet, r.EnvName, r.FilePath)
+	}
+
+	return value, nil
+}
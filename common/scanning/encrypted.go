@@ -0,0 +1,231 @@
+package scanning
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+var (
+	ErrEncryptionKey = errors.New("either passphrase or key file must be given")
+	ErrDecryption    = errors.New("cannot decrypt credentials file")
+)
+
+const (
+	encryptedFileVersion = 1
+	encryptionKeySize    = 32
+
+	// Scrypt parameters recommended for interactive logins.
+	scryptCost        = 1 << 15
+	scryptBlockSize   = 8
+	scryptParallelism = 1
+	scryptSaltSize    = 16
+)
+
+// EncryptionKey unlocks an encrypted credentials file.
+// The passphrase is stretched with scrypt, while a key file holds the AES-256 key itself,
+// either as 32 raw bytes or base64 encoded.
+type EncryptionKey struct {
+	Passphrase string
+	KeyFile    string
+}
+
+// encryptedFile is the format of a file written by EncryptFile.
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptFile encrypts the data with AES-256-GCM and writes it to the file.
+func EncryptFile(filePath string, data []byte, key EncryptionKey) error {
+	envelope := encryptedFile{Version: encryptedFileVersion}
+
+	if key.KeyFile == "" {
+		envelope.KDF = "scrypt"
+		envelope.Salt = make([]byte, scryptSaltSize)
+
+		if _, err := rand.Read(envelope.Salt); err != nil {
+			return err
+		}
+	} else {
+		envelope.KDF = "none"
+	}
+
+	aead, err := key.cipher(envelope)
+	if err != nil {
+		return err
+	}
+
+	envelope.Nonce = make([]byte, aead.NonceSize())
+	if _, err = rand.Read(envelope.Nonce); err != nil {
+		return err
+	}
+
+	envelope.Ciphertext = aead.Seal(nil, envelope.Nonce, data, nil)
+
+	content, err := json.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, content, 0o600) // nolint:mnd
+}
+
+// DecryptFile reads a file written by EncryptFile.
+func DecryptFile(filePath string, key EncryptionKey) ([]byte, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope encryptedFile
+	if err = json.Unmarshal(content, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecryption, err)
+	}
+
+	if envelope.Version != encryptedFileVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrDecryption, envelope.Version)
+	}
+
+	aead, err := key.cipher(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrDecryption)
+	}
+
+	data, err := aead.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		// Wrong key and tampered content are indistinguishable.
+		return nil, fmt.Errorf("%w: %w", ErrDecryption, err)
+	}
+
+	return data, nil
+}
+
+func (k EncryptionKey) cipher(envelope encryptedFile) (cipher.AEAD, error) {
+	secret, err := k.derive(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (k EncryptionKey) derive(envelope encryptedFile) ([]byte, error) {
+	switch envelope.KDF {
+	case "scrypt":
+		if k.Passphrase == "" {
+			return nil, fmt.Errorf("%w: file is encrypted with a passphrase", ErrEncryptionKey)
+		}
+
+		return scrypt.Key([]byte(k.Passphrase), envelope.Salt,
+			scryptCost, scryptBlockSize, scryptParallelism, encryptionKeySize)
+	case "none":
+		if k.KeyFile == "" {
+			return nil, fmt.Errorf("%w: file is encrypted with a key file", ErrEncryptionKey)
+		}
+
+		return readKeyFile(k.KeyFile)
+	default:
+		return nil, fmt.Errorf("%w: unknown key derivation %q", ErrDecryption, envelope.KDF)
+	}
+}
+
+func readKeyFile(filePath string) ([]byte, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == encryptionKeySize {
+		return content, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil || len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("%w: key file must hold a 32 byte key", ErrEncryptionKey)
+	}
+
+	return key, nil
+}
+
+// EncryptedJSONReader is a JSONReader for files written by EncryptFile.
+type EncryptedJSONReader struct {
+	KeyName    string        `json:"string"   validate:"required"`
+	FilePath   string        `json:"filePath" validate:"required"`
+	JSONPath   string        `json:"jsonPath" validate:"required"`
+	Encryption EncryptionKey `json:"-"`
+}
+
+func (r *EncryptedJSONReader) Key() (string, error) {
+	if r.KeyName == "" {
+		return "", fmt.Errorf("%w: %s", ErrKeyNotFound, r.FilePath)
+	}
+
+	return r.KeyName, nil
+}
+
+func (r *EncryptedJSONReader) Value() (any, error) {
+	data, err := decryptedFiles.get(r.FilePath, r.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	return valueAtJSONPath(data, r.JSONPath)
+}
+
+// decryptedFiles avoids deriving the key once per field of the same file.
+var decryptedFiles = &decryptionCache{entries: make(map[string][]byte)} // nolint:gochecknoglobals
+
+type decryptionCache struct {
+	mutex   sync.Mutex
+	entries map[string][]byte
+}
+
+func (c *decryptionCache) get(filePath string, key EncryptionKey) ([]byte, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := sha256.Sum256(fmt.Appendf(nil, "%s\x00%d\x00%d\x00%s\x00%s",
+		filePath, info.ModTime().UnixNano(), info.Size(), key.Passphrase, key.KeyFile))
+	cacheKey := string(fingerprint[:])
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if data, ok := c.entries[cacheKey]; ok {
+		return data, nil
+	}
+
+	data, err := DecryptFile(filePath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[cacheKey] = data
+
+	return data, nil
+}
@@ -0,0 +1,139 @@
+package scanning
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+)
+
+var ErrSecretNotFound = errors.New("secret not found in keyring")
+
+// KeyringBackend looks up a secret stored under a service and an account.
+type KeyringBackend interface {
+	Get(service, account string) (string, error)
+}
+
+// KeyringReader reads a secret from a keyring. Missing secrets are reported as ErrValueNotFound.
+type KeyringReader struct {
+	KeyName string         `json:"string"  validate:"required"`
+	Service string         `json:"service" validate:"required"`
+	Account string         `json:"account" validate:"required"`
+	Backend KeyringBackend `json:"-"       validate:"required"`
+}
+
+func (r *KeyringReader) Key() (string, error) {
+	if r.KeyName == "" {
+		return "", fmt.Errorf("%w: %s/%s", ErrKeyNotFound, r.Service, r.Account)
+	}
+
+	return r.KeyName, nil
+}
+
+func (r *KeyringReader) Value() (any, error) {
+	secret, err := r.Backend.Get(r.Service, r.Account)
+	if errors.Is(err, ErrSecretNotFound) {
+		return nil, fmt.Errorf("%w: %s: %w", ErrValueNotFound, r.KeyName, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// SystemKeyring is the keyring of the operating system. It uses the "security" tool on macOS,
+// and "secret-tool" of libsecret on Linux. Secrets can be added with:
+//
+//	security add-generic-password -s <service> -a <account> -w
+//	secret-tool store --label=<service> service <service> account <account>
+func SystemKeyring() KeyringBackend {
+	return systemKeyring{}
+}
+
+type systemKeyring struct{}
+
+func (systemKeyring) Get(service, account string) (string, error) {
+	var command []string
+
+	switch runtime.GOOS {
+	case "darwin":
+		command = []string{"security", "find-generic-password", "-s", service, "-a", account, "-w"}
+	case "linux", "freebsd", "openbsd":
+		command = []string{"secret-tool", "lookup", "service", service, "account", account}
+	default:
+		return "", fmt.Errorf("%w: no system keyring on %s", ErrSecretNotFound, runtime.GOOS)
+	}
+
+	secret, err := runCommand(defaultCommandTimeout, command, nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s/%s: %w", ErrSecretNotFound, service, account, err)
+	}
+
+	if secret == "" {
+		return "", fmt.Errorf("%w: %s/%s", ErrSecretNotFound, service, account)
+	}
+
+	return secret, nil
+}
+
+// FileKeyring stores secrets in a JSON file shaped as {"<service>": {"<account>": "<secret>"}}.
+// It is the fallback for CI machines without an OS keyring, the file is usually encrypted
+// with EncryptFile and given a Key.
+type FileKeyring struct {
+	FilePath string
+	Key      *EncryptionKey
+}
+
+func (k FileKeyring) Get(service, account string) (string, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if k.Key != nil {
+		data, err = decryptedFiles.get(k.FilePath, *k.Key)
+	} else {
+		data, err = os.ReadFile(k.FilePath)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	var secrets map[string]map[string]string
+	if err = json.Unmarshal(data, &secrets); err != nil {
+		return "", err
+	}
+
+	secret := secrets[service][account]
+	if secret == "" {
+		return "", fmt.Errorf("%w: %s/%s", ErrSecretNotFound, service, account)
+	}
+
+	return secret, nil
+}
+
+// FallbackKeyring tries each backend in order, moving on when a secret is not found.
+type FallbackKeyring []KeyringBackend
+
+func (k FallbackKeyring) Get(service, account string) (string, error) {
+	errs := make([]error, 0, len(k))
+
+	for _, backend := range k {
+		secret, err := backend.Get(service, account)
+		if err == nil {
+			return secret, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return "", fmt.Errorf("%w: %s/%s", ErrSecretNotFound, service, account)
+	}
+
+	return "", errors.Join(errs...)
+}
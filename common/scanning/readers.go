@@ -1,6 +1,7 @@
 package scanning
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -79,19 +80,57 @@ func (r *JSONReader) Value() (any, error) {
 		return nil, err
 	}
 
+	return valueAtJSONPath(data, r.JSONPath)
+}
+
+func valueAtJSONPath(data []byte, jsonPath string) (any, error) {
 	credsMap, err := ajson.Unmarshal(data)
 	if err != nil {
 		return nil, err
 	}
 
-	list, err := credsMap.JSONPath(r.JSONPath)
+	list, err := credsMap.JSONPath(jsonPath)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(list) == 0 || list[0] == nil {
-		return nil, fmt.Errorf("%w: %s", ErrJSONPathNotFound, r.JSONPath)
+		return nil, fmt.Errorf("%w: %s", ErrJSONPathNotFound, jsonPath)
 	}
 
 	return list[0].Value()
 }
+
+// FallbackReader reads the value from the first reader which has one.
+// Readers reporting a missing value are skipped, any other error is returned.
+type FallbackReader struct {
+	KeyName string   `json:"string"  validate:"required"`
+	Readers []Reader `json:"readers" validate:"required,min=1"`
+}
+
+func (r *FallbackReader) Key() (string, error) {
+	if r.KeyName == "" {
+		return "", fmt.Errorf("%w: %d readers", ErrKeyNotFound, len(r.Readers))
+	}
+
+	return r.KeyName, nil
+}
+
+func (r *FallbackReader) Value() (any, error) {
+	for _, reader := range r.Readers {
+		value, err := reader.Value()
+		if err == nil {
+			return value, nil
+		}
+
+		if !isValueMissing(err) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrValueNotFound, r.KeyName)
+}
+
+func isValueMissing(err error) bool {
+	return errors.Is(err, ErrValueNotFound) || errors.Is(err, ErrJSONPathNotFound) || errors.Is(err, ErrEnvVarNotSet)
+}
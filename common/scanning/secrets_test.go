@@ -0,0 +1,136 @@
+package scanning
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedJSONReader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	creds := []byte(`{"clientId": "id", "metadata": {"workspace": "acme"}}`)
+
+	keyFile := filepath.Join(dir, "key.txt")
+	require.NoError(t, os.WriteFile(keyFile,
+		[]byte(base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))+"\n"), 0o600))
+
+	for name, key := range map[string]EncryptionKey{
+		"passphrase": {Passphrase: "correct horse"},
+		"key file":   {KeyFile: keyFile},
+	} {
+		filePath := filepath.Join(dir, name+".json.enc")
+		require.NoError(t, EncryptFile(filePath, creds, key))
+
+		registry := NewRegistry()
+		require.NoError(t, registry.AddReaders(
+			&EncryptedJSONReader{KeyName: "clientId", FilePath: filePath, JSONPath: "$.clientId", Encryption: key},
+			&EncryptedJSONReader{KeyName: "workspace", FilePath: filePath, JSONPath: "$.metadata.workspace", Encryption: key},
+		))
+
+		require.Equal(t, "id", registry.MustString("clientId"), name)
+		require.Equal(t, "acme", registry.MustString("workspace"), name)
+	}
+
+	_, err := DecryptFile(filepath.Join(dir, "passphrase.json.enc"), EncryptionKey{Passphrase: "wrong"})
+	require.ErrorIs(t, err, ErrDecryption)
+
+	_, err = DecryptFile(filepath.Join(dir, "key file.json.enc"), EncryptionKey{Passphrase: "correct horse"})
+	require.ErrorIs(t, err, ErrEncryptionKey)
+}
+
+func TestDotEnvReader(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(filePath, []byte("# comment\nexport HUBSPOT_CLIENT_ID=id\nHUBSPOT_CLIENT_SECRET=\"s3cret\"\n"), 0o600))
+
+	registry := NewRegistry()
+	require.NoError(t, registry.AddReaders(
+		&DotEnvReader{KeyName: "clientId", FilePath: filePath, EnvName: "HUBSPOT_CLIENT_ID"},
+		&DotEnvReader{KeyName: "clientSecret", FilePath: filePath, EnvName: "HUBSPOT_CLIENT_SECRET"},
+		&DotEnvReader{KeyName: "missing", FilePath: filePath, EnvName: "HUBSPOT_MISSING"},
+	))
+
+	require.Equal(t, "id", registry.MustString("clientId"))
+	require.Equal(t, "s3cret", registry.MustString("clientSecret"))
+
+	_, err := registry.GetString("missing")
+	require.ErrorIs(t, err, ErrEnvVarNotSet)
+	require.ErrorIs(t, err, ErrValueNotFound)
+}
+
+func TestCommandReader(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	require.NoError(t, registry.AddReaders(
+		&CommandReader{KeyName: "token", Command: []string{"echo", "from-password-manager"}},
+		&CommandReader{KeyName: "failing", Command: []string{"false"}},
+	))
+
+	require.Equal(t, "from-password-manager", registry.MustString("token"))
+
+	_, err := registry.GetString("failing")
+	require.ErrorIs(t, err, ErrCommandFailed)
+	require.ErrorIs(t, err, ErrValueNotFound)
+}
+
+func TestFileKeyringFallback(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "keyring.json.enc")
+	key := EncryptionKey{Passphrase: "ci"}
+
+	require.NoError(t, EncryptFile(filePath, []byte(`{"connectors/hubspot": {"clientSecret": "s3cret"}}`), key))
+
+	empty := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte(`{}`), 0o600))
+
+	backend := FallbackKeyring{FileKeyring{FilePath: empty}, FileKeyring{FilePath: filePath, Key: &key}}
+
+	registry := NewRegistry()
+	require.NoError(t, registry.AddReaders(
+		&KeyringReader{KeyName: "clientSecret", Service: "connectors/hubspot", Account: "clientSecret", Backend: backend},
+		&KeyringReader{KeyName: "clientId", Service: "connectors/hubspot", Account: "clientId", Backend: backend},
+	))
+
+	require.Equal(t, "s3cret", registry.MustString("clientSecret"))
+
+	_, err := registry.GetString("clientId")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+	require.ErrorIs(t, err, ErrValueNotFound)
+}
+
+func TestFallbackReader(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(filePath, []byte("HUBSPOT_CLIENT_ID=id\n"), 0o600))
+
+	registry := NewRegistry()
+	require.NoError(t, registry.AddReaders(
+		&FallbackReader{KeyName: "clientId", Readers: []Reader{
+			&DotEnvReader{KeyName: "clientId", FilePath: filePath, EnvName: "HUBSPOT_CLIENT_ID"},
+			&CommandReader{KeyName: "clientId", Command: []string{"echo", "unused"}},
+		}},
+		&FallbackReader{KeyName: "clientSecret", Readers: []Reader{
+			&DotEnvReader{KeyName: "clientSecret", FilePath: filePath, EnvName: "HUBSPOT_CLIENT_SECRET"},
+			&CommandReader{KeyName: "clientSecret", Command: []string{"echo", "from-command"}},
+		}},
+		&FallbackReader{KeyName: "missing", Readers: []Reader{
+			&DotEnvReader{KeyName: "missing", FilePath: filePath, EnvName: "HUBSPOT_MISSING"},
+			&CommandReader{KeyName: "missing", Command: []string{"false"}},
+		}},
+	))
+
+	require.Equal(t, "id", registry.MustString("clientId"))
+	require.Equal(t, "from-command", registry.MustString("clientSecret"))
+
+	_, err := registry.GetString("missing")
+	require.ErrorIs(t, err, ErrValueNotFound)
+}
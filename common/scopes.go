@@ -0,0 +1,120 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMissingScopes is returned when granted scopes are insufficient for an operation.
+var ErrMissingScopes = errors.New("missing required scopes")
+
+// ScopeOperation is an operation that may require its own scopes.
+type ScopeOperation string
+
+const (
+	ScopeOperationRead   ScopeOperation = "read"
+	ScopeOperationWrite  ScopeOperation = "write"
+	ScopeOperationDelete ScopeOperation = "delete"
+)
+
+// ScopeRequirements lists the scopes an object needs per operation.
+// Every listed scope is required. Alternatives, such as a fine-grained scope and the legacy
+// scope it replaced, are written as one entry separated by "|", e.g. "crm.objects.contacts.read|contacts".
+// Operations without scopes listed need nothing beyond what the connection was created with.
+type ScopeRequirements struct {
+	Read   []string
+	Write  []string
+	Delete []string
+}
+
+// For returns the scopes required by the operation.
+func (r ScopeRequirements) For(operation ScopeOperation) []string {
+	switch operation {
+	case ScopeOperationRead:
+		return r.Read
+	case ScopeOperationWrite:
+		return r.Write
+	case ScopeOperationDelete:
+		return r.Delete
+	default:
+		return nil
+	}
+}
+
+// ScopeCheckParams selects what a scope check covers.
+type ScopeCheckParams struct {
+	// GrantedScopes are the scopes of the connection, typically taken from the token response
+	// at TokenMetadataFields.ScopesField of the provider catalog.
+	GrantedScopes []string
+	// ObjectNames to check. All objects with declared scope requirements are checked when empty.
+	ObjectNames []string
+	// Operations to check. All operations are checked when empty.
+	Operations []ScopeOperation
+}
+
+// ScopeCheckResult reports operations which will fail because of missing scopes.
+type ScopeCheckResult struct {
+	Missing []MissingScopes
+}
+
+// OK reports whether every checked operation is permitted.
+func (r *ScopeCheckResult) OK() bool {
+	return len(r.Missing) == 0
+}
+
+// Err returns ErrMissingScopes describing every failing operation, or nil.
+func (r *ScopeCheckResult) Err() error {
+	if r.OK() {
+		return nil
+	}
+
+	reasons := make([]string, len(r.Missing))
+	for index, missing := range r.Missing {
+		reasons[index] = missing.String()
+	}
+
+	return fmt.Errorf("%w: %s", ErrMissingScopes, strings.Join(reasons, "; "))
+}
+
+// MissingScopes describes an operation on an object which lacks scopes.
+type MissingScopes struct {
+	Module     ModuleID
+	ObjectName string
+	Operation  ScopeOperation
+	// Scopes which are required but not granted. Alternatives are kept in the "a|b" form.
+	Scopes []string
+}
+
+func (m MissingScopes) String() string {
+	return fmt.Sprintf("%s %s requires %s", m.Operation, m.ObjectName, strings.Join(m.Scopes, ", "))
+}
+
+// MissingScopesOf returns required scopes which are not granted.
+// A requirement with alternatives is satisfied when any alternative is granted.
+func MissingScopesOf(required, granted []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		grantedSet[strings.TrimSpace(scope)] = true
+	}
+
+	var missing []string
+
+	for _, requirement := range required {
+		satisfied := false
+
+		for alternative := range strings.SplitSeq(requirement, "|") {
+			if grantedSet[strings.TrimSpace(alternative)] {
+				satisfied = true
+
+				break
+			}
+		}
+
+		if !satisfied {
+			missing = append(missing, requirement)
+		}
+	}
+
+	return missing
+}
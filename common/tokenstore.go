@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrTokenLeaseTimeout is returned when a token lease cannot be acquired before the context is done.
+	ErrTokenLeaseTimeout = errors.New("timed out acquiring token refresh lease")
+	// ErrTokenLeaseLost is returned on release when the lease went stale and was taken over by another holder.
+	ErrTokenLeaseLost = errors.New("token refresh lease was taken over")
+	// ErrTokenStoreConflict is returned when a refreshed token keeps losing to concurrent writes to the store.
+	ErrTokenStoreConflict = errors.New("refreshed token could not be stored")
+)
+
+// TokenStore persists the OAuth token of a single connection so that several processes
+// using the connection share it. Providers such as Xero, QuickBooks or Salesforce with
+// refresh token rotation invalidate the previous refresh token on every refresh,
+// therefore all processes must use the most recently stored token.
+type TokenStore interface {
+	// Load returns the stored token, or nil when nothing is stored yet.
+	Load(ctx context.Context) (*oauth2.Token, error)
+
+	// CompareAndSwap stores next only if the stored token is still old, compared by access and refresh token.
+	// A nil old matches an empty store. It reports whether the token was stored.
+	CompareAndSwap(ctx context.Context, old, next *oauth2.Token) (bool, error)
+}
+
+// TokenLeaser grants exclusive, time-limited permission to refresh a connection's token.
+// Leases expire so that a crashed process cannot block refreshes forever.
+type TokenLeaser interface {
+	// Acquire blocks until the lease is granted or the context is done.
+	// The returned function releases the lease.
+	Acquire(ctx context.Context) (release func() error, err error)
+}
+
+// sameToken reports whether two tokens carry the same credentials.
+func sameToken(left, right *oauth2.Token) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	return left.AccessToken == right.AccessToken && left.RefreshToken == right.RefreshToken
+}
+
+// MemoryTokenStore is a TokenStore and TokenLeaser for workers within a single process.
+// It is a reference implementation; production deployments typically back TokenStore
+// with a database row updated conditionally and TokenLeaser with a distributed lock.
+type MemoryTokenStore struct {
+	mutex sync.Mutex
+	token *oauth2.Token
+	lease chan struct{}
+}
+
+var (
+	_ TokenStore  = (*MemoryTokenStore)(nil)
+	_ TokenLeaser = (*MemoryTokenStore)(nil)
+)
+
+// NewMemoryTokenStore creates a store holding the initial token, which may be nil.
+func NewMemoryTokenStore(token *oauth2.Token) *MemoryTokenStore {
+	return &MemoryTokenStore{
+		token: token,
+		lease: make(chan struct{}, 1),
+	}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context) (*oauth2.Token, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.token, nil
+}
+
+func (s *MemoryTokenStore) CompareAndSwap(ctx context.Context, old, next *oauth2.Token) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !sameToken(s.token, old) {
+		return false, nil
+	}
+
+	s.token = next
+
+	return true, nil
+}
+
+func (s *MemoryTokenStore) Acquire(ctx context.Context) (func() error, error) {
+	select {
+	case s.lease <- struct{}{}:
+		return func() error {
+			<-s.lease
+
+			return nil
+		}, nil
+	case <-ctx.Done():
+		return nil, errors.Join(ErrTokenLeaseTimeout, ctx.Err())
+	}
+}
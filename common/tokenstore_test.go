@@ -0,0 +1,326 @@
+// nolint
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// rotatingTokenServer issues a new refresh token on every refresh and rejects used ones.
+func rotatingTokenServer(t *testing.T, refreshes *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	var (
+		mutex   sync.Mutex
+		current = "refresh-0"
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Form.Get("refresh_token") != current {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+			return
+		}
+
+		count := refreshes.Add(1)
+		current = fmt.Sprintf("refresh-%d", count)
+
+		_, _ = fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":%q,"expires_in":3600}`, count, current)
+	})
+	mux.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestTokenStoreSingleRefreshAcrossClients(t *testing.T) {
+	t.Parallel()
+
+	var refreshes atomic.Int32
+
+	server := rotatingTokenServer(t, &refreshes)
+	expired := &oauth2.Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Hour)}
+	store := NewMemoryTokenStore(expired)
+	config := &oauth2.Config{ClientID: "id", Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}}
+
+	var wg sync.WaitGroup
+
+	for range 4 {
+		client, err := NewOAuthHTTPClient(t.Context(),
+			WithOAuthConfig(config),
+			WithOAuthToken(expired),
+			WithTokenStore(store, store),
+		)
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/api", nil)
+			require.NoError(t, err)
+
+			rsp, err := client.Do(req)
+			assert.NoError(t, err)
+
+			if rsp != nil {
+				_ = rsp.Body.Close()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refreshes.Load())
+
+	stored, err := store.Load(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-1", stored.RefreshToken)
+}
+
+func TestTokenStoreAdoptsPeerToken(t *testing.T) {
+	t.Parallel()
+
+	var refreshes atomic.Int32
+
+	server := rotatingTokenServer(t, &refreshes)
+	expired := &oauth2.Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Hour)}
+	peer := &oauth2.Token{AccessToken: "peer", RefreshToken: "refresh-peer", Expiry: time.Now().Add(time.Hour)}
+	store := NewMemoryTokenStore(peer)
+
+	source := newCoordinatedTokenSource(&oauthClientParams{
+		client:     server.Client(),
+		config:     &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}},
+		token:      expired,
+		tokenStore: store,
+	})
+
+	token, err := source.TokenWithContext(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "peer", token.AccessToken)
+	assert.Zero(t, refreshes.Load())
+}
+
+func TestFileTokenStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+
+	token, err := store.Load(t.Context())
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	first := &oauth2.Token{AccessToken: "a1", RefreshToken: "r1"}
+	second := &oauth2.Token{AccessToken: "a2", RefreshToken: "r2"}
+
+	swapped, err := store.CompareAndSwap(t.Context(), nil, first)
+	require.NoError(t, err)
+	assert.True(t, swapped)
+
+	swapped, err = store.CompareAndSwap(t.Context(), nil, second)
+	require.NoError(t, err)
+	assert.False(t, swapped, "stale expectation must not overwrite")
+
+	swapped, err = store.CompareAndSwap(t.Context(), first, second)
+	require.NoError(t, err)
+	assert.True(t, swapped)
+
+	token, err = store.Load(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "r2", token.RefreshToken)
+
+	release, err := store.Acquire(t.Context())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = store.Acquire(ctx)
+	require.ErrorIs(t, err, ErrTokenLeaseTimeout)
+
+	require.NoError(t, release())
+
+	release, err = store.Acquire(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, release())
+}
+
+func TestFileTokenStoreLeaseIsExclusive(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	var (
+		active    atomic.Int32
+		maxActive atomic.Int32
+		waitGroup sync.WaitGroup
+	)
+
+	// Every goroutine has its own store, like separate processes sharing the file.
+	for range 8 {
+		waitGroup.Go(func() {
+			store := NewFileTokenStore(path)
+
+			for range 5 {
+				release, err := store.Acquire(t.Context())
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				holders := active.Add(1)
+				for current := maxActive.Load(); holders > current; current = maxActive.Load() {
+					maxActive.CompareAndSwap(current, holders)
+				}
+
+				time.Sleep(time.Millisecond)
+				active.Add(-1)
+
+				assert.NoError(t, release())
+			}
+		})
+	}
+
+	waitGroup.Wait()
+
+	assert.Equal(t, int32(1), maxActive.Load(), "lease must have a single holder at a time")
+}
+
+func TestFileTokenStoreStaleLeaseTakeover(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token.json")
+	lockPath := path + ".lease"
+	ttl := time.Minute
+
+	expire := func() {
+		past := time.Now().Add(-2 * ttl)
+		require.NoError(t, os.Chtimes(lockPath, past, past))
+	}
+
+	crashed := &FileTokenStore{Path: path, LeaseTTL: ttl}
+
+	releaseCrashed, err := crashed.Acquire(t.Context())
+	require.NoError(t, err)
+
+	expire()
+
+	var (
+		active    atomic.Int32
+		maxActive atomic.Int32
+		acquired  = make(chan func() error, 8)
+		waitGroup sync.WaitGroup
+	)
+
+	// Waiters race to take over the stale lock, only one of them may hold it.
+	for range 8 {
+		waitGroup.Go(func() {
+			store := &FileTokenStore{Path: path, LeaseTTL: ttl}
+
+			release, err := store.Acquire(t.Context())
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			holders := active.Add(1)
+			for current := maxActive.Load(); holders > current; current = maxActive.Load() {
+				maxActive.CompareAndSwap(current, holders)
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			active.Add(-1)
+
+			acquired <- release
+		})
+	}
+
+	// The first holder keeps the lock until the stale holder tried to release it.
+	successor := <-acquired
+
+	require.ErrorIs(t, releaseCrashed(), ErrTokenLeaseLost)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = (&FileTokenStore{Path: path, LeaseTTL: ttl}).Acquire(ctx)
+	require.ErrorIs(t, err, ErrTokenLeaseTimeout, "stale holder must not remove the lock of its successor")
+
+	require.NoError(t, successor())
+
+	for range 7 {
+		require.NoError(t, (<-acquired)())
+	}
+
+	waitGroup.Wait()
+
+	assert.Equal(t, int32(1), maxActive.Load(), "stale lock must be taken over by a single waiter")
+}
+
+// racingTokenStore lets a peer store an expired token right before the first swap.
+type racingTokenStore struct {
+	*MemoryTokenStore
+
+	raced atomic.Bool
+	peer  *oauth2.Token
+}
+
+func (s *racingTokenStore) CompareAndSwap(ctx context.Context, old, next *oauth2.Token) (bool, error) {
+	if s.raced.CompareAndSwap(false, true) {
+		if _, err := s.MemoryTokenStore.CompareAndSwap(ctx, old, s.peer); err != nil {
+			return false, err
+		}
+	}
+
+	return s.MemoryTokenStore.CompareAndSwap(ctx, old, next)
+}
+
+func TestTokenStoreReplacesExpiredPeerToken(t *testing.T) {
+	t.Parallel()
+
+	var refreshes atomic.Int32
+
+	server := rotatingTokenServer(t, &refreshes)
+	expired := &oauth2.Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Hour)}
+	peer := &oauth2.Token{AccessToken: "peer", RefreshToken: "refresh-peer", Expiry: time.Now().Add(-time.Minute)}
+	store := &racingTokenStore{MemoryTokenStore: NewMemoryTokenStore(expired), peer: peer}
+
+	source := newCoordinatedTokenSource(&oauthClientParams{
+		client:     server.Client(),
+		config:     &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}},
+		token:      expired,
+		tokenStore: store,
+	})
+
+	token, err := source.TokenWithContext(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", token.AccessToken)
+
+	stored, err := store.Load(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-1", stored.RefreshToken, "refreshed token must be stored over the expired peer token")
+}
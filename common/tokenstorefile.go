@@ -0,0 +1,215 @@
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// defaultFileLeaseTTL bounds how long a crashed holder blocks others.
+	defaultFileLeaseTTL = 30 * time.Second
+	// fileLockPollInterval is how often a waiting process retries the lock file.
+	fileLockPollInterval = 25 * time.Millisecond
+	// tokenFileMode keeps token files private to the owner.
+	tokenFileMode = 0o600
+)
+
+// FileTokenStore is a TokenStore and TokenLeaser for processes sharing a file system.
+// The token is stored as JSON at Path. Locks are files created exclusively next to it,
+// which works on every platform and on most network file systems.
+type FileTokenStore struct {
+	// Path of the JSON token file.
+	Path string
+	// LeaseTTL after which an abandoned lease is considered stale. Defaults to 30 seconds.
+	LeaseTTL time.Duration
+}
+
+var (
+	_ TokenStore  = (*FileTokenStore)(nil)
+	_ TokenLeaser = (*FileTokenStore)(nil)
+)
+
+// NewFileTokenStore creates a store backed by the token file.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path, LeaseTTL: defaultFileLeaseTTL}
+}
+
+func (s *FileTokenStore) Load(ctx context.Context) (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil // nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("reading token file %s: %w", s.Path, err)
+	}
+
+	return token, nil
+}
+
+func (s *FileTokenStore) CompareAndSwap(ctx context.Context, old, next *oauth2.Token) (bool, error) {
+	release, err := s.lock(ctx, s.Path+".cas")
+	if err != nil {
+		return false, err
+	}
+
+	defer release() // nolint:errcheck
+
+	current, err := s.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !sameToken(current, old) {
+		return false, nil
+	}
+
+	data, err := json.Marshal(next)
+	if err != nil {
+		return false, err
+	}
+
+	// Write and rename, so that readers never observe a partially written token.
+	temp := s.Path + ".tmp"
+	if err := os.WriteFile(temp, data, tokenFileMode); err != nil {
+		return false, err
+	}
+
+	if err := os.Rename(temp, s.Path); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *FileTokenStore) Acquire(ctx context.Context) (func() error, error) {
+	return s.lock(ctx, s.Path+".lease")
+}
+
+// lock creates the lock file exclusively, waiting for the current holder
+// and taking over lock files older than the lease TTL.
+//
+// Every lock file holds a random owner token. The lock is only ever removed by whoever
+// still finds the expected token in it, so a holder whose lease went stale cannot
+// remove the lock of the process which took over, and of two waiters taking over
+// the same stale lock only one succeeds.
+func (s *FileTokenStore) lock(ctx context.Context, path string) (func() error, error) {
+	ttl := s.LeaseTTL
+	if ttl <= 0 {
+		ttl = defaultFileLeaseTTL
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil { // nolint:mnd
+		return nil, err
+	}
+
+	owner := rand.Text()
+
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, tokenFileMode)
+		if err == nil {
+			_, writeErr := file.WriteString(owner)
+			if err := errors.Join(writeErr, file.Close()); err != nil {
+				_, _ = removeLockOf(path, owner)
+
+				return nil, err
+			}
+
+			return func() error {
+				removed, err := removeLockOf(path, owner)
+				if err != nil {
+					return err
+				}
+
+				if !removed {
+					return ErrTokenLeaseLost
+				}
+
+				return nil
+			}, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if holder, stale := staleLockOwner(path, ttl); stale {
+			// The holder did not release the lock in time, most likely it crashed.
+			if _, err := removeLockOf(path, holder); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(ErrTokenLeaseTimeout, ctx.Err())
+		case <-time.After(fileLockPollInterval):
+		}
+	}
+}
+
+// staleLockOwner returns the owner token of the lock file when it is older than the TTL.
+// The token and the age are read from the same open file, so they belong to the same lock.
+func staleLockOwner(path string, ttl time.Duration) (string, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+
+	defer file.Close() // nolint:errcheck
+
+	info, err := file.Stat()
+	if err != nil || time.Since(info.ModTime()) <= ttl {
+		return "", false
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", false
+	}
+
+	return string(data), true
+}
+
+// removeLockOf removes the lock file only if it holds the owner token, reporting whether it did.
+// The lock is first renamed to a unique name, which only one process can do, then its token is checked.
+// A lock of another owner is put back, unless a new lock was created in the meantime.
+func removeLockOf(path, owner string) (bool, error) {
+	claimed := path + "." + rand.Text()
+
+	if err := os.Rename(path, claimed); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	data, err := os.ReadFile(claimed)
+	if err == nil && string(data) == owner {
+		return true, os.Remove(claimed)
+	}
+
+	if linkErr := os.Link(claimed, path); linkErr != nil && !errors.Is(linkErr, fs.ErrExist) {
+		err = errors.Join(err, linkErr)
+	}
+
+	return false, errors.Join(err, os.Remove(claimed))
+}
@@ -0,0 +1,164 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// maxStoreAttempts bounds how often a refreshed token is stored over tokens written concurrently by peers.
+const maxStoreAttempts = 3
+
+// coordinatedTokenSource refreshes the token on behalf of all processes sharing a TokenStore.
+// Before refreshing it re-reads the stored token and adopts it if a peer has already refreshed,
+// so that a rotated refresh token is never used twice.
+type coordinatedTokenSource struct {
+	mut     sync.Mutex
+	config  *oauth2.Config
+	client  *http.Client
+	store   TokenStore
+	leaser  TokenLeaser
+	current *oauth2.Token
+}
+
+func newCoordinatedTokenSource(params *oauthClientParams) *coordinatedTokenSource {
+	return &coordinatedTokenSource{
+		config:  params.config,
+		client:  params.client,
+		store:   params.tokenStore,
+		leaser:  params.tokenLeaser,
+		current: params.token,
+	}
+}
+
+func (s *coordinatedTokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenWithContext(context.Background())
+}
+
+func (s *coordinatedTokenSource) TokenWithContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.current.Valid() {
+		return s.current, nil
+	}
+
+	if adopted, err := s.adoptStored(ctx); err != nil || adopted {
+		return s.current, err
+	}
+
+	if s.leaser != nil {
+		release, err := s.leaser.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		defer release() // nolint:errcheck
+
+		// The previous lease holder may have refreshed while we were waiting.
+		if adopted, err := s.adoptStored(ctx); err != nil || adopted {
+			return s.current, err
+		}
+	}
+
+	return s.refresh(ctx)
+}
+
+// adoptStored replaces the current token with the stored one when the stored token is still valid.
+func (s *coordinatedTokenSource) adoptStored(ctx context.Context) (bool, error) {
+	stored, err := s.store.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !stored.Valid() {
+		return false, nil
+	}
+
+	s.current = stored
+
+	return true, nil
+}
+
+func (s *coordinatedTokenSource) refresh(ctx context.Context) (*oauth2.Token, error) {
+	stored, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	base := stored
+	if base == nil {
+		base = s.current
+	}
+
+	if base == nil {
+		return nil, ErrMissingRefreshToken
+	}
+
+	// Force a refresh, the library returns the token as is while it looks valid.
+	expired := *base
+	expired.AccessToken = ""
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, s.client)
+
+	refreshed, err := s.config.TokenSource(ctx, &expired).Token()
+	if err != nil {
+		if isInvalidGrant(err) {
+			// Without a lease a peer may have rotated the refresh token in the meantime.
+			if adopted, loadErr := s.adoptStored(ctx); loadErr == nil && adopted {
+				return s.current, nil
+			}
+
+			return nil, errors.Join(ErrInvalidGrant, err)
+		}
+
+		return nil, err
+	}
+
+	return s.storeRefreshed(ctx, stored, refreshed)
+}
+
+// storeRefreshed stores the refreshed token in place of the token it was refreshed from.
+// When a peer stored a token concurrently, theirs is preferred while it is valid,
+// otherwise ours replaces it, so that the rotated refresh token is never kept only in memory.
+func (s *coordinatedTokenSource) storeRefreshed(
+	ctx context.Context, stored, refreshed *oauth2.Token,
+) (*oauth2.Token, error) {
+	for range maxStoreAttempts {
+		swapped, err := s.store.CompareAndSwap(ctx, stored, refreshed)
+		if err != nil {
+			return nil, err
+		}
+
+		if swapped {
+			s.current = refreshed
+
+			return refreshed, nil
+		}
+
+		stored, err = s.store.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if stored.Valid() {
+			s.current = stored
+
+			return stored, nil
+		}
+	}
+
+	return nil, ErrTokenStoreConflict
+}
+
+func isInvalidGrant(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return retrieveErr.ErrorCode == "invalid_grant"
+	}
+
+	return errors.Is(transformOauth2LibraryError(err), ErrInvalidGrant)
+}
@@ -1,5 +1,7 @@
 package common
 
+import "fmt"
+
 // FilterBy returns a new SearchFilter with an additional FieldFilter appended.
 //
 // The receiver is treated as immutable: FilterBy does not modify the original
@@ -25,3 +27,28 @@ func (s SearchFilter) FilterBy(fieldName string, operator FilterOperator, value
 
 	return SearchFilter{FieldFilters: newFilters}
 }
+
+// InValues returns the values of an IN filter, given either as a []string or a []any.
+// Any other value, or an empty list, is rejected as a list of no values would match nothing.
+func (f FieldFilter) InValues() ([]any, error) {
+	var values []any
+
+	switch list := f.Value.(type) {
+	case []string:
+		values = make([]any, len(list))
+		for index, value := range list {
+			values[index] = value
+		}
+	case []any:
+		values = list
+	default:
+		return nil, fmt.Errorf("%w: %s filter on %s expects a list of values, got %T",
+			ErrBadRequest, f.Operator, f.FieldName, f.Value)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w: %s filter on %s has no values", ErrBadRequest, f.Operator, f.FieldName)
+	}
+
+	return values, nil
+}
@@ -226,7 +226,8 @@ type ReadParams struct {
 	Filter string // optional
 
 	// BuilderFilter is an optional Ampersand-style structured filter for read actions.
-	// Multiple field filters are joined by AND. Only the "eq" operator is supported.
+	// Multiple field filters are joined by AND. Only the "eq" and "in" operators are supported,
+	// the value of "in" is a list of values.
 	BuilderFilter *SearchFilter // optional
 
 	// AssociatedObjects specifies a list of related objects to fetch along with the main object.
@@ -237,11 +238,20 @@ type ReadParams struct {
 	//		Reference: https://docs.stripe.com/expand#how-it-works
 	//	* Capsule: Embeds objects in response.
 	//		Reference: https://developer.capsulecrm.com/v2/overview/reading-from-the-api
+	// Other connectors whose metadata populates FieldMetadata.ReferenceTo can be wrapped
+	// with the joins package, which resolves associated objects through lookup fields.
 	AssociatedObjects []string // optional
 
 	// PageSize specifies the # of records to request when making a read request.
 	PageSize int // optional
 
+	// NormalizeValues requests that ReadResultRow.Fields be coerced into canonical forms
+	// based on FieldMetadata.ValueType: RFC3339 UTC timestamps, int64/float64 numbers,
+	// booleans and []string multi-selects. ReadResultRow.Raw is always left untouched.
+	// Only supported by connectors whose reader is wrapped with reader.NormalizingReader,
+	// other connectors reject it with ErrNotImplemented.
+	NormalizeValues bool // optional
+
 	// Additional options for the read operation that the connector may support.
 	// This optional map is used for bespoke connector-specific parameters.
 	Opts ReadParamsOpts // optional
@@ -276,6 +286,19 @@ type WriteParams struct {
 	Associations any // optional
 
 	Headers []WriteHeader // optional
+
+	// UpsertKey matches the record to update by a unique field instead of RecordId,
+	// a record is created when none matches. Use connectors.Upsert to write with it,
+	// Write of a connector which doesn't support it returns ErrOperationNotSupportedForObject.
+	UpsertKey *UpsertKey // optional
+}
+
+// UpsertKey identifies a record by a natural key, such as an email, a domain or an external ID field.
+type UpsertKey struct {
+	// Field is the provider name of a field holding unique values.
+	Field string
+	// Value is matched exactly.
+	Value any
 }
 
 // GetRecord converts WriteParams.RecordData into a map-based Record.
@@ -302,6 +325,12 @@ func (p WriteParams) IsUpdate() bool {
 	return p.RecordId != ""
 }
 
+// IsUpsert reports whether the record is matched by UpsertKey.
+// Such a write is neither a create nor an update until the provider matches the key.
+func (p WriteParams) IsUpsert() bool {
+	return p.UpsertKey != nil
+}
+
 func (p WriteParams) GetRecordReader() (*bytes.Reader, error) {
 	jsonData, err := json.Marshal(p.RecordData)
 	if err != nil {
@@ -368,6 +397,23 @@ type DeleteParams struct {
 	Headers []WriteHeader // optional
 }
 
+// MergeParams defines how duplicate records are merged into one.
+type MergeParams struct {
+	// The name of the object whose records are merged, e.g. "contacts".
+	ObjectName string // required
+
+	// WinnerId is the ID of the record which survives the merge.
+	WinnerId string // required
+
+	// LoserIds are the IDs of the duplicates merged into the winner.
+	// Providers delete or archive them once merged.
+	LoserIds []string // required
+
+	// FieldValues selects the values the merged record ends up with, keyed by field name.
+	// Fields which are not listed keep the value chosen by the provider, usually the winner's.
+	FieldValues map[string]any // optional
+}
+
 // NextPageToken is an opaque token that can be used to get the next page of results.
 // Callers are encouraged to treat this as an opaque string, and not attempt to parse it.
 // And although each provider will be different, callers should expect that this token
@@ -403,6 +449,8 @@ type ReadResultRow struct {
 	Raw map[string]any `json:"raw"`
 	// RecordId is the ID of the record.
 	Id string `json:"id,omitempty"`
+	// ObjectName is the object of the record, set when results span several objects.
+	ObjectName string `json:"objectName,omitempty"`
 }
 
 // Association is a struct that represents an association between two objects.
@@ -427,14 +475,38 @@ type WriteResult struct {
 	Errors []any `json:"errors,omitempty"` // optional
 	// Data is a JSON node containing data about the properties that were updated.
 	Data map[string]any `json:"data,omitempty"` // optional
+	// Outcome tells whether an upsert created or updated the record, it is empty for other writes.
+	Outcome WriteOutcome `json:"outcome,omitempty"` // optional
 }
 
+// WriteOutcome describes what a write by WriteParams.UpsertKey did.
+type WriteOutcome string
+
+const (
+	WriteOutcomeCreated WriteOutcome = "created"
+	WriteOutcomeUpdated WriteOutcome = "updated"
+)
+
 // DeleteResult represents the outcome of a single record delete operation.
 type DeleteResult struct {
 	// Success is true if deletion succeeded.
 	Success bool `json:"success"`
 }
 
+// MergeResult represents the outcome of a merge operation.
+type MergeResult struct {
+	// Success is true if all losers were merged into the winner.
+	Success bool `json:"success"`
+	// RecordId is the ID of the merged record. Some providers assign a new ID rather than keeping the winner's.
+	RecordId string `json:"recordId,omitempty"`
+	// MergedIds lists the IDs of the records merged into the winner.
+	MergedIds []string `json:"mergedIds,omitempty"`
+	// Errors is list of error record returned by the API.
+	Errors []any `json:"errors,omitempty"` // optional
+	// Data is the merged record, when returned by the provider.
+	Data map[string]any `json:"data,omitempty"` // optional
+}
+
 // BatchStatus describes the aggregate outcome of a batch operation.
 type BatchStatus string
 
@@ -573,6 +645,137 @@ type BatchWriteResult struct {
 	FailureCount int `json:"failureCount"`
 }
 
+// BulkWriteParams defines records written asynchronously by a bulk write job.
+// Unlike BatchWriteParam, the number of records is limited only by the provider's file size limits.
+type BulkWriteParams struct {
+	// ObjectName identifies the target object for the write operation.
+	ObjectName ObjectName // required
+	// Type defines how the records should be processed: create, update, or upsert.
+	Type WriteType // required
+	// Records to write, each maps field names to values.
+	Records []Record // required
+	// IDField is the unique field used to match existing records. Required for update and upsert.
+	IDField string
+	// Associations link every written record to a record of another object.
+	Associations []BulkWriteAssociation // optional
+	// Name labels the job in the provider's UI.
+	Name string // optional
+}
+
+// BulkWriteAssociation describes a record field holding the identifier of an associated record.
+type BulkWriteAssociation struct {
+	// Field of the written record which holds the identifier, it is not written as a value.
+	Field string
+	// ObjectName is the object of the associated record.
+	ObjectName ObjectName
+	// IDField is the unique field of the associated object matched against the identifier.
+	// When empty, the identifier is the provider's record ID.
+	IDField string // optional
+	// Label selects the type of association, provider-specific.
+	Label string // optional
+}
+
+// BulkWriteJobState is the provider-agnostic state of a bulk write job.
+type BulkWriteJobState string
+
+const (
+	BulkWriteJobStatePending    BulkWriteJobState = "pending"
+	BulkWriteJobStateInProgress BulkWriteJobState = "inProgress"
+	BulkWriteJobStateDone       BulkWriteJobState = "done"
+	BulkWriteJobStateFailed     BulkWriteJobState = "failed"
+	BulkWriteJobStateCanceled   BulkWriteJobState = "canceled"
+)
+
+// BulkWriteJob describes the progress of a bulk write job.
+type BulkWriteJob struct {
+	// JobID identifies the job in the provider.
+	JobID string `json:"jobId"`
+	// State is the normalized state of the job.
+	State BulkWriteJobState `json:"state"`
+	// ProviderState is the state exactly as reported by the provider.
+	ProviderState string `json:"providerState"`
+	// RecordsProcessed is the number of records read by the provider so far.
+	RecordsProcessed int64 `json:"recordsProcessed"`
+	// Raw is the provider's description of the job.
+	Raw map[string]any `json:"raw,omitempty"`
+}
+
+// IsDone reports whether the job will not make any more progress.
+func (j BulkWriteJob) IsDone() bool {
+	return j.State == BulkWriteJobStateDone ||
+		j.State == BulkWriteJobStateFailed ||
+		j.State == BulkWriteJobStateCanceled
+}
+
+// BulkWriteErrorsResult is a page of records which a bulk write job failed to write.
+type BulkWriteErrorsResult struct {
+	// Errors describes each failed record.
+	Errors []BulkWriteRecordError `json:"errors"`
+	// NextPage is the token for the next page of errors.
+	NextPage NextPageToken `json:"nextPage,omitempty"`
+	// Done is true when there are no more pages.
+	Done bool `json:"done"`
+}
+
+// BulkWriteRecordError is the reason a record of a bulk write job was not written.
+type BulkWriteRecordError struct {
+	// RecordIndex is the position of the record in BulkWriteParams.Records, or -1 when it is not known.
+	RecordIndex int `json:"recordIndex"`
+	// Code is the provider's error type.
+	Code string `json:"code"`
+	// Message describes the error.
+	Message string `json:"message,omitempty"`
+	// Value is the offending value, if the provider reports it.
+	Value any `json:"value,omitempty"`
+	// Raw is the provider's description of the error.
+	Raw map[string]any `json:"raw,omitempty"`
+}
+
+// FieldHistoryParams defines which field changes of an object are read.
+type FieldHistoryParams struct {
+	// ObjectName identifies the object whose records have tracked fields.
+	ObjectName ObjectName // required
+	// Fields lists the fields whose changes are returned.
+	Fields datautils.StringSet // required
+	// RecordIDs limits the history to these records.
+	RecordIDs []string // optional
+	// Since returns only the changes made at or after this time.
+	Since time.Time // optional
+	// Archived reads the history moved by the provider to long-term storage.
+	// Providers that keep the whole history in one place ignore it.
+	Archived bool // optional
+	// NextPage is the token returned by the previous page.
+	NextPage NextPageToken // optional
+}
+
+// FieldHistoryResult is a page of field changes.
+type FieldHistoryResult struct {
+	// Rows lists the changes.
+	Rows []FieldChange `json:"rows"`
+	// NextPage is the token for the next page of changes.
+	NextPage NextPageToken `json:"nextPage,omitempty"`
+	// Done is true when there are no more pages.
+	Done bool `json:"done"`
+}
+
+// FieldChange is a single change of a field value of a record.
+type FieldChange struct {
+	// RecordID identifies the changed record.
+	RecordID string `json:"recordId"`
+	// Field is the name of the changed field.
+	Field string `json:"field"`
+	// OldValue is the value before the change, nil when the field had no value.
+	OldValue any `json:"oldValue"`
+	// NewValue is the value after the change, nil when the value was cleared.
+	NewValue any `json:"newValue"`
+	// ChangedAt is when the change was made.
+	ChangedAt time.Time `json:"changedAt"`
+	// ChangedBy identifies who or what made the change, provider-specific.
+	ChangedBy string `json:"changedBy,omitempty"`
+	// Raw is the provider's description of the change.
+	Raw map[string]any `json:"raw,omitempty"`
+}
+
 // WriteMethod is signature for any HTTP method that performs write modifications.
 // Ex: Post/Put/Patch.
 type WriteMethod func(context.Context, string, any, ...Header) (*JSONHTTPResponse, error)
@@ -1137,6 +1340,8 @@ type FilterOperator string
 
 const (
 	FilterOperatorEQ FilterOperator = "eq"
+	// FilterOperatorIN matches any of the values, given as a non-empty []string or []any.
+	FilterOperatorIN FilterOperator = "in"
 )
 
 type SearchParams struct {
@@ -1153,6 +1358,37 @@ type SearchParams struct {
 	// AssociatedObjects specifies a list of related objects to fetch along with the main object.
 	// Optional.
 	AssociatedObjects []string `json:"associatedObjects,omitempty"`
+
+	// Text switches to a free-text search, the Filter is not used then.
+	// Only providers with full-text search support it, see ValidateTextSearch.
+	// Optional.
+	Text *TextSearch `json:"text,omitempty"`
 }
 
+// IsTextSearch reports whether records are searched by a free-text term instead of field filters.
+func (p SearchParams) IsTextSearch() bool {
+	return p.Text != nil
+}
+
+// TextSearch finds records by a term matched against their searchable fields,
+// the way the global search bar of a provider does.
+type TextSearch struct {
+	// Term is matched literally, characters reserved by the provider's search syntax are escaped.
+	Term string `json:"term"`
+	// Scope restricts the matched fields, all searchable fields are matched by default.
+	Scope TextSearchScope `json:"scope,omitempty"`
+	// AdditionalObjects are searched along with SearchParams.ObjectName, mapped to the fields to return.
+	// Rows of results spanning several objects tell their object by ReadResultRow.ObjectName.
+	AdditionalObjects map[string]datautils.StringSet `json:"additionalObjects,omitempty"`
+}
+
+type TextSearchScope string
+
+const (
+	TextSearchScopeAll   TextSearchScope = "all"
+	TextSearchScopeName  TextSearchScope = "name"
+	TextSearchScopeEmail TextSearchScope = "email"
+	TextSearchScopePhone TextSearchScope = "phone"
+)
+
 type SearchResult = ReadResult
@@ -3,6 +3,8 @@ package common
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
@@ -31,8 +33,32 @@ var (
 	// ErrMissingSearchFilters is returned when no field filters are provided for the Search operation.
 	ErrMissingSearchFilters = errors.New("no filters provided for Search operation")
 
+	// ErrMissingSearchTerm is returned when a free-text search has no term.
+	ErrMissingSearchTerm = errors.New("no term provided for text search")
+
 	// ErrPaginationControl is returned when controlling page size is not supported by connector..
 	ErrPaginationControl = errors.New("pagination cannot be controlled by page size")
+
+	// ErrMissingUpsertKey is returned when the upsert key has no field or value.
+	ErrMissingUpsertKey = errors.New("upsert key requires field and value")
+
+	// ErrUpsertKeyWithRecordID is returned when both the record ID and the upsert key are given.
+	ErrUpsertKeyWithRecordID = errors.New("upsert key cannot be combined with record ID")
+
+	// ErrUpsertKeyNotUnique is returned when more than one record matches the upsert key.
+	ErrUpsertKeyNotUnique = errors.New("upsert key matches multiple records")
+
+	// ErrMissingMergeRecords is returned when there are no records to merge into the winner.
+	ErrMissingMergeRecords = errors.New("no records to merge provided")
+
+	// ErrMergeWinnerIsLoser is returned when the winner is also listed among the records merged into it.
+	ErrMergeWinnerIsLoser = errors.New("record cannot be merged into itself")
+
+	// ErrMissingBulkWriteIDField is returned when a bulk update or upsert has no field to match records by.
+	ErrMissingBulkWriteIDField = errors.New("bulk write requires ID field to match records")
+
+	// ErrInvalidBulkWriteAssociation is returned when a bulk write association has no field or object.
+	ErrInvalidBulkWriteAssociation = errors.New("bulk write association requires field and object")
 )
 
 func (p ReadParams) ValidateParams(withRequiredFields bool) error {
@@ -44,6 +70,11 @@ func (p ReadParams) ValidateParams(withRequiredFields bool) error {
 		return ErrMissingFields
 	}
 
+	// Readers honoring the flag are wrapped with reader.NormalizingReader, which clears it before reading.
+	if p.NormalizeValues {
+		return fmt.Errorf("%w: value normalization is not supported by this connector", ErrNotImplemented)
+	}
+
 	// If both 'since' and 'until' are set, ensure correct chronological order.
 	if !p.Since.IsZero() && !p.Until.IsZero() {
 		// Until must be after since, otherwise error.
@@ -55,7 +86,40 @@ func (p ReadParams) ValidateParams(withRequiredFields bool) error {
 	return nil
 }
 
+// ValidateParams validates a write of a connector which doesn't honor UpsertKey,
+// therefore an upsert key is rejected. Writers honoring it use ValidateUpsertParams instead.
 func (p WriteParams) ValidateParams() error {
+	if err := p.validateRecord(); err != nil {
+		return err
+	}
+
+	if p.UpsertKey != nil {
+		return fmt.Errorf("%w: %s cannot be upserted", ErrOperationNotSupportedForObject, p.ObjectName)
+	}
+
+	return nil
+}
+
+// ValidateUpsertParams validates a write of a connector which honors UpsertKey.
+func (p WriteParams) ValidateUpsertParams() error {
+	if err := p.validateRecord(); err != nil {
+		return err
+	}
+
+	if p.UpsertKey != nil {
+		if p.RecordId != "" {
+			return ErrUpsertKeyWithRecordID
+		}
+
+		if p.UpsertKey.Field == "" || p.UpsertKey.Value == nil {
+			return ErrMissingUpsertKey
+		}
+	}
+
+	return nil
+}
+
+func (p WriteParams) validateRecord() error {
 	if len(p.ObjectName) == 0 {
 		return ErrMissingObjects
 	}
@@ -79,6 +143,32 @@ func (p DeleteParams) ValidateParams() error {
 	return nil
 }
 
+func (p MergeParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.WinnerId) == 0 {
+		return ErrMissingRecordID
+	}
+
+	if len(p.LoserIds) == 0 {
+		return ErrMissingMergeRecords
+	}
+
+	for _, loserID := range p.LoserIds {
+		if len(loserID) == 0 {
+			return ErrMissingRecordID
+		}
+
+		if loserID == p.WinnerId {
+			return ErrMergeWinnerIsLoser
+		}
+	}
+
+	return nil
+}
+
 var (
 	// ErrUnknownWriteType is returned when enum option for the write type is invalid.
 	ErrUnknownWriteType = errors.New("unknown write type")
@@ -103,6 +193,44 @@ func (p BatchWriteParam) ValidateParams() error {
 	return nil
 }
 
+func (p BulkWriteParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if p.Type != WriteTypeCreate && p.Type != WriteTypeUpdate && p.Type != WriteTypeUpsert {
+		return ErrUnknownWriteType
+	}
+
+	if len(p.Records) == 0 {
+		return ErrMissingRecordData
+	}
+
+	if p.Type != WriteTypeCreate && len(p.IDField) == 0 {
+		return ErrMissingBulkWriteIDField
+	}
+
+	for _, association := range p.Associations {
+		if len(association.Field) == 0 || len(association.ObjectName) == 0 {
+			return ErrInvalidBulkWriteAssociation
+		}
+	}
+
+	return nil
+}
+
+func (p FieldHistoryParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	return nil
+}
+
 func (p *UpsertMetadataParams) ValidateParams() error {
 	if p == nil {
 		return ErrMissingFieldsMetadata
@@ -120,18 +248,24 @@ func (p *DeleteMetadataParams) ValidateParams() error {
 		return ErrMissingFieldsMetadata
 	}
 
-	if len(p.Fields) == 0 {
+	if len(p.Fields) == 0 && len(p.FieldGroups) == 0 && len(p.Objects) == 0 {
 		return ErrMissingFieldsMetadata
 	}
 
 	return nil
 }
 
+// ValidateParams validates parameters of a search by field filters.
+// A free-text search is rejected here, providers supporting it validate it with ValidateTextSearch.
 func (p SearchParams) ValidateParams(withRequiredFields bool) error {
 	if len(p.ObjectName) == 0 {
 		return ErrMissingObjects
 	}
 
+	if p.IsTextSearch() {
+		return fmt.Errorf("%w: text search", ErrOperationNotSupportedForObject)
+	}
+
 	if withRequiredFields && len(p.Fields) == 0 {
 		return ErrMissingFields
 	}
@@ -143,6 +277,29 @@ func (p SearchParams) ValidateParams(withRequiredFields bool) error {
 	return nil
 }
 
+// ValidateTextSearch validates parameters of a free-text search, see SearchParams.Text.
+func (p SearchParams) ValidateTextSearch(withRequiredFields bool) error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if withRequiredFields && len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	if p.Text == nil || strings.TrimSpace(p.Text.Term) == "" {
+		return ErrMissingSearchTerm
+	}
+
+	for objectName, fields := range p.Text.AdditionalObjects {
+		if withRequiredFields && len(fields) == 0 {
+			return fmt.Errorf("%w: %s", ErrMissingFields, objectName)
+		}
+	}
+
+	return nil
+}
+
 func (p SubscribeParams) ValidateParams() error {
 	if len(p.SubscriptionEvents) == 0 {
 		return ErrMissingObjects
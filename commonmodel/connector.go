@@ -0,0 +1,364 @@
+package commonmodel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// Connector exposes a provider connector through the canonical objects of a Model.
+//
+// Object and field names in ReadParams, WriteParams and ListObjectMetadata are canonical.
+// The wrapped connector must implement the corresponding connectors interface,
+// otherwise the operation returns common.ErrNotImplemented.
+type Connector struct {
+	connectors.Connector
+
+	model   *Model
+	mapping ProviderMapping
+}
+
+var (
+	_ connectors.ReadConnector           = (*Connector)(nil)
+	_ connectors.WriteConnector          = (*Connector)(nil)
+	_ connectors.ObjectMetadataConnector = (*Connector)(nil)
+)
+
+// NewConnector wraps the provider connector with the canonical model.
+func NewConnector(model *Model, conn connectors.Connector) (*Connector, error) {
+	mapping, ok := model.Mappings[conn.Provider()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s model, provider %s", ErrProviderNotMapped, model.Name, conn.Provider())
+	}
+
+	return &Connector{
+		Connector: conn,
+		model:     model,
+		mapping:   mapping,
+	}, nil
+}
+
+// Model returns the common model used by this connector.
+func (c *Connector) Model() *Model {
+	return c.model
+}
+
+// Read reads canonical records. Requested fields which are not part of the canonical object
+// are treated as provider fields and returned under ExtensionsField.
+func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	reader, ok := c.Connector.(connectors.ReadConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support read", common.ErrNotImplemented, c.Provider())
+	}
+
+	object, err := c.lookup(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if object.mapping.WriteOnly {
+		return nil, fmt.Errorf("%w: %s cannot be read from %s",
+			common.ErrOperationNotSupportedForObject, params.ObjectName, c.Provider())
+	}
+
+	providerParams := params
+	providerParams.ObjectName = object.mapping.ObjectName
+	providerParams.Fields, object.extensions = object.providerFields(params.Fields.List())
+	providerParams.BuilderFilter = object.providerFilter(params.BuilderFilter)
+
+	result, err := reader.Read(ctx, providerParams)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]common.ReadResultRow, 0, len(result.Data))
+
+	for _, row := range result.Data {
+		if !object.matches(row.Fields) {
+			continue
+		}
+
+		fields, err := object.toCanonical(row.Fields, params.Fields.List())
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, common.ReadResultRow{
+			Fields:       fields,
+			Associations: row.Associations,
+			Raw:          row.Raw,
+			Id:           row.Id,
+		})
+	}
+
+	return &common.ReadResult{
+		Rows:     int64(len(rows)),
+		Data:     rows,
+		NextPage: result.NextPage,
+		Done:     result.Done,
+	}, nil
+}
+
+// Write creates or updates a canonical record.
+// RecordData must be a map keyed by canonical field names, optionally containing ExtensionsField
+// with provider fields which are written as is.
+func (c *Connector) Write(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	writer, ok := c.Connector.(connectors.WriteConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support write", common.ErrNotImplemented, c.Provider())
+	}
+
+	object, err := c.lookup(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := common.RecordDataToMap(params.RecordData)
+	if err != nil {
+		return nil, err
+	}
+
+	providerRecord, err := object.toProvider(record)
+	if err != nil {
+		return nil, err
+	}
+
+	providerParams := params
+	providerParams.ObjectName = object.mapping.ObjectName
+	providerParams.RecordData = providerRecord
+
+	if object.mapping.PrepareWrite != nil {
+		providerParams, err = object.mapping.PrepareWrite(providerParams, providerRecord)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return writer.Write(ctx, providerParams)
+}
+
+// ListObjectMetadata describes canonical objects, limited to fields the provider mapping supports.
+func (c *Connector) ListObjectMetadata(
+	ctx context.Context, objectNames []string,
+) (*common.ListObjectMetadataResult, error) {
+	result := common.NewListObjectMetadataResult()
+
+	for _, objectName := range objectNames {
+		object, err := c.lookup(objectName)
+		if err != nil {
+			result.AppendError(objectName, err)
+
+			continue
+		}
+
+		fields := make(common.FieldsMetadata)
+
+		for _, field := range object.definition.Fields {
+			mapping, ok := object.mapping.Fields[field.Name]
+			if !ok {
+				continue
+			}
+
+			fields[field.Name] = common.FieldMetadata{
+				DisplayName:  field.DisplayName,
+				ValueType:    field.ValueType,
+				ProviderType: mapping.Field,
+				ReadOnly:     new(field.ReadOnly || mapping.ReadOnly),
+				IsCustom:     new(false),
+				Values:       fieldValues(field.Values),
+			}
+		}
+
+		result.Result[objectName] = *common.NewObjectMetadata(object.definition.DisplayName, fields)
+	}
+
+	return result, nil
+}
+
+func fieldValues(values []string) []common.FieldValue {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]common.FieldValue, len(values))
+	for index, value := range values {
+		result[index] = common.FieldValue{Value: value, DisplayValue: value}
+	}
+
+	return result
+}
+
+// mappedObject pairs the canonical definition with its provider mapping.
+type mappedObject struct {
+	definition *ObjectDefinition
+	mapping    ObjectMapping
+	// extensions are requested provider fields which have no canonical counterpart.
+	extensions []string
+}
+
+func (c *Connector) lookup(objectName string) (*mappedObject, error) {
+	definition, ok := c.model.Object(objectName)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", common.ErrObjectNotSupported, objectName)
+	}
+
+	mapping, ok := c.mapping[objectName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not mapped for %s", common.ErrObjectNotSupported, objectName, c.Provider())
+	}
+
+	return &mappedObject{
+		definition: definition,
+		mapping:    mapping,
+	}, nil
+}
+
+// providerFields converts requested canonical fields into provider fields.
+// Unknown fields are passed through and reported as extensions.
+func (o *mappedObject) providerFields(requested []string) (datautils.StringSet, []string) {
+	fields := datautils.NewStringSet()
+	extensions := make([]string, 0)
+
+	for _, name := range requested {
+		if _, ok := o.definition.Field(name); ok {
+			if mapping, ok := o.mapping.Fields[name]; ok {
+				fields.AddOne(mapping.Field)
+			}
+
+			continue
+		}
+
+		fields.AddOne(name)
+		extensions = append(extensions, name)
+	}
+
+	for field := range o.mapping.Discriminator {
+		fields.AddOne(field)
+	}
+
+	if len(fields) == 0 {
+		// Provider connectors require at least one field, the id is always a safe choice.
+		if mapping, ok := o.mapping.Fields["id"]; ok {
+			fields.AddOne(mapping.Field)
+		}
+	}
+
+	return fields, extensions
+}
+
+// providerFilter translates canonical field names used in the builder filter.
+func (o *mappedObject) providerFilter(filter *common.SearchFilter) *common.SearchFilter {
+	if filter == nil {
+		return nil
+	}
+
+	result := &common.SearchFilter{
+		FieldFilters: make([]common.FieldFilter, len(filter.FieldFilters)),
+	}
+
+	for index, fieldFilter := range filter.FieldFilters {
+		if mapping, ok := o.mapping.Fields[fieldFilter.FieldName]; ok {
+			fieldFilter.FieldName = mapping.Field
+		}
+
+		result.FieldFilters[index] = fieldFilter
+	}
+
+	return result
+}
+
+// matches reports whether the provider row belongs to this canonical object.
+func (o *mappedObject) matches(fields map[string]any) bool {
+	for field, expected := range o.mapping.Discriminator {
+		if fmt.Sprint(fields[providerKey(field)]) != fmt.Sprint(expected) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (o *mappedObject) toCanonical(fields map[string]any, requested []string) (map[string]any, error) {
+	result := make(map[string]any, len(requested))
+
+	for _, name := range requested {
+		if _, ok := o.definition.Field(name); !ok {
+			continue
+		}
+
+		mapping, ok := o.mapping.Fields[name]
+		if !ok {
+			continue
+		}
+
+		value := fields[providerKey(mapping.Field)]
+
+		if mapping.ToCanonical != nil {
+			converted, err := mapping.ToCanonical(value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+
+			value = converted
+		}
+
+		result[name] = value
+	}
+
+	if len(o.extensions) != 0 {
+		extensions := make(map[string]any, len(o.extensions))
+		for _, name := range o.extensions {
+			extensions[name] = fields[providerKey(name)]
+		}
+
+		result[ExtensionsField] = extensions
+	}
+
+	return result, nil
+}
+
+func (o *mappedObject) toProvider(record map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(record))
+
+	for name, value := range record {
+		if name == ExtensionsField {
+			extensions, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s must be an object", common.ErrRecordDataNotJSON, ExtensionsField)
+			}
+
+			maps.Copy(result, extensions)
+
+			continue
+		}
+
+		definition, ok := o.definition.Field(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.%s", ErrUnknownField, o.definition.Name, name)
+		}
+
+		mapping, ok := o.mapping.Fields[name]
+		if !ok || definition.ReadOnly || mapping.ReadOnly {
+			return nil, fmt.Errorf("%w: %s.%s", ErrReadOnlyField, o.definition.Name, name)
+		}
+
+		if mapping.ToProvider != nil {
+			converted, err := mapping.ToProvider(value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+
+			value = converted
+		}
+
+		result[mapping.Field] = value
+	}
+
+	maps.Copy(result, o.mapping.Discriminator)
+
+	return result, nil
+}
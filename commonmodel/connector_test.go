@@ -0,0 +1,295 @@
+// nolint
+package commonmodel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testModel() *Model {
+	return &Model{
+		Name: "test",
+		Objects: []ObjectDefinition{{
+			Name:        "contacts",
+			DisplayName: "Contacts",
+			Fields: []FieldDefinition{
+				{Name: "id", DisplayName: "ID", ValueType: common.ValueTypeString, ReadOnly: true},
+				{Name: "email", DisplayName: "Email", ValueType: common.ValueTypeString},
+				{Name: "status", DisplayName: "Status", ValueType: common.ValueTypeSingleSelect},
+				{Name: "title", DisplayName: "Title", ValueType: common.ValueTypeString},
+			},
+		}},
+		Mappings: map[providers.Provider]ProviderMapping{
+			providers.Mock: {
+				"contacts": {
+					ObjectName: "Person",
+					Fields: map[string]FieldMapping{
+						"id":    Field("PersonId"),
+						"email": Field("Emails").WithTransforms(FirstItem("value"), WrapList("value")),
+						"status": Field("State").WithEnum(EnumTable{
+							{Provider: "ACTIVE", Canonical: "active"},
+							{Provider: "ENABLED", Canonical: "active"},
+							{Provider: "DISABLED", Canonical: "inactive"},
+						}, ""),
+					},
+					Discriminator: map[string]any{"Kind": "human"},
+				},
+			},
+		},
+	}
+}
+
+func TestNewConnectorUnmappedProvider(t *testing.T) {
+	t.Parallel()
+
+	conn, err := mock.NewConnector()
+	require.NoError(t, err)
+
+	model := testModel()
+	delete(model.Mappings, providers.Mock)
+
+	_, err = NewConnector(model, conn)
+	require.ErrorIs(t, err, ErrProviderNotMapped)
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	var received common.ReadParams
+
+	conn, err := mock.NewConnector(mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+		received = params
+
+		return &common.ReadResult{
+			Rows: 2,
+			Data: []common.ReadResultRow{{
+				Id: "1",
+				Fields: map[string]any{
+					"personid":    "1",
+					"emails":      []any{map[string]any{"value": "a@example.com"}},
+					"state":       "enabled",
+					"kind":        "human",
+					"customscore": float64(7),
+				},
+				Raw: map[string]any{"PersonId": "1"},
+			}, {
+				Id:     "2",
+				Fields: map[string]any{"personid": "2", "kind": "robot"},
+			}},
+			NextPage: "next",
+		}, nil
+	}))
+	require.NoError(t, err)
+
+	adapter, err := NewConnector(testModel(), conn)
+	require.NoError(t, err)
+
+	result, err := adapter.Read(t.Context(), common.ReadParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("id", "email", "status", "title", "CustomScore"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Person", received.ObjectName)
+	assert.ElementsMatch(t, []string{"PersonId", "Emails", "State", "CustomScore", "Kind"}, received.Fields.List())
+
+	assert.Equal(t, &common.ReadResult{
+		Rows: 1,
+		Data: []common.ReadResultRow{{
+			Id: "1",
+			Fields: map[string]any{
+				"id":     "1",
+				"email":  "a@example.com",
+				"status": "active",
+				"extensions": map[string]any{
+					"CustomScore": float64(7),
+				},
+			},
+			Raw: map[string]any{"PersonId": "1"},
+		}},
+		NextPage: "next",
+	}, result)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	var received common.WriteParams
+
+	conn, err := mock.NewConnector(mock.WithWrite(func(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+		received = params
+
+		return &common.WriteResult{Success: true, RecordId: "1"}, nil
+	}))
+	require.NoError(t, err)
+
+	adapter, err := NewConnector(testModel(), conn)
+	require.NoError(t, err)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "contacts",
+		RecordData: map[string]any{
+			"email":      "a@example.com",
+			"status":     "active",
+			"extensions": map[string]any{"CustomScore": 7},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Person", received.ObjectName)
+	assert.Equal(t, map[string]any{
+		"Emails":      []any{map[string]any{"value": "a@example.com"}},
+		"State":       "ACTIVE",
+		"Kind":        "human",
+		"CustomScore": 7,
+	}, received.RecordData)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "contacts",
+		RecordData: map[string]any{"id": "1"},
+	})
+	require.ErrorIs(t, err, ErrReadOnlyField)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "contacts",
+		RecordData: map[string]any{"nickname": "x"},
+	})
+	require.ErrorIs(t, err, ErrUnknownField)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "contacts",
+		RecordData: map[string]any{"status": "archived"},
+	})
+	require.ErrorIs(t, err, ErrUnknownEnumValue)
+}
+
+func TestListObjectMetadata(t *testing.T) {
+	t.Parallel()
+
+	conn, err := mock.NewConnector()
+	require.NoError(t, err)
+
+	adapter, err := NewConnector(testModel(), conn)
+	require.NoError(t, err)
+
+	result, err := adapter.ListObjectMetadata(t.Context(), []string{"contacts", "deals"})
+	require.NoError(t, err)
+
+	contacts := result.Result["contacts"]
+	assert.Equal(t, "Contacts", contacts.DisplayName)
+	assert.Len(t, contacts.Fields, 3, "unmapped title must be excluded")
+	assert.Equal(t, "Emails", contacts.Fields["email"].ProviderType)
+	assert.True(t, *contacts.Fields["id"].ReadOnly)
+
+	require.ErrorIs(t, result.Errors["deals"], common.ErrObjectNotSupported)
+}
+
+func TestWriteAppendToParent(t *testing.T) {
+	t.Parallel()
+
+	var received common.WriteParams
+
+	conn, err := mock.NewConnector(mock.WithWrite(func(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+		received = params
+
+		return &common.WriteResult{Success: true}, nil
+	}))
+	require.NoError(t, err)
+
+	model := testModel()
+	model.Objects = append(model.Objects, ObjectDefinition{
+		Name: "comments",
+		Fields: []FieldDefinition{
+			{Name: "ticket_id", ValueType: common.ValueTypeReference},
+			{Name: "body", ValueType: common.ValueTypeString},
+		},
+	})
+	model.Mappings[providers.Mock]["comments"] = ObjectMapping{
+		ObjectName: "tickets",
+		Fields: map[string]FieldMapping{
+			"ticket_id": Field("id"),
+			"body":      Field("body"),
+		},
+		WriteOnly:    true,
+		PrepareWrite: AppendToParent("id", "ticket", "comment"),
+	}
+
+	adapter, err := NewConnector(model, conn)
+	require.NoError(t, err)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "comments",
+		RecordData: map[string]any{"ticket_id": float64(12345678), "body": "hello"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "tickets", received.ObjectName)
+	assert.Equal(t, "12345678", received.RecordId)
+	assert.Equal(t, map[string]any{
+		"ticket": map[string]any{"comment": map[string]any{"body": "hello"}},
+	}, received.RecordData)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "comments",
+		RecordData: map[string]any{"body": "hello"},
+	})
+	require.ErrorIs(t, err, ErrMissingParentReference)
+
+	_, err = adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "comments",
+		RecordId:   "1",
+		RecordData: map[string]any{"ticket_id": "1", "body": "hello"},
+	})
+	require.ErrorIs(t, err, common.ErrOperationNotSupportedForObject)
+
+	_, err = adapter.Read(t.Context(), common.ReadParams{
+		ObjectName: "comments",
+		Fields:     connectors.Fields("body"),
+	})
+	require.ErrorIs(t, err, common.ErrOperationNotSupportedForObject)
+}
+
+func TestEnumNumericProviderValues(t *testing.T) {
+	t.Parallel()
+
+	toCanonical, toProvider := Enum(EnumTable{
+		{Provider: 2, Canonical: "open"},
+		{Provider: 5, Canonical: "closed"},
+	}, "")
+
+	value, err := toCanonical(float64(5))
+	require.NoError(t, err)
+	assert.Equal(t, "closed", value)
+
+	value, err = toProvider("open")
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+}
+
+func TestFloatReadsNumericStrings(t *testing.T) {
+	t.Parallel()
+
+	toCanonical := Float()
+
+	value, err := toCanonical("1500.50")
+	require.NoError(t, err)
+	assert.Equal(t, 1500.5, value) // nolint:testifylint
+
+	value, err = toCanonical(float64(42))
+	require.NoError(t, err)
+	assert.Equal(t, float64(42), value) // nolint:testifylint
+
+	value, err = toCanonical("")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = toCanonical("n/a")
+	require.ErrorIs(t, err, ErrInvalidNumber)
+}
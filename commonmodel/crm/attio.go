@@ -0,0 +1,76 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// attioMapping reads record attributes, which Attio returns as lists of value objects.
+// Composite attributes such as personal names and record references are read-only here.
+func attioMapping() commonmodel.ProviderMapping {
+	recordID := commonmodel.ReadOnlyField("id").WithTransforms(commonmodel.Nested("record_id"), nil)
+	createdAt := commonmodel.Field("created_at")
+
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "people",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id": recordID,
+				"first_name": commonmodel.ReadOnlyField("name").WithTransforms(
+					commonmodel.FirstItem("first_name"), nil),
+				"last_name": commonmodel.ReadOnlyField("name").WithTransforms(
+					commonmodel.FirstItem("last_name"), nil),
+				"email": commonmodel.Field("email_addresses").WithTransforms(
+					commonmodel.FirstItem("email_address"), commonmodel.WrapList("")),
+				"phone": commonmodel.ReadOnlyField("phone_numbers").WithTransforms(
+					commonmodel.FirstItem("original_phone_number"), nil),
+				"title": commonmodel.Field("job_title").WithTransforms(commonmodel.FirstItem("value"), nil),
+				"company_id": commonmodel.ReadOnlyField("company").WithTransforms(
+					commonmodel.FirstItem("target_record_id"), nil),
+				"created_at": createdAt,
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "companies",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":   recordID,
+				"name": commonmodel.Field("name").WithTransforms(commonmodel.FirstItem("value"), nil),
+				"domain": commonmodel.Field("domains").WithTransforms(
+					commonmodel.FirstItem("domain"), commonmodel.WrapList("")),
+				"created_at": createdAt,
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "deals",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":   recordID,
+				"name": commonmodel.Field("name").WithTransforms(commonmodel.FirstItem("value"), nil),
+				"amount": commonmodel.Field("value").WithTransforms(
+					commonmodel.Chain(commonmodel.FirstItem("currency_value"), commonmodel.Float()), nil),
+				"stage": commonmodel.ReadOnlyField("stage").WithTransforms(
+					commonmodel.FirstItem("status"), nil),
+				"company_id": commonmodel.ReadOnlyField("associated_company").WithTransforms(
+					commonmodel.FirstItem("target_record_id"), nil),
+				"owner_id": commonmodel.ReadOnlyField("owner").WithTransforms(
+					commonmodel.FirstItem("referenced_actor_id"), nil),
+				"created_at": createdAt,
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "notes",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.ReadOnlyField("id").WithTransforms(commonmodel.Nested("note_id"), nil),
+				"title":      commonmodel.Field("title"),
+				"body":       commonmodel.Field("content_plaintext"),
+				"created_at": createdAt,
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.ReadOnlyField("id").WithTransforms(commonmodel.Nested("task_id"), nil),
+				"body":       commonmodel.Field("content_plaintext"),
+				"status":     doneFlag("is_completed"),
+				"due_date":   commonmodel.Field("deadline_at"),
+				"created_at": createdAt,
+			},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// capsuleMapping splits Capsule parties into contacts and companies using the party type.
+// Notes are stored as history entries, which the Capsule connector does not expose.
+func capsuleMapping() commonmodel.ProviderMapping {
+	owner := commonmodel.ReadOnlyField("owner").WithTransforms(commonmodel.Nested("id"), nil)
+
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "parties",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"first_name": commonmodel.Field("firstName"),
+				"last_name":  commonmodel.Field("lastName"),
+				"email": commonmodel.Field("emailAddresses").WithTransforms(
+					commonmodel.FirstItem("address"), commonmodel.WrapList("address")),
+				"phone": commonmodel.Field("phoneNumbers").WithTransforms(
+					commonmodel.FirstItem("number"), commonmodel.WrapList("number")),
+				"title": commonmodel.Field("jobTitle"),
+				"company_id": commonmodel.ReadOnlyField("organisation").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("updatedAt"),
+			},
+			Discriminator: map[string]any{"type": "person"},
+		},
+		ObjectCompanies: {
+			ObjectName: "parties",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":   commonmodel.Field("id"),
+				"name": commonmodel.Field("name"),
+				"domain": commonmodel.Field("websites").WithTransforms(
+					commonmodel.FirstItem("address"), commonmodel.WrapList("address")),
+				"phone": commonmodel.Field("phoneNumbers").WithTransforms(
+					commonmodel.FirstItem("number"), commonmodel.WrapList("number")),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("updatedAt"),
+			},
+			Discriminator: map[string]any{"type": "organisation"},
+		},
+		ObjectDeals: {
+			ObjectName: "opportunities",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":   commonmodel.Field("id"),
+				"name": commonmodel.Field("name"),
+				"amount": commonmodel.ReadOnlyField("value").WithTransforms(
+					commonmodel.Chain(commonmodel.Nested("amount"), commonmodel.Float()), nil),
+				"stage": commonmodel.ReadOnlyField("milestone").WithTransforms(
+					commonmodel.Nested("name"), nil),
+				"close_date": commonmodel.Field("expectedCloseOn"),
+				"company_id": commonmodel.ReadOnlyField("party").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("updatedAt"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("description"),
+				"body":    commonmodel.Field("detail"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "OPEN", Canonical: TaskStatusOpen},
+					{Provider: "PENDING", Canonical: TaskStatusOpen},
+					{Provider: "COMPLETED", Canonical: TaskStatusCompleted},
+				}, TaskStatusOpen),
+				"due_date":   commonmodel.Field("dueOn"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("updatedAt"),
+			},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// closeMapping treats Close leads as companies. Opportunity values are stored in cents.
+func closeMapping() commonmodel.ProviderMapping {
+	centsToUnits, unitsToCents := commonmodel.Scale(100) // nolint:mnd
+	centsToUnits = commonmodel.Chain(commonmodel.Float(), centsToUnits)
+
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "contact",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id": commonmodel.Field("id"),
+				"email": commonmodel.Field("emails").WithTransforms(
+					commonmodel.FirstItem("email"), commonmodel.WrapList("email")),
+				"phone": commonmodel.Field("phones").WithTransforms(
+					commonmodel.FirstItem("phone"), commonmodel.WrapList("phone")),
+				"title":      commonmodel.Field("title"),
+				"company_id": commonmodel.Field("lead_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_updated"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "lead",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"domain":     commonmodel.Field("url"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_updated"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "opportunity",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("note"),
+				"amount":     commonmodel.Field("value").WithTransforms(centsToUnits, unitsToCents),
+				"stage":      commonmodel.ReadOnlyField("status_label"),
+				"close_date": commonmodel.Field("date_won"),
+				"company_id": commonmodel.Field("lead_id"),
+				"owner_id":   commonmodel.Field("user_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_updated"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "activity/note",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"body":       commonmodel.Field("note"),
+				"owner_id":   commonmodel.Field("user_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_updated"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "task",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"subject":    commonmodel.Field("text"),
+				"status":     doneFlag("is_complete"),
+				"due_date":   commonmodel.Field("date"),
+				"owner_id":   commonmodel.Field("assigned_to"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_updated"),
+			},
+		},
+	}
+}
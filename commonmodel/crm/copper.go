@@ -0,0 +1,70 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// copperMapping does not cover notes, which Copper stores as activities
+// whose type id is specific to each account.
+func copperMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "people",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"first_name": commonmodel.Field("first_name"),
+				"last_name":  commonmodel.Field("last_name"),
+				"email": commonmodel.Field("emails").WithTransforms(
+					commonmodel.FirstItem("email"), commonmodel.WrapList("email")),
+				"phone": commonmodel.Field("phone_numbers").WithTransforms(
+					commonmodel.FirstItem("number"), commonmodel.WrapList("number")),
+				"title":      commonmodel.Field("title"),
+				"company_id": commonmodel.Field("company_id"),
+				"owner_id":   commonmodel.Field("assignee_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_modified"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "companies",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":     commonmodel.Field("id"),
+				"name":   commonmodel.Field("name"),
+				"domain": commonmodel.Field("email_domain"),
+				"phone": commonmodel.Field("phone_numbers").WithTransforms(
+					commonmodel.FirstItem("number"), commonmodel.WrapList("number")),
+				"owner_id":   commonmodel.Field("assignee_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_modified"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "opportunities",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"amount":     commonmodel.Field("monetary_value").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("pipeline_stage_id"),
+				"close_date": commonmodel.Field("close_date"),
+				"company_id": commonmodel.Field("company_id"),
+				"owner_id":   commonmodel.Field("assignee_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_modified"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("name"),
+				"body":    commonmodel.Field("details"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "Open", Canonical: TaskStatusOpen},
+					{Provider: "Completed", Canonical: TaskStatusCompleted},
+				}, TaskStatusOpen),
+				"due_date":   commonmodel.Field("due_date"),
+				"owner_id":   commonmodel.Field("assignee_id"),
+				"created_at": commonmodel.Field("date_created"),
+				"updated_at": commonmodel.Field("date_modified"),
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// dynamicsCRMMapping uses the Web API lookup properties (_<name>_value) for references.
+// Writing lookups requires @odata.bind annotations, so they are read-only in this model.
+func dynamicsCRMMapping() commonmodel.ProviderMapping {
+	owner := commonmodel.ReadOnlyField("_ownerid_value")
+
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("contactid"),
+				"first_name": commonmodel.Field("firstname"),
+				"last_name":  commonmodel.Field("lastname"),
+				"email":      commonmodel.Field("emailaddress1"),
+				"phone":      commonmodel.Field("telephone1"),
+				"title":      commonmodel.Field("jobtitle"),
+				"company_id": commonmodel.ReadOnlyField("_parentcustomerid_value"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdon"),
+				"updated_at": commonmodel.Field("modifiedon"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "accounts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("accountid"),
+				"name":       commonmodel.Field("name"),
+				"domain":     commonmodel.Field("websiteurl"),
+				"phone":      commonmodel.Field("telephone1"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdon"),
+				"updated_at": commonmodel.Field("modifiedon"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "opportunities",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("opportunityid"),
+				"name":       commonmodel.Field("name"),
+				"amount":     commonmodel.Field("estimatedvalue").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("stepname"),
+				"close_date": commonmodel.Field("estimatedclosedate"),
+				"company_id": commonmodel.ReadOnlyField("_parentaccountid_value"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdon"),
+				"updated_at": commonmodel.Field("modifiedon"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "annotations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("annotationid"),
+				"title":      commonmodel.Field("subject"),
+				"body":       commonmodel.Field("notetext"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdon"),
+				"updated_at": commonmodel.Field("modifiedon"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("activityid"),
+				"subject": commonmodel.Field("subject"),
+				"body":    commonmodel.Field("description"),
+				// statecode: 0 Open, 1 Completed, 2 Canceled.
+				"status": commonmodel.ReadOnlyField("statecode").WithTransforms(
+					func(value any) (any, error) {
+						if value == nil {
+							return nil, nil // nolint:nilnil
+						}
+
+						if value == float64(1) {
+							return TaskStatusCompleted, nil
+						}
+
+						return TaskStatusOpen, nil
+					},
+					nil,
+				),
+				"due_date":   commonmodel.Field("scheduledend"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("createdon"),
+				"updated_at": commonmodel.Field("modifiedon"),
+			},
+		},
+	}
+}
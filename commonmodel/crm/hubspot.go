@@ -0,0 +1,78 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+func hubspotMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("hs_object_id"),
+				"first_name": commonmodel.Field("firstname"),
+				"last_name":  commonmodel.Field("lastname"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"title":      commonmodel.Field("jobtitle"),
+				"company_id": commonmodel.Field("associatedcompanyid"),
+				"owner_id":   commonmodel.Field("hubspot_owner_id"),
+				"created_at": commonmodel.Field("createdate"),
+				"updated_at": commonmodel.Field("lastmodifieddate"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "companies",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("hs_object_id"),
+				"name":       commonmodel.Field("name"),
+				"domain":     commonmodel.Field("domain"),
+				"phone":      commonmodel.Field("phone"),
+				"industry":   commonmodel.Field("industry"),
+				"owner_id":   commonmodel.Field("hubspot_owner_id"),
+				"created_at": commonmodel.Field("createdate"),
+				"updated_at": commonmodel.Field("hs_lastmodifieddate"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "deals",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("hs_object_id"),
+				"name":       commonmodel.Field("dealname"),
+				"amount":     commonmodel.Field("amount").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("dealstage"),
+				"close_date": commonmodel.Field("closedate"),
+				"owner_id":   commonmodel.Field("hubspot_owner_id"),
+				"created_at": commonmodel.Field("createdate"),
+				"updated_at": commonmodel.Field("hs_lastmodifieddate"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "notes",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("hs_object_id"),
+				"body":       commonmodel.Field("hs_note_body"),
+				"owner_id":   commonmodel.Field("hubspot_owner_id"),
+				"created_at": commonmodel.Field("hs_createdate"),
+				"updated_at": commonmodel.Field("hs_lastmodifieddate"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("hs_object_id"),
+				"subject": commonmodel.Field("hs_task_subject"),
+				"body":    commonmodel.Field("hs_task_body"),
+				"status": commonmodel.Field("hs_task_status").WithEnum(commonmodel.EnumTable{
+					{Provider: "NOT_STARTED", Canonical: TaskStatusOpen},
+					{Provider: "IN_PROGRESS", Canonical: TaskStatusOpen},
+					{Provider: "WAITING", Canonical: TaskStatusOpen},
+					{Provider: "DEFERRED", Canonical: TaskStatusOpen},
+					{Provider: "COMPLETED", Canonical: TaskStatusCompleted},
+				}, ""),
+				"due_date":   commonmodel.Field("hs_timestamp"),
+				"owner_id":   commonmodel.Field("hubspot_owner_id"),
+				"created_at": commonmodel.Field("hs_createdate"),
+				"updated_at": commonmodel.Field("hs_lastmodifieddate"),
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+func insightlyMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "Contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("CONTACT_ID"),
+				"first_name": commonmodel.Field("FIRST_NAME"),
+				"last_name":  commonmodel.Field("LAST_NAME"),
+				"email":      commonmodel.Field("EMAIL_ADDRESS"),
+				"phone":      commonmodel.Field("PHONE"),
+				"title":      commonmodel.Field("TITLE"),
+				"company_id": commonmodel.Field("ORGANISATION_ID"),
+				"owner_id":   commonmodel.Field("OWNER_USER_ID"),
+				"created_at": commonmodel.Field("DATE_CREATED_UTC"),
+				"updated_at": commonmodel.Field("DATE_UPDATED_UTC"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "Organisations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":     commonmodel.Field("ORGANISATION_ID"),
+				"name":   commonmodel.Field("ORGANISATION_NAME"),
+				"domain": commonmodel.Field("WEBSITE"),
+				"phone":  commonmodel.Field("PHONE"),
+				// Insightly has no standard industry field.
+				"owner_id":   commonmodel.Field("OWNER_USER_ID"),
+				"created_at": commonmodel.Field("DATE_CREATED_UTC"),
+				"updated_at": commonmodel.Field("DATE_UPDATED_UTC"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "Opportunities",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("OPPORTUNITY_ID"),
+				"name":       commonmodel.Field("OPPORTUNITY_NAME"),
+				"amount":     commonmodel.Field("OPPORTUNITY_VALUE").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("STAGE_ID"),
+				"close_date": commonmodel.Field("FORECAST_CLOSE_DATE"),
+				"company_id": commonmodel.Field("ORGANISATION_ID"),
+				"owner_id":   commonmodel.Field("OWNER_USER_ID"),
+				"created_at": commonmodel.Field("DATE_CREATED_UTC"),
+				"updated_at": commonmodel.Field("DATE_UPDATED_UTC"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "Notes",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("NOTE_ID"),
+				"title":      commonmodel.Field("TITLE"),
+				"body":       commonmodel.Field("BODY"),
+				"owner_id":   commonmodel.Field("OWNER_USER_ID"),
+				"created_at": commonmodel.Field("DATE_CREATED_UTC"),
+				"updated_at": commonmodel.Field("DATE_UPDATED_UTC"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "Tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("TASK_ID"),
+				"subject":    commonmodel.Field("TITLE"),
+				"body":       commonmodel.Field("DETAILS"),
+				"status":     doneFlag("COMPLETED"),
+				"due_date":   commonmodel.Field("DUE_DATE"),
+				"owner_id":   commonmodel.Field("OWNER_USER_ID"),
+				"created_at": commonmodel.Field("DATE_CREATED_UTC"),
+				"updated_at": commonmodel.Field("DATE_UPDATED_UTC"),
+			},
+		},
+	}
+}
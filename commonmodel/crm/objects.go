@@ -0,0 +1,190 @@
+// Package crm defines the CRM common model: canonical contacts, companies, deals, notes and tasks,
+// together with declarative field mappings for the supported CRM connectors.
+//
+// Usage:
+//
+//	conn, err := commonmodel.NewConnector(crm.Model(), hubspotConnector)
+//	result, err := conn.Read(ctx, common.ReadParams{
+//		ObjectName: crm.ObjectContacts,
+//		Fields:     connectors.Fields("id", "email", "first_name", "my_custom_property"),
+//	})
+//
+// The custom property is not canonical, therefore it is returned under commonmodel.ExtensionsField.
+package crm
+
+import (
+	"fmt"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/commonmodel"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// Canonical object names.
+const (
+	ObjectContacts  = "contacts"
+	ObjectCompanies = "companies"
+	ObjectDeals     = "deals"
+	ObjectNotes     = "notes"
+	ObjectTasks     = "tasks"
+)
+
+// Canonical task statuses.
+const (
+	TaskStatusOpen      = "open"
+	TaskStatusCompleted = "completed"
+)
+
+// Model returns the CRM common model with mappings for all supported providers.
+func Model() *commonmodel.Model {
+	return &commonmodel.Model{
+		Name:    "crm",
+		Objects: objects(),
+		Mappings: map[providers.Provider]commonmodel.ProviderMapping{
+			providers.Hubspot:     hubspotMapping(),
+			providers.Salesforce:  salesforceMapping(),
+			providers.Pipedrive:   pipedriveMapping(),
+			providers.Zoho:        zohoMapping(),
+			providers.DynamicsCRM: dynamicsCRMMapping(),
+			providers.Close:       closeMapping(),
+			providers.Copper:      copperMapping(),
+			providers.Attio:       attioMapping(),
+			providers.Capsule:     capsuleMapping(),
+			providers.Insightly:   insightlyMapping(),
+		},
+	}
+}
+
+func objects() []commonmodel.ObjectDefinition {
+	return []commonmodel.ObjectDefinition{
+		{
+			Name:        ObjectContacts,
+			DisplayName: "Contacts",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("first_name", "First Name"),
+				text("last_name", "Last Name"),
+				text("email", "Email"),
+				text("phone", "Phone"),
+				text("title", "Job Title"),
+				reference("company_id", "Company"),
+				reference("owner_id", "Owner"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectCompanies,
+			DisplayName: "Companies",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("name", "Name"),
+				text("domain", "Domain"),
+				text("phone", "Phone"),
+				text("industry", "Industry"),
+				reference("owner_id", "Owner"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectDeals,
+			DisplayName: "Deals",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("name", "Name"),
+				{Name: "amount", DisplayName: "Amount", ValueType: common.ValueTypeFloat},
+				text("stage", "Stage"),
+				{Name: "close_date", DisplayName: "Close Date", ValueType: common.ValueTypeDate},
+				reference("company_id", "Company"),
+				reference("owner_id", "Owner"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectNotes,
+			DisplayName: "Notes",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("title", "Title"),
+				text("body", "Body"),
+				reference("owner_id", "Owner"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectTasks,
+			DisplayName: "Tasks",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("subject", "Subject"),
+				text("body", "Body"),
+				{
+					Name:        "status",
+					DisplayName: "Status",
+					ValueType:   common.ValueTypeSingleSelect,
+					Values:      []string{TaskStatusOpen, TaskStatusCompleted},
+				},
+				{Name: "due_date", DisplayName: "Due Date", ValueType: common.ValueTypeDateTime},
+				reference("owner_id", "Owner"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+	}
+}
+
+func id() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "id", DisplayName: "ID", ValueType: common.ValueTypeString, ReadOnly: true,
+	}
+}
+
+func createdAt() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "created_at", DisplayName: "Created At", ValueType: common.ValueTypeDateTime, ReadOnly: true,
+	}
+}
+
+func updatedAt() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "updated_at", DisplayName: "Updated At", ValueType: common.ValueTypeDateTime, ReadOnly: true,
+	}
+}
+
+func text(name, displayName string) commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{Name: name, DisplayName: displayName, ValueType: common.ValueTypeString}
+}
+
+func reference(name, displayName string) commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{Name: name, DisplayName: displayName, ValueType: common.ValueTypeReference}
+}
+
+// doneFlag maps a boolean completion flag onto canonical task statuses.
+func doneFlag(field string) commonmodel.FieldMapping {
+	return commonmodel.Field(field).WithTransforms(
+		func(value any) (any, error) {
+			if value == nil {
+				return nil, nil // nolint:nilnil
+			}
+
+			if value == true || value == "true" || value == float64(1) {
+				return TaskStatusCompleted, nil
+			}
+
+			return TaskStatusOpen, nil
+		},
+		func(value any) (any, error) {
+			switch value {
+			case TaskStatusCompleted:
+				return true, nil
+			case TaskStatusOpen:
+				return false, nil
+			default:
+				return nil, fmt.Errorf("%w: %v", commonmodel.ErrUnknownEnumValue, value)
+			}
+		},
+	)
+}
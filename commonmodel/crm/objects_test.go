@@ -0,0 +1,45 @@
+// nolint
+package crm
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testcommonmodel"
+)
+
+// Every mapping must reference canonical objects and fields, and every provider must be in the catalog.
+func TestMappingsReferenceCanonicalFields(t *testing.T) {
+	t.Parallel()
+
+	testcommonmodel.AssertMappingsReferenceCanonicalFields(t, Model())
+}
+
+// Numeric canonical fields are read as numbers, even from providers which return them as strings.
+func TestFloatFieldsAreNormalized(t *testing.T) {
+	t.Parallel()
+
+	model := Model()
+
+	for provider, mapping := range model.Mappings {
+		for objectName, object := range mapping {
+			definition, _ := model.Object(objectName)
+
+			for fieldName, field := range object.Fields {
+				canonical, _ := definition.Field(fieldName)
+				if canonical.ValueType != common.ValueTypeFloat {
+					continue
+				}
+
+				value, err := field.ToCanonical("1500.50")
+				if err != nil {
+					t.Fatalf("%s: %s.%s: %v", provider, objectName, fieldName, err)
+				}
+
+				if _, ok := value.(float64); !ok && value != nil {
+					t.Fatalf("%s: %s.%s is not read as a number: %v", provider, objectName, fieldName, value)
+				}
+			}
+		}
+	}
+}
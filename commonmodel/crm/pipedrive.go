@@ -0,0 +1,74 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// pipedriveMapping works with both the legacy (v1) and crm (v2) modules.
+// References are plain ids in v2 and expanded objects in v1, hence the Nested transforms.
+func pipedriveMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "persons",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"first_name": commonmodel.Field("first_name"),
+				"last_name":  commonmodel.Field("last_name"),
+				"email": commonmodel.Field("emails").WithTransforms(
+					commonmodel.FirstItem("value"), commonmodel.WrapList("value")),
+				"phone": commonmodel.Field("phones").WithTransforms(
+					commonmodel.FirstItem("value"), commonmodel.WrapList("value")),
+				"company_id": commonmodel.Field("org_id").WithTransforms(commonmodel.Nested("value"), nil),
+				"owner_id":   commonmodel.Field("owner_id").WithTransforms(commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("add_time"),
+				"updated_at": commonmodel.Field("update_time"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "organizations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"owner_id":   commonmodel.Field("owner_id").WithTransforms(commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("add_time"),
+				"updated_at": commonmodel.Field("update_time"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "deals",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("title"),
+				"amount":     commonmodel.Field("value").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("stage_id"),
+				"close_date": commonmodel.Field("expected_close_date"),
+				"company_id": commonmodel.Field("org_id").WithTransforms(commonmodel.Nested("value"), nil),
+				"owner_id":   commonmodel.Field("owner_id").WithTransforms(commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("add_time"),
+				"updated_at": commonmodel.Field("update_time"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "notes",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"body":       commonmodel.Field("content"),
+				"owner_id":   commonmodel.Field("user_id"),
+				"created_at": commonmodel.Field("add_time"),
+				"updated_at": commonmodel.Field("update_time"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "activities",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"subject":    commonmodel.Field("subject"),
+				"body":       commonmodel.Field("note"),
+				"status":     doneFlag("done"),
+				"due_date":   commonmodel.Field("due_date"),
+				"owner_id":   commonmodel.Field("owner_id").WithTransforms(commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("add_time"),
+				"updated_at": commonmodel.Field("update_time"),
+			},
+			Discriminator: map[string]any{"type": "task"},
+		},
+	}
+}
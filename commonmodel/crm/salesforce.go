@@ -0,0 +1,80 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+func salesforceMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "Contact",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("Id"),
+				"first_name": commonmodel.Field("FirstName"),
+				"last_name":  commonmodel.Field("LastName"),
+				"email":      commonmodel.Field("Email"),
+				"phone":      commonmodel.Field("Phone"),
+				"title":      commonmodel.Field("Title"),
+				"company_id": commonmodel.Field("AccountId"),
+				"owner_id":   commonmodel.Field("OwnerId"),
+				"created_at": commonmodel.Field("CreatedDate"),
+				"updated_at": commonmodel.Field("LastModifiedDate"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "Account",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("Id"),
+				"name":       commonmodel.Field("Name"),
+				"domain":     commonmodel.Field("Website"),
+				"phone":      commonmodel.Field("Phone"),
+				"industry":   commonmodel.Field("Industry"),
+				"owner_id":   commonmodel.Field("OwnerId"),
+				"created_at": commonmodel.Field("CreatedDate"),
+				"updated_at": commonmodel.Field("LastModifiedDate"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "Opportunity",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("Id"),
+				"name":       commonmodel.Field("Name"),
+				"amount":     commonmodel.Field("Amount").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("StageName"),
+				"close_date": commonmodel.Field("CloseDate"),
+				"company_id": commonmodel.Field("AccountId"),
+				"owner_id":   commonmodel.Field("OwnerId"),
+				"created_at": commonmodel.Field("CreatedDate"),
+				"updated_at": commonmodel.Field("LastModifiedDate"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "Note",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("Id"),
+				"title":      commonmodel.Field("Title"),
+				"body":       commonmodel.Field("Body"),
+				"owner_id":   commonmodel.Field("OwnerId"),
+				"created_at": commonmodel.Field("CreatedDate"),
+				"updated_at": commonmodel.Field("LastModifiedDate"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "Task",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("Id"),
+				"subject": commonmodel.Field("Subject"),
+				"body":    commonmodel.Field("Description"),
+				"status": commonmodel.Field("Status").WithEnum(commonmodel.EnumTable{
+					{Provider: "Not Started", Canonical: TaskStatusOpen},
+					{Provider: "In Progress", Canonical: TaskStatusOpen},
+					{Provider: "Waiting on someone else", Canonical: TaskStatusOpen},
+					{Provider: "Deferred", Canonical: TaskStatusOpen},
+					{Provider: "Completed", Canonical: TaskStatusCompleted},
+				}, TaskStatusOpen),
+				"due_date":   commonmodel.Field("ActivityDate"),
+				"owner_id":   commonmodel.Field("OwnerId"),
+				"created_at": commonmodel.Field("CreatedDate"),
+				"updated_at": commonmodel.Field("LastModifiedDate"),
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package crm
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// zohoMapping targets the Zoho CRM module. Lookup fields such as Owner and Account_Name
+// are objects holding the referenced id, therefore they are only read through this model.
+func zohoMapping() commonmodel.ProviderMapping {
+	owner := commonmodel.ReadOnlyField("Owner").WithTransforms(commonmodel.Nested("id"), nil)
+	account := commonmodel.ReadOnlyField("Account_Name").WithTransforms(commonmodel.Nested("id"), nil)
+
+	return commonmodel.ProviderMapping{
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"first_name": commonmodel.Field("First_Name"),
+				"last_name":  commonmodel.Field("Last_Name"),
+				"email":      commonmodel.Field("Email"),
+				"phone":      commonmodel.Field("Phone"),
+				"title":      commonmodel.Field("Title"),
+				"company_id": account,
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("Created_Time"),
+				"updated_at": commonmodel.Field("Modified_Time"),
+			},
+		},
+		ObjectCompanies: {
+			ObjectName: "accounts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("Account_Name"),
+				"domain":     commonmodel.Field("Website"),
+				"phone":      commonmodel.Field("Phone"),
+				"industry":   commonmodel.Field("Industry"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("Created_Time"),
+				"updated_at": commonmodel.Field("Modified_Time"),
+			},
+		},
+		ObjectDeals: {
+			ObjectName: "deals",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("Deal_Name"),
+				"amount":     commonmodel.Field("Amount").WithTransforms(commonmodel.Float(), nil),
+				"stage":      commonmodel.Field("Stage"),
+				"close_date": commonmodel.Field("Closing_Date"),
+				"company_id": account,
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("Created_Time"),
+				"updated_at": commonmodel.Field("Modified_Time"),
+			},
+		},
+		ObjectNotes: {
+			ObjectName: "notes",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"title":      commonmodel.Field("Note_Title"),
+				"body":       commonmodel.Field("Note_Content"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("Created_Time"),
+				"updated_at": commonmodel.Field("Modified_Time"),
+			},
+		},
+		ObjectTasks: {
+			ObjectName: "tasks",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("Subject"),
+				"body":    commonmodel.Field("Description"),
+				"status": commonmodel.Field("Status").WithEnum(commonmodel.EnumTable{
+					{Provider: "Not Started", Canonical: TaskStatusOpen},
+					{Provider: "Deferred", Canonical: TaskStatusOpen},
+					{Provider: "In Progress", Canonical: TaskStatusOpen},
+					{Provider: "Waiting for input", Canonical: TaskStatusOpen},
+					{Provider: "Completed", Canonical: TaskStatusCompleted},
+				}, TaskStatusOpen),
+				"due_date":   commonmodel.Field("Due_Date"),
+				"owner_id":   owner,
+				"created_at": commonmodel.Field("Created_Time"),
+				"updated_at": commonmodel.Field("Modified_Time"),
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package commonmodel
+
+import "errors"
+
+var (
+	// ErrProviderNotMapped is returned when the model has no mappings for the connector's provider.
+	ErrProviderNotMapped = errors.New("provider is not supported by the common model")
+
+	// ErrUnknownField is returned when a canonical record contains a field which is not defined by the model.
+	ErrUnknownField = errors.New("field is not part of the common model")
+
+	// ErrReadOnlyField is returned when writing a field which the provider mapping cannot write.
+	ErrReadOnlyField = errors.New("field is read-only in the common model")
+
+	// ErrUnknownEnumValue is returned when a canonical value has no provider equivalent.
+	ErrUnknownEnumValue = errors.New("value has no provider equivalent")
+
+	// ErrInvalidNumber is returned when a provider value of a numeric canonical field is not a number.
+	ErrInvalidNumber = errors.New("value is not a number")
+
+	// ErrMissingParentReference is returned when a record written through its parent lacks the parent id.
+	ErrMissingParentReference = errors.New("record must reference its parent")
+)
@@ -0,0 +1,157 @@
+// Package commonmodel is an optional layer that exposes provider records through
+// provider-agnostic, canonical objects.
+//
+// A Model describes canonical objects (e.g. contacts, tickets) and declares, per provider,
+// how each canonical field maps onto a provider object and field. A Connector wraps an
+// existing provider connector and translates Read, Write and ListObjectMetadata calls
+// in both directions using those mappings.
+//
+// Canonical rows keep the untouched provider payload in ReadResultRow.Raw.
+// Provider fields without a canonical counterpart (custom fields) pass through
+// under the ExtensionsField key, both when reading and writing.
+package commonmodel
+
+import (
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// ExtensionsField is the canonical record key that holds provider fields
+// which are not part of the canonical object definition, typically custom fields.
+const ExtensionsField = "extensions"
+
+// Model is a set of canonical object definitions together with
+// the provider specific mappings that implement them.
+type Model struct {
+	// Name identifies the model, e.g. "crm".
+	Name string
+	// Objects are the canonical object definitions.
+	Objects []ObjectDefinition
+	// Mappings holds declarative field mappings for each supported provider.
+	Mappings map[providers.Provider]ProviderMapping
+}
+
+// ObjectDefinition describes a canonical object.
+type ObjectDefinition struct {
+	// Name is the canonical object name, e.g. "contacts".
+	Name string
+	// DisplayName is a human-readable object name.
+	DisplayName string
+	// Fields are the canonical fields of the object.
+	Fields []FieldDefinition
+}
+
+// FieldDefinition describes a canonical field.
+// Field names are lowercase snake_case to match ReadResultRow.Fields conventions.
+type FieldDefinition struct {
+	Name        string
+	DisplayName string
+	ValueType   common.ValueType
+	// ReadOnly fields are never sent to the provider on write.
+	ReadOnly bool
+	// Values lists canonical values for select fields.
+	Values []string
+}
+
+// ProviderMapping maps canonical object names to their provider implementation.
+type ProviderMapping map[string]ObjectMapping
+
+// ObjectMapping describes how one canonical object is stored by a provider.
+type ObjectMapping struct {
+	// ObjectName is the provider object name used with the underlying connector.
+	ObjectName string
+	// Fields maps canonical field names to provider fields.
+	// Canonical fields that are absent are not supported by the provider.
+	Fields map[string]FieldMapping
+	// Discriminator lists provider fields with fixed values that distinguish this canonical
+	// object when the provider stores several canonical objects under the same provider object.
+	// Rows not matching every value are skipped on read, and the values are set on write.
+	// Example: Capsule stores both people and organisations as "parties" with a "type" field.
+	Discriminator map[string]any
+	// WriteOnly marks objects which the provider can create but cannot list,
+	// for example ticket comments that are added through an update of the parent ticket.
+	WriteOnly bool
+	// PrepareWrite optionally reshapes the translated provider write request,
+	// for providers that expect an envelope or a write against a parent record.
+	PrepareWrite WritePreparer
+}
+
+// FieldMapping maps a canonical field onto a provider field.
+type FieldMapping struct {
+	// Field is the provider field name as accepted by the underlying connector
+	// in ReadParams.Fields and WriteParams.RecordData.
+	Field string
+	// ToCanonical optionally converts a provider value into the canonical representation.
+	ToCanonical Transform
+	// ToProvider optionally converts a canonical value into the provider representation.
+	ToProvider Transform
+	// ReadOnly marks fields which cannot be written through this mapping,
+	// for example because the provider requires a different payload shape.
+	ReadOnly bool
+}
+
+// Field returns a one-to-one mapping onto the provider field.
+func Field(name string) FieldMapping {
+	return FieldMapping{Field: name}
+}
+
+// ReadOnlyField returns a mapping onto the provider field which is never written.
+func ReadOnlyField(name string) FieldMapping {
+	return FieldMapping{Field: name, ReadOnly: true}
+}
+
+// WithTransforms returns a copy of the mapping using the given value converters.
+// Either converter can be nil, in which case values are passed as is.
+func (m FieldMapping) WithTransforms(toCanonical, toProvider Transform) FieldMapping {
+	m.ToCanonical = toCanonical
+	m.ToProvider = toProvider
+
+	return m
+}
+
+// WithEnum returns a copy of the mapping which translates values using
+// the table of provider values to canonical values. See Enum.
+func (m FieldMapping) WithEnum(table EnumTable, fallback string) FieldMapping {
+	m.ToCanonical, m.ToProvider = Enum(table, fallback)
+
+	return m
+}
+
+// Object returns the canonical object definition by name.
+func (m *Model) Object(name string) (*ObjectDefinition, bool) {
+	for index := range m.Objects {
+		if m.Objects[index].Name == name {
+			return &m.Objects[index], true
+		}
+	}
+
+	return nil, false
+}
+
+// Field returns the canonical field definition by name.
+func (d *ObjectDefinition) Field(name string) (*FieldDefinition, bool) {
+	for index := range d.Fields {
+		if d.Fields[index].Name == name {
+			return &d.Fields[index], true
+		}
+	}
+
+	return nil, false
+}
+
+// Providers returns providers which have mappings in this model.
+func (m *Model) Providers() []providers.Provider {
+	result := make([]providers.Provider, 0, len(m.Mappings))
+	for provider := range m.Mappings {
+		result = append(result, provider)
+	}
+
+	return result
+}
+
+// providerKey is how provider fields are keyed in ReadResultRow.Fields.
+func providerKey(field string) string {
+	return strings.ToLower(field)
+}
@@ -0,0 +1,46 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// dixaMapping treats Dixa conversations as tickets.
+// The Dixa connector can create conversations but cannot list them, so tickets are write-only.
+func dixaMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "conversations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.ReadOnlyField("id"),
+				"subject": commonmodel.Field("subject"),
+				"description": commonmodel.Field("message").WithTransforms(nil,
+					func(value any) (any, error) {
+						if value == nil {
+							return nil, nil // nolint:nilnil
+						}
+
+						return map[string]any{"_type": "Text", "content": value}, nil
+					}),
+				"requester_id": commonmodel.Field("requesterId"),
+			},
+			WriteOnly: true,
+		},
+		ObjectContacts: {
+			ObjectName: "endusers",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("displayName"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phoneNumber"),
+				"created_at": commonmodel.Field("createdAt"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "agents",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("displayName"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("createdAt"),
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// freshdeskMapping uses the numeric status and priority codes of Freshdesk.
+// Ticket conversations are not exposed by the Freshdesk connector, so comments are not mapped.
+func freshdeskMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":          commonmodel.Field("id"),
+				"subject":     commonmodel.Field("subject"),
+				"description": commonmodel.Field("description"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: 2, Canonical: StatusOpen},
+					{Provider: 3, Canonical: StatusPending},
+					{Provider: 4, Canonical: StatusSolved},
+					{Provider: 5, Canonical: StatusClosed},
+				}, ""),
+				"priority": commonmodel.Field("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: 1, Canonical: PriorityLow},
+					{Provider: 2, Canonical: PriorityNormal},
+					{Provider: 3, Canonical: PriorityHigh},
+					{Provider: 4, Canonical: PriorityUrgent},
+				}, ""),
+				"requester_id": commonmodel.Field("requester_id"),
+				"assignee_id":  commonmodel.Field("responder_id"),
+				"created_at":   commonmodel.Field("created_at"),
+				"updated_at":   commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"created_at": commonmodel.Field("created_at"),
+				"updated_at": commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "agents",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id": commonmodel.Field("id"),
+				"name": commonmodel.ReadOnlyField("contact").WithTransforms(
+					commonmodel.Nested("name"), nil),
+				"email": commonmodel.ReadOnlyField("contact").WithTransforms(
+					commonmodel.Nested("email"), nil),
+				"created_at": commonmodel.Field("created_at"),
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// frontMapping treats Front conversations as tickets.
+// Front comments belong to a conversation sub-resource, which the connector does not address.
+func frontMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "conversations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("subject"),
+				"status": commonmodel.ReadOnlyField("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "unassigned", Canonical: StatusOpen},
+					{Provider: "assigned", Canonical: StatusOpen},
+					{Provider: "snoozed", Canonical: StatusOnHold},
+					{Provider: "archived", Canonical: StatusClosed},
+					{Provider: "deleted", Canonical: StatusClosed},
+				}, ""),
+				"requester_id": commonmodel.ReadOnlyField("recipient").WithTransforms(
+					commonmodel.Nested("handle"), nil),
+				"assignee_id": commonmodel.ReadOnlyField("assignee").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("created_at"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":   commonmodel.Field("id"),
+				"name": commonmodel.Field("name"),
+				"email": commonmodel.ReadOnlyField("handles").WithTransforms(
+					commonmodel.FirstItem("handle"), nil),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "teammates",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":    commonmodel.Field("id"),
+				"name":  commonmodel.Field("username"),
+				"email": commonmodel.Field("email"),
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// gorgiasMapping reads comments from Gorgias ticket messages.
+func gorgiasMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("subject"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "open", Canonical: StatusOpen},
+					{Provider: "closed", Canonical: StatusClosed},
+				}, ""),
+				"priority": commonmodel.Field("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: "low", Canonical: PriorityLow},
+					{Provider: "normal", Canonical: PriorityNormal},
+					{Provider: "high", Canonical: PriorityHigh},
+					{Provider: "critical", Canonical: PriorityUrgent},
+				}, ""),
+				"requester_id": commonmodel.ReadOnlyField("customer").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"assignee_id": commonmodel.ReadOnlyField("assignee_user").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("created_datetime"),
+				"updated_at": commonmodel.Field("updated_datetime"),
+			},
+		},
+		ObjectComments: {
+			ObjectName: "messages",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":        commonmodel.Field("id"),
+				"ticket_id": commonmodel.Field("ticket_id"),
+				"body":      commonmodel.Field("body_text"),
+				"public":    commonmodel.Field("public"),
+				"author_id": commonmodel.ReadOnlyField("sender").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("created_datetime"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "customers",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("created_datetime"),
+				"updated_at": commonmodel.Field("updated_datetime"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("created_datetime"),
+			},
+		},
+	}
+}
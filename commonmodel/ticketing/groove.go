@@ -0,0 +1,50 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// grooveMapping identifies Groove tickets by their number, and customers and agents by email,
+// which is how the Groove API addresses them.
+func grooveMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":          commonmodel.ReadOnlyField("number"),
+				"subject":     commonmodel.Field("title"),
+				"description": commonmodel.ReadOnlyField("summary"),
+				"status": commonmodel.Field("state").WithEnum(commonmodel.EnumTable{
+					{Provider: "opened", Canonical: StatusOpen},
+					{Provider: "unread", Canonical: StatusOpen},
+					{Provider: "pending", Canonical: StatusPending},
+					{Provider: "closed", Canonical: StatusClosed},
+					{Provider: "spam", Canonical: StatusClosed},
+				}, ""),
+				"priority": commonmodel.Field("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: "low", Canonical: PriorityLow},
+					{Provider: "medium", Canonical: PriorityNormal},
+					{Provider: "high", Canonical: PriorityHigh},
+					{Provider: "urgent", Canonical: PriorityUrgent},
+				}, ""),
+				"assignee_id": commonmodel.Field("assignee"),
+				"created_at":  commonmodel.Field("created_at"),
+				"updated_at":  commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "customers",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":    commonmodel.ReadOnlyField("email"),
+				"name":  commonmodel.Field("name"),
+				"email": commonmodel.Field("email"),
+				"phone": commonmodel.Field("phone_number"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "agents",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":    commonmodel.ReadOnlyField("email"),
+				"email": commonmodel.Field("email"),
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// happyFoxMapping is read-only, the HappyFox connector does not support writes.
+// Statuses are configurable in HappyFox, so they are mapped by their behavior instead of name.
+func happyFoxMapping() commonmodel.ProviderMapping {
+	status, _ := commonmodel.Enum(commonmodel.EnumTable{
+		{Provider: "pending", Canonical: StatusOpen},
+		{Provider: "completed", Canonical: StatusClosed},
+	}, "")
+	priority, _ := commonmodel.Enum(commonmodel.EnumTable{
+		{Provider: "low", Canonical: PriorityLow},
+		{Provider: "medium", Canonical: PriorityNormal},
+		{Provider: "high", Canonical: PriorityHigh},
+		{Provider: "critical", Canonical: PriorityUrgent},
+	}, "")
+
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("subject"),
+				"status": commonmodel.ReadOnlyField("status").WithTransforms(
+					commonmodel.Chain(commonmodel.Nested("behavior"), status), nil),
+				"priority": commonmodel.ReadOnlyField("priority").WithTransforms(
+					commonmodel.Chain(commonmodel.Nested("name"), priority), nil),
+				"requester_id": commonmodel.ReadOnlyField("user").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"assignee_id": commonmodel.ReadOnlyField("assigned_to").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("created_at"),
+				"updated_at": commonmodel.Field("last_updated_at"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"created_at": commonmodel.Field("created_at"),
+				"updated_at": commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "staff",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":    commonmodel.Field("id"),
+				"name":  commonmodel.Field("name"),
+				"email": commonmodel.Field("email"),
+			},
+		},
+	}
+}
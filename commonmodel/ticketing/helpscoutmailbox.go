@@ -0,0 +1,46 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// helpScoutMailboxMapping treats Help Scout conversations as tickets.
+// People are split into first and last names, which have no canonical counterpart.
+func helpScoutMailboxMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "conversations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":          commonmodel.Field("id"),
+				"subject":     commonmodel.Field("subject"),
+				"description": commonmodel.ReadOnlyField("preview"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "active", Canonical: StatusOpen},
+					{Provider: "pending", Canonical: StatusPending},
+					{Provider: "closed", Canonical: StatusClosed},
+					{Provider: "spam", Canonical: StatusClosed},
+				}, ""),
+				"requester_id": commonmodel.ReadOnlyField("primaryCustomer").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"assignee_id": commonmodel.ReadOnlyField("assignee").WithTransforms(
+					commonmodel.Nested("id"), nil),
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("userUpdatedAt"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "customers",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"created_at": commonmodel.Field("createdAt"),
+				"updated_at": commonmodel.Field("updatedAt"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("createdAt"),
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// intercomMapping treats Intercom conversations as tickets.
+// Replies are posted to a conversation sub-resource the connector does not write, so comments are not mapped.
+func intercomMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "conversations",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":      commonmodel.Field("id"),
+				"subject": commonmodel.Field("title"),
+				"description": commonmodel.ReadOnlyField("source").WithTransforms(
+					commonmodel.Nested("body"), nil),
+				"status": commonmodel.ReadOnlyField("state").WithEnum(commonmodel.EnumTable{
+					{Provider: "open", Canonical: StatusOpen},
+					{Provider: "snoozed", Canonical: StatusOnHold},
+					{Provider: "closed", Canonical: StatusClosed},
+				}, ""),
+				"priority": commonmodel.ReadOnlyField("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: "not_priority", Canonical: PriorityNormal},
+					{Provider: "priority", Canonical: PriorityHigh},
+				}, ""),
+				"requester_id": commonmodel.ReadOnlyField("source").WithTransforms(
+					commonmodel.Nested("author", "id"), nil),
+				"assignee_id": commonmodel.ReadOnlyField("admin_assignee_id"),
+				"created_at":  commonmodel.Field("created_at"),
+				"updated_at":  commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectContacts: {
+			ObjectName: "contacts",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"created_at": commonmodel.Field("created_at"),
+				"updated_at": commonmodel.Field("updated_at"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "admins",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":    commonmodel.Field("id"),
+				"name":  commonmodel.Field("name"),
+				"email": commonmodel.Field("email"),
+			},
+		},
+	}
+}
@@ -0,0 +1,156 @@
+// Package ticketing defines the ticketing (helpdesk) common model: canonical tickets, comments,
+// contacts and agents, together with declarative field mappings for the supported helpdesk connectors.
+//
+// Usage:
+//
+//	conn, err := commonmodel.NewConnector(ticketing.Model(), zendeskConnector)
+//	result, err := conn.Write(ctx, common.WriteParams{
+//		ObjectName: ticketing.ObjectComments,
+//		RecordData: map[string]any{"ticket_id": "42", "body": "We are on it.", "public": true},
+//	})
+//
+// Statuses and priorities are translated to the canonical values listed below.
+// Some providers only create comments through an update of the parent ticket;
+// such comments are write-only and must reference the ticket via ticket_id.
+package ticketing
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/commonmodel"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// Canonical object names.
+const (
+	ObjectTickets  = "tickets"
+	ObjectComments = "comments"
+	ObjectContacts = "contacts"
+	ObjectAgents   = "agents"
+)
+
+// Canonical ticket statuses.
+const (
+	StatusOpen    = "open"
+	StatusPending = "pending"
+	StatusOnHold  = "on_hold"
+	StatusSolved  = "solved"
+	StatusClosed  = "closed"
+)
+
+// Canonical ticket priorities.
+const (
+	PriorityLow    = "low"
+	PriorityNormal = "normal"
+	PriorityHigh   = "high"
+	PriorityUrgent = "urgent"
+)
+
+// Model returns the ticketing common model with mappings for all supported providers.
+func Model() *commonmodel.Model {
+	return &commonmodel.Model{
+		Name:    "ticketing",
+		Objects: objects(),
+		Mappings: map[providers.Provider]commonmodel.ProviderMapping{
+			providers.ZendeskSupport:   zendeskSupportMapping(),
+			providers.Freshdesk:        freshdeskMapping(),
+			providers.Intercom:         intercomMapping(),
+			providers.Front:            frontMapping(),
+			providers.Gorgias:          gorgiasMapping(),
+			providers.HelpScoutMailbox: helpScoutMailboxMapping(),
+			providers.HappyFox:         happyFoxMapping(),
+			providers.Groove:           grooveMapping(),
+			providers.Dixa:             dixaMapping(),
+			providers.ServiceNow:       serviceNowMapping(),
+		},
+	}
+}
+
+func objects() []commonmodel.ObjectDefinition {
+	return []commonmodel.ObjectDefinition{
+		{
+			Name:        ObjectTickets,
+			DisplayName: "Tickets",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("subject", "Subject"),
+				text("description", "Description"),
+				{
+					Name:        "status",
+					DisplayName: "Status",
+					ValueType:   common.ValueTypeSingleSelect,
+					Values:      []string{StatusOpen, StatusPending, StatusOnHold, StatusSolved, StatusClosed},
+				},
+				{
+					Name:        "priority",
+					DisplayName: "Priority",
+					ValueType:   common.ValueTypeSingleSelect,
+					Values:      []string{PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent},
+				},
+				reference("requester_id", "Requester"),
+				reference("assignee_id", "Assignee"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectComments,
+			DisplayName: "Comments",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				reference("ticket_id", "Ticket"),
+				text("body", "Body"),
+				{Name: "public", DisplayName: "Public", ValueType: common.ValueTypeBoolean},
+				reference("author_id", "Author"),
+				createdAt(),
+			},
+		},
+		{
+			Name:        ObjectContacts,
+			DisplayName: "Contacts",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("name", "Name"),
+				text("email", "Email"),
+				text("phone", "Phone"),
+				createdAt(),
+				updatedAt(),
+			},
+		},
+		{
+			Name:        ObjectAgents,
+			DisplayName: "Agents",
+			Fields: []commonmodel.FieldDefinition{
+				id(),
+				text("name", "Name"),
+				text("email", "Email"),
+				createdAt(),
+			},
+		},
+	}
+}
+
+func id() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "id", DisplayName: "ID", ValueType: common.ValueTypeString, ReadOnly: true,
+	}
+}
+
+func createdAt() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "created_at", DisplayName: "Created At", ValueType: common.ValueTypeDateTime, ReadOnly: true,
+	}
+}
+
+func updatedAt() commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{
+		Name: "updated_at", DisplayName: "Updated At", ValueType: common.ValueTypeDateTime, ReadOnly: true,
+	}
+}
+
+func text(name, displayName string) commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{Name: name, DisplayName: displayName, ValueType: common.ValueTypeString}
+}
+
+func reference(name, displayName string) commonmodel.FieldDefinition {
+	return commonmodel.FieldDefinition{Name: name, DisplayName: displayName, ValueType: common.ValueTypeReference}
+}
@@ -0,0 +1,68 @@
+// nolint
+package ticketing
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/testcommonmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every mapping must reference canonical objects and fields, and every provider must be in the catalog.
+func TestMappingsReferenceCanonicalFields(t *testing.T) {
+	t.Parallel()
+
+	testcommonmodel.AssertMappingsReferenceCanonicalFields(t, Model())
+}
+
+// Every provider stores tickets, and a canonical status or priority written to it is read back unchanged.
+func TestTicketSelectValuesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	model := Model()
+	definition, ok := model.Object(ObjectTickets)
+	require.True(t, ok)
+
+	for provider, mapping := range model.Mappings {
+		tickets, ok := mapping[ObjectTickets]
+		require.True(t, ok, "%s must map tickets", provider)
+
+		for _, fieldName := range []string{"status", "priority"} {
+			field, ok := tickets.Fields[fieldName]
+			if !ok || field.ToProvider == nil || field.ToCanonical == nil {
+				continue
+			}
+
+			canonicalField, _ := definition.Field(fieldName)
+
+			for _, canonical := range canonicalField.Values {
+				value, err := field.ToProvider(canonical)
+				if err != nil {
+					// The provider has no equivalent of this value.
+					continue
+				}
+
+				actual, err := field.ToCanonical(value)
+				require.NoError(t, err, "%s: %s %v", provider, fieldName, value)
+				assert.Equal(t, canonical, actual, "%s: %s", provider, fieldName)
+			}
+		}
+	}
+}
+
+// Comments which cannot be listed are written through their parent ticket.
+func TestWriteOnlyObjectsPrepareWrite(t *testing.T) {
+	t.Parallel()
+
+	for provider, mapping := range Model().Mappings {
+		comments, ok := mapping[ObjectComments]
+		if !ok || !comments.WriteOnly {
+			continue
+		}
+
+		assert.NotNil(t, comments.PrepareWrite, "%s: write-only comments need PrepareWrite", provider)
+		_, ok = comments.Fields["ticket_id"]
+		assert.True(t, ok, "%s: write-only comments must reference the ticket", provider)
+	}
+}
@@ -0,0 +1,69 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// serviceNowMapping treats incidents as tickets. ServiceNow returns enums as numeric strings
+// and references either as a sys_id or as {"link", "value"} objects.
+// Comments are added by writing the journal field of the incident.
+func serviceNowMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "incident",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":          commonmodel.Field("sys_id"),
+				"subject":     commonmodel.Field("short_description"),
+				"description": commonmodel.Field("description"),
+				"status": commonmodel.Field("state").WithEnum(commonmodel.EnumTable{
+					{Provider: "1", Canonical: StatusOpen},
+					{Provider: "2", Canonical: StatusOpen},
+					{Provider: "3", Canonical: StatusOnHold},
+					{Provider: "6", Canonical: StatusSolved},
+					{Provider: "7", Canonical: StatusClosed},
+					{Provider: "8", Canonical: StatusClosed},
+				}, ""),
+				// Priority is derived from impact and urgency, it cannot be written directly.
+				"priority": commonmodel.ReadOnlyField("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: "1", Canonical: PriorityUrgent},
+					{Provider: "2", Canonical: PriorityHigh},
+					{Provider: "3", Canonical: PriorityNormal},
+					{Provider: "4", Canonical: PriorityLow},
+					{Provider: "5", Canonical: PriorityLow},
+				}, ""),
+				"requester_id": commonmodel.Field("caller_id").WithTransforms(commonmodel.Nested("value"), nil),
+				"assignee_id":  commonmodel.Field("assigned_to").WithTransforms(commonmodel.Nested("value"), nil),
+				"created_at":   commonmodel.Field("sys_created_on"),
+				"updated_at":   commonmodel.Field("sys_updated_on"),
+			},
+		},
+		ObjectComments: {
+			ObjectName: "incident",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":        commonmodel.ReadOnlyField("sys_id"),
+				"ticket_id": commonmodel.Field("sys_id"),
+				"body":      commonmodel.Field("comments"),
+			},
+			WriteOnly:    true,
+			PrepareWrite: commonmodel.AppendToParent("sys_id"),
+		},
+		ObjectContacts: {
+			ObjectName: "contact",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("sys_id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"created_at": commonmodel.Field("sys_created_on"),
+				"updated_at": commonmodel.Field("sys_updated_on"),
+			},
+		},
+		ObjectAgents: {
+			ObjectName: "Users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("sys_id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("sys_created_on"),
+			},
+		},
+	}
+}
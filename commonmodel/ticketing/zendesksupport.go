@@ -0,0 +1,73 @@
+package ticketing
+
+import "github.com/amp-labs/connectors/commonmodel"
+
+// zendeskSupportMapping wraps writes in the singular object envelope Zendesk expects.
+// Comments cannot be listed across tickets; they are added through a ticket update.
+func zendeskSupportMapping() commonmodel.ProviderMapping {
+	return commonmodel.ProviderMapping{
+		ObjectTickets: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":          commonmodel.Field("id"),
+				"subject":     commonmodel.Field("subject"),
+				"description": commonmodel.Field("description"),
+				"status": commonmodel.Field("status").WithEnum(commonmodel.EnumTable{
+					{Provider: "open", Canonical: StatusOpen},
+					{Provider: "new", Canonical: StatusOpen},
+					{Provider: "pending", Canonical: StatusPending},
+					{Provider: "hold", Canonical: StatusOnHold},
+					{Provider: "solved", Canonical: StatusSolved},
+					{Provider: "closed", Canonical: StatusClosed},
+				}, ""),
+				"priority": commonmodel.Field("priority").WithEnum(commonmodel.EnumTable{
+					{Provider: "low", Canonical: PriorityLow},
+					{Provider: "normal", Canonical: PriorityNormal},
+					{Provider: "high", Canonical: PriorityHigh},
+					{Provider: "urgent", Canonical: PriorityUrgent},
+				}, ""),
+				"requester_id": commonmodel.Field("requester_id"),
+				"assignee_id":  commonmodel.Field("assignee_id"),
+				"created_at":   commonmodel.Field("created_at"),
+				"updated_at":   commonmodel.Field("updated_at"),
+			},
+			PrepareWrite: commonmodel.Envelope("ticket"),
+		},
+		ObjectComments: {
+			ObjectName: "tickets",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":        commonmodel.ReadOnlyField("id"),
+				"ticket_id": commonmodel.Field("ticket_id"),
+				"body":      commonmodel.Field("body"),
+				"public":    commonmodel.Field("public"),
+				"author_id": commonmodel.Field("author_id"),
+			},
+			WriteOnly:    true,
+			PrepareWrite: commonmodel.AppendToParent("ticket_id", "ticket", "comment"),
+		},
+		ObjectContacts: {
+			ObjectName: "users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"phone":      commonmodel.Field("phone"),
+				"created_at": commonmodel.Field("created_at"),
+				"updated_at": commonmodel.Field("updated_at"),
+			},
+			Discriminator: map[string]any{"role": "end-user"},
+			PrepareWrite:  commonmodel.Envelope("user"),
+		},
+		ObjectAgents: {
+			ObjectName: "users",
+			Fields: map[string]commonmodel.FieldMapping{
+				"id":         commonmodel.Field("id"),
+				"name":       commonmodel.Field("name"),
+				"email":      commonmodel.Field("email"),
+				"created_at": commonmodel.Field("created_at"),
+			},
+			Discriminator: map[string]any{"role": "agent"},
+			PrepareWrite:  commonmodel.Envelope("user"),
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package commonmodel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Transform converts a single field value between provider and canonical representations.
+type Transform func(value any) (any, error)
+
+// EnumValue pairs a provider value with its canonical value.
+// Provider values are usually strings, but can be numbers for providers
+// that encode enums as integers, such as Freshdesk ticket statuses.
+type EnumValue struct {
+	Provider  any
+	Canonical string
+}
+
+// EnumTable is an ordered list of provider to canonical value pairs.
+// Several provider values may share a canonical value, in which case
+// the first one listed is used when writing.
+type EnumTable []EnumValue
+
+// Enum returns a pair of transforms which translate values using the table.
+// Lookups are case-insensitive on the provider side.
+//
+// Values missing from the table become fallback on read, unless fallback is empty,
+// in which case they are passed through unchanged. On write, canonical values without
+// a provider equivalent are rejected with ErrUnknownEnumValue.
+func Enum(table EnumTable, fallback string) (toCanonical, toProvider Transform) {
+	canonical := make(map[string]string, len(table))
+	provider := make(map[string]any, len(table))
+
+	for _, value := range table {
+		canonical[strings.ToLower(fmt.Sprint(value.Provider))] = value.Canonical
+
+		if _, ok := provider[value.Canonical]; !ok {
+			provider[value.Canonical] = value.Provider
+		}
+	}
+
+	toCanonical = func(value any) (any, error) {
+		if value == nil {
+			return nil, nil // nolint:nilnil
+		}
+
+		if result, ok := canonical[strings.ToLower(fmt.Sprint(value))]; ok {
+			return result, nil
+		}
+
+		if fallback != "" {
+			return fallback, nil
+		}
+
+		return value, nil
+	}
+
+	toProvider = func(value any) (any, error) {
+		if value == nil {
+			return nil, nil // nolint:nilnil
+		}
+
+		if result, ok := provider[fmt.Sprint(value)]; ok {
+			return result, nil
+		}
+
+		return nil, fmt.Errorf("%w: %v", ErrUnknownEnumValue, value)
+	}
+
+	return toCanonical, toProvider
+}
+
+// FirstItem returns a transform which reads a single value out of a provider list,
+// such as Pipedrive emails or Attio attribute values.
+// When key is given, the first list item is expected to be an object and the key is read from it.
+// Items flagged with "primary": true are preferred over the first item.
+func FirstItem(key string) Transform {
+	return func(value any) (any, error) {
+		list, ok := value.([]any)
+		if !ok {
+			return value, nil
+		}
+
+		if len(list) == 0 {
+			return nil, nil // nolint:nilnil
+		}
+
+		item := list[0]
+
+		for _, candidate := range list {
+			if object, ok := candidate.(map[string]any); ok && object["primary"] == true {
+				item = candidate
+
+				break
+			}
+		}
+
+		if key == "" {
+			return item, nil
+		}
+
+		object, ok := item.(map[string]any)
+		if !ok {
+			return item, nil
+		}
+
+		return object[key], nil
+	}
+}
+
+// Nested returns a transform which reads a key out of a provider object,
+// for example the "id" of an owner reference. Several keys descend into nested objects.
+// Non object values are returned unchanged, which handles providers that return
+// either a bare id or an expanded object.
+func Nested(keys ...string) Transform {
+	return func(value any) (any, error) {
+		for _, key := range keys {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return value, nil
+			}
+
+			value = object[key]
+		}
+
+		return value, nil
+	}
+}
+
+// Chain returns a transform which applies the transforms in order,
+// for example reading a nested value and then translating it with an enum.
+func Chain(transforms ...Transform) Transform {
+	return func(value any) (any, error) {
+		for _, transform := range transforms {
+			converted, err := transform(value)
+			if err != nil {
+				return nil, err
+			}
+
+			value = converted
+		}
+
+		return value, nil
+	}
+}
+
+// WrapList returns a transform which writes a canonical value as a single item provider list.
+// When key is given, the item is an object holding the value under that key.
+func WrapList(key string) Transform {
+	return func(value any) (any, error) {
+		if value == nil {
+			return nil, nil // nolint:nilnil
+		}
+
+		if key == "" {
+			return []any{value}, nil
+		}
+
+		return []any{map[string]any{key: value}}, nil
+	}
+}
+
+// Float returns a transform which reads numeric provider values as float64,
+// for providers such as HubSpot that return every property as a string.
+// Empty strings are read as nil, other values that are not numbers are rejected with ErrInvalidNumber.
+func Float() Transform {
+	return func(value any) (any, error) {
+		switch typed := value.(type) {
+		case nil, float64:
+			return typed, nil
+		case float32:
+			return float64(typed), nil
+		case int:
+			return float64(typed), nil
+		case int64:
+			return float64(typed), nil
+		case string:
+			if strings.TrimSpace(typed) == "" {
+				return nil, nil // nolint:nilnil
+			}
+
+			number, err := strconv.ParseFloat(strings.TrimSpace(typed), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidNumber, typed)
+			}
+
+			return number, nil
+		default:
+			return nil, fmt.Errorf("%w: %v", ErrInvalidNumber, value)
+		}
+	}
+}
+
+// Scale returns a pair of transforms which divide provider values by factor on read
+// and multiply canonical values by factor on write. Useful for amounts stored in cents.
+func Scale(factor float64) (toCanonical, toProvider Transform) {
+	toCanonical = func(value any) (any, error) {
+		number, ok := value.(float64)
+		if !ok {
+			return value, nil
+		}
+
+		return number / factor, nil
+	}
+
+	toProvider = func(value any) (any, error) {
+		number, ok := value.(float64)
+		if !ok {
+			return value, nil
+		}
+
+		return number * factor, nil
+	}
+
+	return toCanonical, toProvider
+}
@@ -0,0 +1,69 @@
+package commonmodel
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// WritePreparer reshapes a translated write request before it reaches the provider connector.
+// The record holds provider fields and is the same map as params.RecordData.
+type WritePreparer func(params common.WriteParams, record map[string]any) (common.WriteParams, error)
+
+// Envelope returns a preparer which nests the provider record under the key.
+// Example: Zendesk expects {"ticket": {...}} when creating or updating tickets.
+func Envelope(key string) WritePreparer {
+	return func(params common.WriteParams, record map[string]any) (common.WriteParams, error) {
+		params.RecordData = map[string]any{key: record}
+
+		return params, nil
+	}
+}
+
+// AppendToParent returns a preparer for records which are created by updating their parent,
+// such as a comment added to a ticket. The parent id is taken out of the provider field parentField
+// and becomes the RecordId of the write, while the remaining fields are nested under the path.
+// An empty path keeps the fields at the top level of the payload.
+//
+// Records written this way cannot be updated, therefore a RecordId is rejected.
+func AppendToParent(parentField string, path ...string) WritePreparer {
+	return func(params common.WriteParams, record map[string]any) (common.WriteParams, error) {
+		if params.RecordId != "" {
+			return params, fmt.Errorf("%w: records are appended to %s and cannot be updated",
+				common.ErrOperationNotSupportedForObject, params.ObjectName)
+		}
+
+		parentID, ok := record[parentField]
+		if !ok || parentID == nil || parentID == "" {
+			return params, fmt.Errorf("%w: %s", ErrMissingParentReference, parentField)
+		}
+
+		fields := make(map[string]any, len(record))
+
+		for name, value := range record {
+			if name != parentField {
+				fields[name] = value
+			}
+		}
+
+		var payload any = fields
+		for index := len(path) - 1; index >= 0; index-- {
+			payload = map[string]any{path[index]: payload}
+		}
+
+		params.RecordId = recordID(parentID)
+		params.RecordData = payload
+
+		return params, nil
+	}
+}
+
+// recordID formats an id value, avoiding exponent notation for JSON numbers.
+func recordID(value any) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
@@ -101,6 +101,17 @@ type WriteConnector interface {
 	Write(ctx context.Context, params WriteParams) (*WriteResult, error)
 }
 
+// UpsertConnector is implemented by connectors whose Write honors WriteParams.UpsertKey
+// using an upsert endpoint of the provider. Prefer calling Upsert, which falls back to
+// SearchConnector for other connectors.
+type UpsertConnector interface {
+	WriteConnector
+
+	// SupportsUpsert reports whether records of the object can be upserted by the key field.
+	// It may consult the provider's metadata, e.g. to tell whether the field is unique.
+	SupportsUpsert(ctx context.Context, objectName string, field string) (bool, error)
+}
+
 // DeleteConnector is an interface that extends the Connector interface with delete capabilities.
 type DeleteConnector interface {
 	Connector
@@ -108,6 +119,18 @@ type DeleteConnector interface {
 	Delete(ctx context.Context, params DeleteParams) (*DeleteResult, error)
 }
 
+// MergeConnector merges duplicate records of an object into a single surviving record.
+//
+// Related records, such as activities and associations, are moved to the winner,
+// while the losers are deleted or archived by the provider. Providers limiting how many
+// records are merged at once are called repeatedly, so an error may leave some losers merged.
+// Such an error is returned along with an unsuccessful result listing the losers merged so far.
+type MergeConnector interface {
+	Connector
+
+	Merge(ctx context.Context, params MergeParams) (*MergeResult, error)
+}
+
 // BatchWriteConnector provides synchronous operations for writing multiple records in a single request.
 // It serves the same purpose as WriteConnector but operates
 // on collections of records instead of individual ones.
@@ -125,6 +148,38 @@ type BatchWriteConnector interface {
 	BatchWrite(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error)
 }
 
+// BulkWriteConnector provides asynchronous operations for writing large numbers of records.
+//
+// A job is started with all the records, then polled until it is done,
+// after which the records that failed are listed page by page.
+// Errors returned from the methods represent connector-level issues,
+// failures of individual records are reported by GetBulkWriteErrors.
+type BulkWriteConnector interface {
+	Connector
+
+	// StartBulkWrite submits the records and returns the job writing them.
+	StartBulkWrite(ctx context.Context, params *common.BulkWriteParams) (*common.BulkWriteJob, error)
+
+	// GetBulkWriteJob returns the current state of a job.
+	GetBulkWriteJob(ctx context.Context, jobID string) (*common.BulkWriteJob, error)
+
+	// GetBulkWriteErrors returns a page of records the job failed to write.
+	// An empty page token requests the first page.
+	GetBulkWriteErrors(
+		ctx context.Context, jobID string, nextPage common.NextPageToken,
+	) (*common.BulkWriteErrorsResult, error)
+}
+
+// FieldHistoryConnector is an interface that extends the Connector interface with
+// the ability to read past changes of record fields, such as stage transitions or owner changes.
+type FieldHistoryConnector interface {
+	Connector
+
+	// ReadFieldHistory returns a page of changes made to the requested fields, oldest first within a record.
+	// Only fields the provider tracks have history.
+	ReadFieldHistory(ctx context.Context, params *common.FieldHistoryParams) (*common.FieldHistoryResult, error)
+}
+
 // ObjectMetadataConnector is an interface that extends the Connector interface with
 // the ability to list object metadata.
 type ObjectMetadataConnector interface {
@@ -158,6 +213,41 @@ type AuthMetadataConnector interface {
 	GetPostAuthInfo(ctx context.Context) (*common.PostAuthInfo, error)
 }
 
+// HealthChecker is an interface that extends the Connector interface with
+// the ability to verify that the connection's credentials still work.
+// Implement it when the catalog AuthHealthCheck cannot describe the check,
+// otherwise CheckCredentials derives the check from ProviderInfo.
+type HealthChecker interface {
+	Connector
+
+	// CheckCredentials performs a request which does not mutate state and classifies the outcome.
+	// Provider failures are described by the result, errors mean the check could not be performed.
+	CheckCredentials(ctx context.Context) (*common.CredentialCheckResult, error)
+}
+
+// ScopeChecker is an interface that extends the Connector interface with
+// the ability to tell, before a sync starts, which objects and operations
+// the granted OAuth scopes do not permit.
+type ScopeChecker interface {
+	Connector
+
+	// CheckScopes compares granted scopes against the scopes each object and operation requires.
+	CheckScopes(ctx context.Context, params common.ScopeCheckParams) (*common.ScopeCheckResult, error)
+}
+
+// QuotaConnector is an interface that extends the Connector interface with
+// the API quota state which the provider reports in its responses,
+// so that callers can throttle before requests are rejected.
+type QuotaConnector interface {
+	Connector
+
+	// Quota returns the latest status of every quota seen in responses so far.
+	Quota() []common.QuotaStatus
+
+	// OnQuota registers a callback invoked whenever a response updates a quota.
+	OnQuota(callback func(common.QuotaStatus))
+}
+
 // RecordCountConnector is an interface that extends the Connector interface with
 // the ability to retrieve record counts.
 type RecordCountConnector interface {
@@ -418,10 +508,16 @@ type (
 	ReadResult               = common.ReadResult
 	WriteResult              = common.WriteResult
 	DeleteResult             = common.DeleteResult
+	MergeParams              = common.MergeParams
+	MergeResult              = common.MergeResult
 	BatchWriteParam          = common.BatchWriteParam
 	WriteType                = common.WriteType
 	BatchWriteResult         = common.BatchWriteResult
 	BatchStatus              = common.BatchStatus
+	BulkWriteParams          = common.BulkWriteParams
+	BulkWriteJob             = common.BulkWriteJob
+	FieldHistoryParams       = common.FieldHistoryParams
+	FieldHistoryResult       = common.FieldHistoryResult
 	ListObjectMetadataResult = common.ListObjectMetadataResult
 	RecordCountParams        = common.RecordCountParams
 	RecordCountResult        = common.RecordCountResult
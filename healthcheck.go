@@ -0,0 +1,69 @@
+package connectors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// providerInfoConnector is implemented by connectors which keep ProviderInfo
+// with catalog substitutions applied, such as connectors built on internal/components.
+type providerInfoConnector interface {
+	ProviderInfo() *providers.ProviderInfo
+}
+
+// CheckCredentials verifies that the connector's credentials are still accepted by the provider.
+// It is meant to be run on a schedule to detect broken connections before a sync fails.
+//
+// The check is chosen in this order:
+//  1. the connector's own HealthChecker implementation;
+//  2. the catalog AuthHealthCheck request, using info if given, otherwise the connector's ProviderInfo;
+//  3. GetPostAuthInfo of an AuthMetadataConnector. The connector may obtain the metadata
+//     without calling the provider, therefore success is reported as CredentialStatusUnverified,
+//     while failures are classified as usual.
+//
+// Pass info when the catalog URL depends on substitutions, e.g. a workspace, which the connector
+// does not expose. Returns common.ErrCredentialCheckNotConfigured when no check is available.
+func CheckCredentials(
+	ctx context.Context, conn Connector, info *providers.ProviderInfo,
+) (*common.CredentialCheckResult, error) {
+	if checker, ok := conn.(HealthChecker); ok {
+		return checker.CheckCredentials(ctx)
+	}
+
+	if info == nil {
+		info = connectorProviderInfo(conn)
+	}
+
+	if info != nil {
+		result, err := info.CheckCredentials(ctx, conn.HTTPClient().Client)
+		if !errors.Is(err, common.ErrCredentialCheckNotConfigured) {
+			return result, err
+		}
+	}
+
+	if authMetadata, ok := conn.(AuthMetadataConnector); ok {
+		if _, err := authMetadata.GetPostAuthInfo(ctx); err != nil {
+			return common.NewCredentialCheckResult(0, err), nil
+		}
+
+		return &common.CredentialCheckResult{Status: common.CredentialStatusUnverified}, nil
+	}
+
+	return nil, common.ErrCredentialCheckNotConfigured
+}
+
+func connectorProviderInfo(conn Connector) *providers.ProviderInfo {
+	if withInfo, ok := conn.(providerInfoConnector); ok {
+		return withInfo.ProviderInfo()
+	}
+
+	info, err := providers.ReadInfo(conn.Provider())
+	if err != nil {
+		return nil
+	}
+
+	return info
+}
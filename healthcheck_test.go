@@ -0,0 +1,48 @@
+// nolint
+package connectors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCredentialsByPostAuthInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected common.CredentialStatus
+	}{
+		{
+			name:     "Metadata obtained without a provider call does not verify the credentials",
+			expected: common.CredentialStatusUnverified,
+		},
+		{
+			name:     "Failure to obtain the metadata is classified",
+			err:      common.ErrAccessToken,
+			expected: common.CredentialStatusAuthInvalidated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockConnector(t, mock.WithGetPostAuthInfo(func(context.Context) (*common.PostAuthInfo, error) {
+				return &common.PostAuthInfo{}, tt.err
+			}))
+
+			result, err := connectors.CheckCredentials(t.Context(), conn, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Status)
+			assert.False(t, result.Healthy())
+		})
+	}
+}
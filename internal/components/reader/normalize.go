@@ -0,0 +1,139 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/readhelper"
+	"github.com/amp-labs/connectors/internal/components"
+)
+
+var _ components.Reader = &NormalizingReader{}
+
+// MetadataLister is the part of components.SchemaProvider needed to normalize values.
+// Connectors implementing ListObjectMetadata by themselves pass the connector.
+type MetadataLister interface {
+	ListObjectMetadata(ctx context.Context, objects []string) (*common.ListObjectMetadataResult, error)
+}
+
+// NormalizingReader decorates a Reader and, when ReadParams.NormalizeValues is set,
+// coerces every ReadResultRow.Fields value into its canonical form using the field
+// metadata returned by the MetadataLister. Reads without the flag are passed through as is.
+//
+// The wrapped reader receives the params without the flag, so that only the decorator honors it,
+// see ReadParams.ValidateParams.
+//
+// Metadata is fetched once per object and reused for the following pages until it expires,
+// so that fields added or retyped in the provider are picked up by long-lived connectors.
+type NormalizingReader struct {
+	reader components.Reader
+	schema MetadataLister
+
+	mutex    sync.Mutex
+	metadata map[string]cachedMetadata
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// metadataTTL is how long the metadata of an object is reused.
+const metadataTTL = 15 * time.Minute
+
+type cachedMetadata struct {
+	metadata  *common.ObjectMetadata
+	expiresAt time.Time
+}
+
+// NewNormalizingReader wraps the reader with opt-in value normalization.
+func NewNormalizingReader(reader components.Reader, schema MetadataLister) *NormalizingReader {
+	return &NormalizingReader{
+		reader:   reader,
+		schema:   schema,
+		metadata: make(map[string]cachedMetadata),
+		ttl:      metadataTTL,
+		now:      time.Now,
+	}
+}
+
+func (r *NormalizingReader) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	if r.reader == nil {
+		return nil, fmt.Errorf("%w: reader is not implemented", common.ErrNotImplemented)
+	}
+
+	normalize := params.NormalizeValues
+	params.NormalizeValues = false
+
+	result, err := r.reader.Read(ctx, params)
+	if err != nil || !normalize || result == nil || len(result.Data) == 0 {
+		return result, err
+	}
+
+	if r.schema == nil {
+		return nil, fmt.Errorf("%w: value normalization requires object metadata", common.ErrNotImplemented)
+	}
+
+	metadata, err := r.objectMetadata(ctx, params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range result.Data {
+		result.Data[index].Fields = readhelper.NormalizeFields(result.Data[index].Fields, metadata.Fields)
+	}
+
+	return result, nil
+}
+
+// objectMetadata returns the cached metadata of the object, fetching it on first use or once expired.
+// Failures are not cached, the next read tries again.
+func (r *NormalizingReader) objectMetadata(ctx context.Context, objectName string) (*common.ObjectMetadata, error) {
+	r.mutex.Lock()
+	cached, ok := r.metadata[objectName]
+	r.mutex.Unlock()
+
+	if ok && r.now().Before(cached.expiresAt) {
+		return cached.metadata, nil
+	}
+
+	list, err := r.schema.ListObjectMetadata(ctx, []string{objectName})
+	if err != nil {
+		return nil, err
+	}
+
+	// Some providers key the result by the lowercase object name.
+	if metadata, ok := lookupObject(list.Result, objectName); ok {
+		r.mutex.Lock()
+		r.metadata[objectName] = cachedMetadata{
+			metadata:  &metadata,
+			expiresAt: r.now().Add(r.ttl),
+		}
+		r.mutex.Unlock()
+
+		return &metadata, nil
+	}
+
+	if err, ok := lookupObject(list.Errors, objectName); ok {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("%w: metadata for %s", common.ErrMissingExpectedValues, objectName)
+}
+
+func lookupObject[V any](registry map[string]V, objectName string) (V, bool) {
+	if value, ok := registry[objectName]; ok {
+		return value, true
+	}
+
+	for name, value := range registry {
+		if strings.EqualFold(name, objectName) {
+			return value, true
+		}
+	}
+
+	var zero V
+
+	return zero, false
+}
@@ -0,0 +1,126 @@
+package reader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizingReaderCachesMetadata(t *testing.T) {
+	t.Parallel()
+
+	lister := &countingLister{}
+	normalizing := NewNormalizingReader(staticReader{}, lister)
+
+	params := common.ReadParams{ObjectName: "orders", NormalizeValues: true}
+
+	for range 3 {
+		result, err := normalizing.Read(t.Context(), params)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), result.Data[0].Fields["total"])
+	}
+
+	require.Equal(t, 1, lister.calls, "metadata must be fetched once per object")
+}
+
+func TestNormalizingReaderRefreshesExpiredMetadata(t *testing.T) {
+	t.Parallel()
+
+	lister := &countingLister{}
+	normalizing := NewNormalizingReader(staticReader{}, lister)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	normalizing.now = func() time.Time { return now }
+
+	params := common.ReadParams{ObjectName: "orders", NormalizeValues: true}
+
+	_, err := normalizing.Read(t.Context(), params)
+	require.NoError(t, err)
+
+	now = now.Add(metadataTTL - time.Second)
+	_, err = normalizing.Read(t.Context(), params)
+	require.NoError(t, err)
+	require.Equal(t, 1, lister.calls, "metadata is reused until it expires")
+
+	now = now.Add(time.Second)
+	_, err = normalizing.Read(t.Context(), params)
+	require.NoError(t, err)
+	require.Equal(t, 2, lister.calls, "expired metadata must be fetched again")
+}
+
+func TestNormalizingReaderHidesFlagFromWrappedReader(t *testing.T) {
+	t.Parallel()
+
+	normalizing := NewNormalizingReader(validatingReader{}, &countingLister{})
+
+	result, err := normalizing.Read(t.Context(), common.ReadParams{
+		ObjectName:      "orders",
+		Fields:          datautils.NewSet("total"),
+		NormalizeValues: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(42), result.Data[0].Fields["total"])
+}
+
+func TestReadParamsRejectUnhonoredNormalization(t *testing.T) {
+	t.Parallel()
+
+	_, err := validatingReader{}.Read(t.Context(), common.ReadParams{
+		ObjectName:      "orders",
+		Fields:          datautils.NewSet("total"),
+		NormalizeValues: true,
+	})
+	require.ErrorIs(t, err, common.ErrNotImplemented)
+}
+
+// staticReader returns the same page for every read.
+type staticReader struct{}
+
+func (staticReader) Read(context.Context, common.ReadParams) (*common.ReadResult, error) {
+	return &common.ReadResult{
+		Rows: 1,
+		Data: []common.ReadResultRow{{
+			Fields: map[string]any{"total": "42"},
+		}},
+		Done: true,
+	}, nil
+}
+
+// validatingReader validates the params the way readers of connectors do.
+type validatingReader struct {
+	staticReader
+}
+
+func (r validatingReader) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(true); err != nil {
+		return nil, err
+	}
+
+	return r.staticReader.Read(ctx, params)
+}
+
+// countingLister counts how many times metadata was requested.
+type countingLister struct {
+	calls int
+}
+
+func (l *countingLister) ListObjectMetadata(
+	_ context.Context, objects []string,
+) (*common.ListObjectMetadataResult, error) {
+	l.calls++
+
+	result := common.NewListObjectMetadataResult()
+	for _, object := range objects {
+		result.Result[object] = common.ObjectMetadata{
+			Fields: common.FieldsMetadata{
+				"total": {ValueType: common.ValueTypeInt},
+			},
+		}
+	}
+
+	return result, nil
+}
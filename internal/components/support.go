@@ -2,6 +2,8 @@ package components
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/providers"
@@ -27,7 +29,9 @@ type EndpointRegistry struct {
 type EndpointRegistryInput map[common.ModuleID][]struct {
 	Endpoint string
 	Support  providers.Support
-	glob     glob.Glob // Compiled pattern for matching
+	// Scopes required by each operation on matching objects, optional.
+	Scopes common.ScopeRequirements
+	glob   glob.Glob // Compiled pattern for matching
 }
 
 func NewEndpointRegistry(es EndpointRegistryInput) (*EndpointRegistry, error) {
@@ -45,6 +49,21 @@ func NewEmptyEndpointRegistry() *EndpointRegistry {
 	}
 }
 
+// NewEmptyEndpointRegistryWithScopes supports every endpoint like NewEmptyEndpointRegistry,
+// while declaring the scopes required by the endpoints of the input.
+// Connectors without per-object support pass it to their operations and use it for CheckScopes,
+// the Support of the input entries is left empty.
+func NewEmptyEndpointRegistryWithScopes(scopes EndpointRegistryInput) (*EndpointRegistry, error) {
+	registry, err := NewEndpointRegistry(scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	registry.allowAll = true
+
+	return registry, nil
+}
+
 // Quick access to common support levels.
 // nolint:gochecknoglobals
 var (
@@ -109,6 +128,101 @@ func (p *EndpointRegistry) GetSupport(module common.ModuleID, path string) (*pro
 	return &support, nil
 }
 
+// RequiredScopes returns scopes declared by all patterns matching the object.
+func (p *EndpointRegistry) RequiredScopes(module common.ModuleID, objectName string) common.ScopeRequirements {
+	requirements := common.ScopeRequirements{}
+
+	for _, endpoint := range p.patterns[module] {
+		if endpoint.glob.Match(objectName) {
+			requirements.Read = appendUnique(requirements.Read, endpoint.Scopes.Read)
+			requirements.Write = appendUnique(requirements.Write, endpoint.Scopes.Write)
+			requirements.Delete = appendUnique(requirements.Delete, endpoint.Scopes.Delete)
+		}
+	}
+
+	return requirements
+}
+
+// CheckScopes compares the granted scopes against declared scope requirements and reports
+// every object and operation which will be rejected by the provider.
+// Without explicit object names, objects are taken from patterns which list names literally,
+// such as "contacts" or "{contacts,companies}".
+func (p *EndpointRegistry) CheckScopes(module common.ModuleID, params common.ScopeCheckParams) *common.ScopeCheckResult {
+	objectNames := params.ObjectNames
+	if len(objectNames) == 0 {
+		objectNames = p.declaredObjects(module)
+	}
+
+	operations := params.Operations
+	if len(operations) == 0 {
+		operations = []common.ScopeOperation{
+			common.ScopeOperationRead, common.ScopeOperationWrite, common.ScopeOperationDelete,
+		}
+	}
+
+	result := &common.ScopeCheckResult{}
+
+	for _, objectName := range objectNames {
+		requirements := p.RequiredScopes(module, objectName)
+
+		for _, operation := range operations {
+			missing := common.MissingScopesOf(requirements.For(operation), params.GrantedScopes)
+			if len(missing) == 0 {
+				continue
+			}
+
+			result.Missing = append(result.Missing, common.MissingScopes{
+				Module:     module,
+				ObjectName: objectName,
+				Operation:  operation,
+				Scopes:     missing,
+			})
+		}
+	}
+
+	return result
+}
+
+// declaredObjects lists object names written literally in patterns which declare scopes.
+func (p *EndpointRegistry) declaredObjects(module common.ModuleID) []string {
+	var names []string
+
+	for _, endpoint := range p.patterns[module] {
+		scopes := endpoint.Scopes
+		if len(scopes.Read)+len(scopes.Write)+len(scopes.Delete) == 0 {
+			continue
+		}
+
+		names = appendUnique(names, literalNames(endpoint.Endpoint))
+	}
+
+	return names
+}
+
+// literalNames expands a pattern which is a plain name or a brace list of plain names.
+func literalNames(pattern string) []string {
+	inner := pattern
+	if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+		inner = pattern[1 : len(pattern)-1]
+	}
+
+	if strings.ContainsAny(inner, "*?[]{}!\\") {
+		return nil
+	}
+
+	return strings.Split(inner, ",")
+}
+
+func appendUnique(list []string, values []string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
+
 // mergeSupport combines two support configurations using OR operations.
 func mergeSupport(base *providers.Support, additional providers.Support) {
 	base.Read = base.Read || additional.Read
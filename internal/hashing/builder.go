@@ -1,4 +1,5 @@
-package memstore
+// Package hashing builds deterministic hashes of structured data.
+package hashing
 
 import (
 	"encoding/binary"
@@ -14,14 +15,14 @@ const (
 	int64Size = 8
 )
 
-// hashable represents types that can contribute their content to a hash computation.
+// Hashable represents types that can contribute their content to a hash computation.
 // Types implementing this interface can be included in deterministic hash calculations
-// via the hashBuilder.Hashable method.
-type hashable interface {
+// via the Builder.Hashable method.
+type Hashable interface {
 	UpdateHash(h hash.Hash) error
 }
 
-// hashBuilder provides a fluent API for deterministic hashing of structured data.
+// Builder provides a fluent API for deterministic hashing of structured data.
 //
 // The builder ensures consistent hash values by:
 //   - Using field separators (null bytes) between consecutive field values
@@ -34,21 +35,27 @@ type hashable interface {
 //
 // Example usage:
 //
-//	builder := &hashBuilder{h: sha256.New()}
+//	h := sha256.New()
+//	builder := hashing.NewBuilder(h)
 //	builder.String("contact").Int64(12345).Bool(true)
 //	if err := builder.Error(); err != nil {
 //	    // handle error
 //	}
-//	hash := builder.h.Sum(nil)
-type hashBuilder struct {
+//	hash := h.Sum(nil)
+type Builder struct {
 	h    hash.Hash // The underlying hash implementation (e.g., SHA-256, MD5)
 	fc   int       // Field count - tracks number of fields added for separator logic
 	errs []error   // Accumulated errors from hash write operations
 }
 
+// NewBuilder creates a builder writing into the given hash.
+func NewBuilder(h hash.Hash) *Builder {
+	return &Builder{h: h}
+}
+
 // String adds a string value to the hash.
 // The string is written as UTF-8 bytes.
-func (b *hashBuilder) String(s string) *hashBuilder {
+func (b *Builder) String(s string) *Builder {
 	b.incrementField()
 	b.write([]byte(s))
 
@@ -57,7 +64,7 @@ func (b *hashBuilder) String(s string) *hashBuilder {
 
 // Nil adds a nil marker (byte value 0) to the hash.
 // Used to explicitly represent nil values in the hash computation.
-func (b *hashBuilder) Nil() *hashBuilder {
+func (b *Builder) Nil() *Builder {
 	b.incrementField()
 	b.write([]byte{0})
 
@@ -66,7 +73,7 @@ func (b *hashBuilder) Nil() *hashBuilder {
 
 // NonNil adds a non-nil marker (byte value 1) to the hash.
 // Used to explicitly represent non-nil values in the hash computation.
-func (b *hashBuilder) NonNil() *hashBuilder {
+func (b *Builder) NonNil() *Builder {
 	b.incrementField()
 	b.write([]byte{1})
 
@@ -76,7 +83,7 @@ func (b *hashBuilder) NonNil() *hashBuilder {
 // Rat adds a rational number (jsonschema.Rat) to the hash.
 // Nil values are represented as byte 0, non-nil values as byte 1 followed by
 // the string representation of the rational number.
-func (b *hashBuilder) Rat(rat *jsonschema.Rat) {
+func (b *Builder) Rat(rat *jsonschema.Rat) {
 	b.incrementField()
 
 	if rat == nil {
@@ -90,7 +97,7 @@ func (b *hashBuilder) Rat(rat *jsonschema.Rat) {
 // StringPtr adds a string pointer to the hash.
 // Nil pointers are represented as byte 0, non-nil pointers as byte 1 followed by
 // the string value. This ensures nil and empty string produce different hashes.
-func (b *hashBuilder) StringPtr(s *string) *hashBuilder {
+func (b *Builder) StringPtr(s *string) *Builder {
 	b.incrementField()
 
 	if s == nil {
@@ -105,7 +112,7 @@ func (b *hashBuilder) StringPtr(s *string) *hashBuilder {
 
 // Bool adds a boolean value to the hash.
 // True is represented as byte 1, false as byte 0.
-func (b *hashBuilder) Bool(v bool) *hashBuilder {
+func (b *Builder) Bool(v bool) *Builder {
 	b.incrementField()
 
 	if v {
@@ -120,7 +127,7 @@ func (b *hashBuilder) Bool(v bool) *hashBuilder {
 // BoolPtr adds a boolean pointer to the hash.
 // Nil pointers are represented as {0, 0}, true as {1, 1}, and false as {1, 0}.
 // This three-way distinction ensures nil, false, and true all produce different hashes.
-func (b *hashBuilder) BoolPtr(v *bool) *hashBuilder {
+func (b *Builder) BoolPtr(v *bool) *Builder {
 	b.incrementField()
 
 	switch {
@@ -138,7 +145,7 @@ func (b *hashBuilder) BoolPtr(v *bool) *hashBuilder {
 // Int64 adds a 64-bit integer to the hash.
 // The integer is encoded as 8 bytes in big-endian format for deterministic representation
 // across different architectures.
-func (b *hashBuilder) Int64(v int64) *hashBuilder {
+func (b *Builder) Int64(v int64) *Builder {
 	b.incrementField()
 
 	bts := make([]byte, int64Size)
@@ -152,7 +159,7 @@ func (b *hashBuilder) Int64(v int64) *hashBuilder {
 // Float64 adds a 64-bit floating point number to the hash.
 // The float is converted to its IEEE 754 bit representation and encoded as 8 bytes
 // in big-endian format for deterministic representation.
-func (b *hashBuilder) Float64(v float64) *hashBuilder {
+func (b *Builder) Float64(v float64) *Builder {
 	b.incrementField()
 
 	bits64 := math.Float64bits(v)
@@ -169,7 +176,7 @@ func (b *hashBuilder) Float64(v float64) *hashBuilder {
 // Float64Ptr adds a float64 pointer to the hash.
 // Nil pointers are represented as byte 0, non-nil pointers as byte 1 followed by
 // the 8-byte IEEE 754 representation in big-endian format.
-func (b *hashBuilder) Float64Ptr(value *float64) *hashBuilder {
+func (b *Builder) Float64Ptr(value *float64) *Builder {
 	b.incrementField()
 
 	if value == nil {
@@ -195,7 +202,7 @@ func (b *hashBuilder) Float64Ptr(value *float64) *hashBuilder {
 // Nil values are represented as byte 0, non-nil values as byte 1 followed by
 // the result of calling UpdateHash on the hashable object.
 // Any errors from UpdateHash are accumulated in the builder's error list.
-func (b *hashBuilder) Hashable(h hashable) *hashBuilder {
+func (b *Builder) Hashable(h Hashable) *Builder {
 	b.incrementField()
 
 	if h == nil {
@@ -214,7 +221,7 @@ func (b *hashBuilder) Hashable(h hashable) *hashBuilder {
 // Error returns any accumulated errors from hash operations.
 // Returns nil if no errors occurred, a single error if only one occurred,
 // or a joined error combining all errors if multiple occurred.
-func (b *hashBuilder) Error() error {
+func (b *Builder) Error() error {
 	if len(b.errs) == 0 {
 		return nil
 	} else if len(b.errs) == 1 {
@@ -227,7 +234,7 @@ func (b *hashBuilder) Error() error {
 // incrementField adds a field separator (null byte) before each field after the first.
 // This ensures that different field combinations produce different hashes.
 // For example, String("ab").String("c") will hash differently than String("a").String("bc").
-func (b *hashBuilder) incrementField() {
+func (b *Builder) incrementField() {
 	if b.fc > 0 {
 		b.h.Write([]byte{0})
 	}
@@ -237,7 +244,7 @@ func (b *hashBuilder) incrementField() {
 
 // write writes bytes to the hash and accumulates any errors.
 // Errors are rare with standard hash implementations but are tracked for completeness.
-func (b *hashBuilder) write(bts []byte) {
+func (b *Builder) write(bts []byte) {
 	_, err := b.h.Write(bts)
 	if err != nil {
 		b.errs = append(b.errs, err)
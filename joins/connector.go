@@ -0,0 +1,113 @@
+// Package joins hydrates ReadParams.AssociatedObjects for any connector whose object metadata
+// describes lookup fields through common.FieldMetadata.ReferenceTo.
+//
+// For every page of rows the join engine:
+//  1. finds fields of the read object that reference each requested associated object;
+//  2. collects the foreign keys stored in those fields;
+//  3. fetches the referenced records in batch via connectors.BatchRecordReaderConnector,
+//     or falls back to one Read filtered by all keys, failing with readhelper.ErrAssociationsUnsupported
+//     when the connector cannot filter the target by id;
+//  4. attaches the fetched records to rows as common.Association entries.
+//
+// Usage:
+//
+//	conn, err := joins.NewConnector(pipedriveConnector, joins.WithTargetFields("persons", "name", "email"))
+//	result, err := conn.Read(ctx, common.ReadParams{
+//		ObjectName:        "deals",
+//		Fields:            connectors.Fields("title"),
+//		AssociatedObjects: []string{"persons"},
+//	})
+package joins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+// Connector wraps a provider connector and resolves associated objects through reference metadata
+// instead of passing ReadParams.AssociatedObjects to the provider connector.
+type Connector struct {
+	connectors.Connector
+
+	reader   connectors.ReadConnector
+	metadata connectors.ObjectMetadataConnector
+	// targetFields are fields fetched for each associated object, when chosen by the caller.
+	targetFields map[string][]string
+}
+
+var _ connectors.ReadConnector = (*Connector)(nil)
+
+// Option configures the join connector.
+type Option func(*Connector)
+
+// WithTargetFields chooses the fields fetched for the associated object.
+// By default, every field listed in the object metadata is fetched.
+func WithTargetFields(target string, fields ...string) Option {
+	return func(c *Connector) {
+		c.targetFields[target] = fields
+	}
+}
+
+// NewConnector wraps the connector with generic joins.
+// The connector must implement both connectors.ReadConnector and connectors.ObjectMetadataConnector.
+func NewConnector(conn connectors.Connector, opts ...Option) (*Connector, error) {
+	reader, ok := conn.(connectors.ReadConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support read", common.ErrNotImplemented, conn.Provider())
+	}
+
+	metadata, ok := conn.(connectors.ObjectMetadataConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not provide object metadata", common.ErrNotImplemented, conn.Provider())
+	}
+
+	result := &Connector{
+		Connector:    conn,
+		reader:       reader,
+		metadata:     metadata,
+		targetFields: make(map[string][]string),
+	}
+
+	for _, opt := range opts {
+		opt(result)
+	}
+
+	return result, nil
+}
+
+// Read reads a page of records and attaches the requested associated objects.
+// Reads without associated objects are passed through as is.
+func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	if len(params.AssociatedObjects) == 0 {
+		return c.reader.Read(ctx, params)
+	}
+
+	plan, err := c.plan(ctx, params.ObjectName, params.AssociatedObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	providerParams := params
+	providerParams.AssociatedObjects = nil
+	providerParams.Fields, plan.hidden = plan.withReferenceFields(params.Fields)
+
+	result, err := c.reader.Read(ctx, providerParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.hydrate(ctx, plan, result.Data); err != nil {
+		return nil, err
+	}
+
+	for index := range result.Data {
+		for _, field := range plan.hidden {
+			delete(result.Data[index].Fields, field)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,220 @@
+// nolint
+package joins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/readhelper"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listMetadata(ctx context.Context, objectNames []string) (*common.ListObjectMetadataResult, error) {
+	result := common.NewListObjectMetadataResult()
+	result.Result["deals"] = *common.NewObjectMetadata("Deals", common.FieldsMetadata{
+		"id":        {DisplayName: "ID"},
+		"title":     {DisplayName: "Title"},
+		"person_id": {DisplayName: "Person", ReferenceTo: []string{"persons"}},
+	})
+	result.Result["persons"] = *common.NewObjectMetadata("Persons", common.FieldsMetadata{
+		"id":   {DisplayName: "ID"},
+		"name": {DisplayName: "Name"},
+	})
+
+	return result, nil
+}
+
+func readDeals(params common.ReadParams) *common.ReadResult {
+	return &common.ReadResult{
+		Rows: 3,
+		Data: []common.ReadResultRow{
+			{Id: "1", Fields: map[string]any{"title": "A", "person_id": float64(10)}},
+			{Id: "2", Fields: map[string]any{"title": "B", "person_id": map[string]any{"id": "11"}}},
+			{Id: "3", Fields: map[string]any{"title": "C", "person_id": nil}},
+		},
+		Done: true,
+	}
+}
+
+func TestReadWithBatchReader(t *testing.T) {
+	t.Parallel()
+
+	var requestedIds []string
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(listMetadata),
+		mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+			assert.Empty(t, params.AssociatedObjects)
+			assert.ElementsMatch(t, []string{"title", "person_id"}, params.Fields.List())
+
+			return readDeals(params), nil
+		}),
+		mock.WithGetRecordsByIds(func(ctx context.Context, objectName string, recordIds []string,
+			fields []string, associations []string,
+		) ([]common.ReadResultRow, error) {
+			assert.Equal(t, "persons", objectName)
+			requestedIds = recordIds
+
+			return []common.ReadResultRow{
+				{Id: "10", Raw: map[string]any{"name": "Ann"}},
+				{Id: "11", Raw: map[string]any{"name": "Bob"}},
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	joined, err := NewConnector(conn)
+	require.NoError(t, err)
+
+	result, err := joined.Read(t.Context(), common.ReadParams{
+		ObjectName:        "deals",
+		Fields:            connectors.Fields("title"),
+		AssociatedObjects: []string{"persons"},
+	})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"10", "11"}, requestedIds)
+	assert.Equal(t, map[string]any{"title": "A"}, result.Data[0].Fields, "reference field was not requested")
+	assert.Equal(t, []common.Association{{ObjectId: "10", Raw: map[string]any{"name": "Ann"}}},
+		result.Data[0].Associations["persons"])
+	assert.Equal(t, []common.Association{{ObjectId: "11", Raw: map[string]any{"name": "Bob"}}},
+		result.Data[1].Associations["persons"])
+	assert.Nil(t, result.Data[2].Associations)
+}
+
+func TestReadWithFilteredReadFallback(t *testing.T) {
+	t.Parallel()
+
+	reads := 0
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(listMetadata),
+		mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+			if params.ObjectName == "deals" {
+				return readDeals(params), nil
+			}
+
+			reads++
+
+			assert.ElementsMatch(t, []string{"id", "name"}, params.Fields.List(), "target fields come from metadata")
+			require.Len(t, params.BuilderFilter.FieldFilters, 1)
+			assert.Equal(t, common.FilterOperatorIN, params.BuilderFilter.FieldFilters[0].Operator)
+			assert.ElementsMatch(t, []string{"10", "11"}, params.BuilderFilter.FieldFilters[0].Value)
+
+			return &common.ReadResult{Data: []common.ReadResultRow{
+				{Id: "10", Raw: map[string]any{"name": "Ann"}},
+				{Id: "11", Raw: map[string]any{"name": "Bob"}},
+			}, Done: true}, nil
+		}),
+		mock.WithGetRecordsByIds(func(ctx context.Context, objectName string, recordIds []string,
+			fields []string, associations []string,
+		) ([]common.ReadResultRow, error) {
+			return nil, common.ErrNotImplemented
+		}),
+	)
+	require.NoError(t, err)
+
+	joined, err := NewConnector(conn)
+	require.NoError(t, err)
+
+	result, err := joined.Read(t.Context(), common.ReadParams{
+		ObjectName:        "deals",
+		Fields:            connectors.Fields("title", "person_id"),
+		AssociatedObjects: []string{"persons"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, reads, "keys are fetched with a single read")
+	assert.Equal(t, float64(10), result.Data[0].Fields["person_id"], "requested reference field is kept")
+	assert.Equal(t, "10", result.Data[0].Associations["persons"][0].ObjectId)
+	assert.Equal(t, "11", result.Data[1].Associations["persons"][0].ObjectId)
+}
+
+func TestReadWithTargetFields(t *testing.T) {
+	t.Parallel()
+
+	var requestedFields []string
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(listMetadata),
+		mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+			return readDeals(params), nil
+		}),
+		mock.WithGetRecordsByIds(func(ctx context.Context, objectName string, recordIds []string,
+			fields []string, associations []string,
+		) ([]common.ReadResultRow, error) {
+			requestedFields = fields
+
+			return []common.ReadResultRow{}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	joined, err := NewConnector(conn, WithTargetFields("persons", "email"))
+	require.NoError(t, err)
+
+	_, err = joined.Read(t.Context(), common.ReadParams{
+		ObjectName:        "deals",
+		Fields:            connectors.Fields("title"),
+		AssociatedObjects: []string{"persons"},
+	})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"id", "email"}, requestedFields)
+}
+
+func TestReadWithUnfilteredRead(t *testing.T) {
+	t.Parallel()
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(listMetadata),
+		mock.WithRead(func(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+			if params.ObjectName == "deals" {
+				return readDeals(params), nil
+			}
+
+			// Simulate a provider which ignores the filter and returns more than the requested records.
+			return &common.ReadResult{Data: []common.ReadResultRow{
+				{Id: "10", Raw: map[string]any{"name": "Ann"}},
+				{Id: "12", Raw: map[string]any{"name": "Cid"}},
+			}, Done: true}, nil
+		}),
+		mock.WithGetRecordsByIds(func(ctx context.Context, objectName string, recordIds []string,
+			fields []string, associations []string,
+		) ([]common.ReadResultRow, error) {
+			return nil, common.ErrNotImplemented
+		}),
+	)
+	require.NoError(t, err)
+
+	joined, err := NewConnector(conn)
+	require.NoError(t, err)
+
+	_, err = joined.Read(t.Context(), common.ReadParams{
+		ObjectName:        "deals",
+		Fields:            connectors.Fields("title"),
+		AssociatedObjects: []string{"persons"},
+	})
+	require.ErrorIs(t, err, readhelper.ErrAssociationsUnsupported)
+}
+
+func TestReadWithoutReferenceMetadata(t *testing.T) {
+	t.Parallel()
+
+	conn, err := mock.NewConnector(mock.WithListObjectMetadata(listMetadata))
+	require.NoError(t, err)
+
+	joined, err := NewConnector(conn)
+	require.NoError(t, err)
+
+	_, err = joined.Read(t.Context(), common.ReadParams{
+		ObjectName:        "deals",
+		Fields:            connectors.Fields("title"),
+		AssociatedObjects: []string{"organizations"},
+	})
+	require.ErrorIs(t, err, readhelper.ErrAssociationsUnsupported)
+}
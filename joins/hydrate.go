@@ -0,0 +1,173 @@
+package joins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/readhelper"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// hydrate attaches associations for every planned join to the rows in place.
+func (c *Connector) hydrate(ctx context.Context, plan *plan, rows []common.ReadResultRow) error {
+	for _, join := range plan.joins {
+		keys := foreignKeys(rows, join.fields)
+		if len(keys) == 0 {
+			continue
+		}
+
+		targets, err := c.fetch(ctx, join, keys)
+		if err != nil {
+			return fmt.Errorf("joining %s: %w", join.target, err)
+		}
+
+		for index := range rows {
+			attach(&rows[index], join, targets)
+		}
+	}
+
+	return nil
+}
+
+// fetch retrieves target records by id, keyed by record id.
+func (c *Connector) fetch(ctx context.Context, join join, keys []string) (map[string]common.ReadResultRow, error) {
+	if batch, ok := c.Connector.(connectors.BatchRecordReaderConnector); ok {
+		rows, err := batch.GetRecordsByIds(ctx, join.target, keys, join.targetFields, nil)
+		if err == nil {
+			return indexByID(rows), nil
+		}
+
+		if !unsupported(err) {
+			return nil, err
+		}
+	}
+
+	return c.fetchByRead(ctx, join, keys)
+}
+
+// fetchByRead reads all target records with a single Read filtered by the keys.
+// Not every connector honours BuilderFilter, a row outside the keys means the target
+// cannot be filtered by id and the join is unsupported.
+func (c *Connector) fetchByRead(
+	ctx context.Context, join join, keys []string,
+) (map[string]common.ReadResultRow, error) {
+	requested := datautils.NewStringSet(keys...)
+	result := make(map[string]common.ReadResultRow, len(keys))
+
+	params := common.ReadParams{
+		ObjectName: join.target,
+		Fields:     datautils.NewStringSet(join.targetFields...),
+		BuilderFilter: &common.SearchFilter{
+			FieldFilters: []common.FieldFilter{{
+				FieldName: join.idField,
+				Operator:  common.FilterOperatorIN,
+				Value:     keys,
+			}},
+		},
+	}
+
+	for {
+		page, err := c.reader.Read(ctx, params)
+		if err != nil {
+			if unsupported(err) {
+				return nil, fmt.Errorf("%w: %w", readhelper.ErrAssociationsUnsupported, err)
+			}
+
+			return nil, err
+		}
+
+		for _, row := range page.Data {
+			if !requested.Has(row.Id) {
+				return nil, fmt.Errorf("%w: %s cannot be filtered by %s",
+					readhelper.ErrAssociationsUnsupported, join.target, join.idField)
+			}
+
+			result[row.Id] = row
+		}
+
+		if page.Done || page.NextPage == "" {
+			return result, nil
+		}
+
+		params.NextPage = page.NextPage
+	}
+}
+
+// unsupported reports whether a batch read is unavailable for the object, as opposed to having failed.
+func unsupported(err error) bool {
+	return errors.Is(err, common.ErrNotImplemented) ||
+		errors.Is(err, common.ErrOperationNotSupportedForObject) ||
+		errors.Is(err, common.ErrObjectNotSupported)
+}
+
+func attach(row *common.ReadResultRow, join join, targets map[string]common.ReadResultRow) {
+	for _, field := range join.fields {
+		key := foreignKey(row.Fields[strings.ToLower(field)])
+
+		target, ok := targets[key]
+		if key == "" || !ok {
+			continue
+		}
+
+		if row.Associations == nil {
+			row.Associations = make(map[string][]common.Association)
+		}
+
+		row.Associations[join.target] = append(row.Associations[join.target], common.Association{
+			ObjectId: key,
+			Raw:      target.Raw,
+		})
+	}
+}
+
+// foreignKeys returns unique non-empty keys stored in the reference fields of the rows.
+func foreignKeys(rows []common.ReadResultRow, fields []string) []string {
+	keys := datautils.NewStringSet()
+
+	for _, row := range rows {
+		for _, field := range fields {
+			if key := foreignKey(row.Fields[strings.ToLower(field)]); key != "" {
+				keys.AddOne(key)
+			}
+		}
+	}
+
+	return keys.List()
+}
+
+// foreignKey converts a reference field value into a record id.
+// References are stored either as a bare id or as an expanded object with an id.
+func foreignKey(value any) string {
+	switch key := value.(type) {
+	case string:
+		return key
+	case float64:
+		return strconv.FormatFloat(key, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(key, 10)
+	case int:
+		return strconv.Itoa(key)
+	case map[string]any:
+		for name, nested := range key {
+			if strings.EqualFold(name, defaultIDField) || name == "value" {
+				return foreignKey(nested)
+			}
+		}
+	}
+
+	return ""
+}
+
+func indexByID(rows []common.ReadResultRow) map[string]common.ReadResultRow {
+	result := make(map[string]common.ReadResultRow, len(rows))
+	for _, row := range rows {
+		result[row.Id] = row
+	}
+
+	return result
+}
@@ -0,0 +1,141 @@
+package joins
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/readhelper"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// defaultIDField is used to filter target objects when their metadata has no id field.
+const defaultIDField = "id"
+
+// plan describes how associated objects of a single read are resolved.
+type plan struct {
+	joins []join
+	// hidden are reference fields added to the read which the caller did not request.
+	hidden []string
+}
+
+// join resolves one associated object through the reference fields pointing at it.
+type join struct {
+	target string
+	// fields of the source object referencing the target, as named in the provider metadata.
+	fields []string
+	// idField is the target field holding the record id, used by filtered reads.
+	idField string
+	// targetFields are the fields fetched for each target record.
+	targetFields []string
+}
+
+func (c *Connector) plan(ctx context.Context, objectName string, targets []string) (*plan, error) {
+	objects := append([]string{objectName}, targets...)
+
+	metadata, err := c.metadata.ListObjectMetadata(ctx, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	source, ok := metadata.Result[objectName]
+	if !ok {
+		if err := metadata.Errors[objectName]; err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %s", common.ErrObjectNotSupported, objectName)
+	}
+
+	result := &plan{joins: make([]join, 0, len(targets))}
+
+	for _, target := range targets {
+		fields := referenceFields(source.Fields, target)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w: fromObject %v, toObject %v",
+				readhelper.ErrAssociationsUnsupported, objectName, target)
+		}
+
+		targetMetadata := metadata.Result[target]
+		targetID := idField(targetMetadata)
+
+		result.joins = append(result.joins, join{
+			target:       target,
+			fields:       fields,
+			idField:      targetID,
+			targetFields: c.fieldsOf(target, targetMetadata, targetID),
+		})
+	}
+
+	return result, nil
+}
+
+// withReferenceFields returns requested fields extended with the reference fields needed for joins,
+// together with the lowercase keys of fields that were added and must be removed from the rows.
+func (p *plan) withReferenceFields(requested datautils.StringSet) (datautils.StringSet, []string) {
+	fields := datautils.NewStringSet(requested.List()...)
+	requestedKeys := datautils.NewStringSet()
+
+	for _, field := range requested.List() {
+		requestedKeys.AddOne(strings.ToLower(field))
+	}
+
+	hidden := datautils.NewStringSet()
+
+	for _, join := range p.joins {
+		for _, field := range join.fields {
+			if requestedKeys.Has(strings.ToLower(field)) {
+				continue
+			}
+
+			fields.AddOne(field)
+			hidden.AddOne(strings.ToLower(field))
+		}
+	}
+
+	return fields, hidden.List()
+}
+
+// referenceFields lists fields whose ReferenceTo includes the target object.
+func referenceFields(fields common.FieldsMetadata, target string) []string {
+	result := make([]string, 0)
+
+	for name, field := range fields {
+		for _, reference := range field.ReferenceTo {
+			if strings.EqualFold(reference, target) {
+				result = append(result, name)
+
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// fieldsOf returns the target fields chosen by the caller, otherwise all fields from the target metadata.
+// The id field is always included.
+func (c *Connector) fieldsOf(target string, metadata common.ObjectMetadata, idField string) []string {
+	fields := datautils.NewStringSet(idField)
+
+	if chosen, ok := c.targetFields[target]; ok {
+		fields.Add(chosen)
+	} else {
+		fields.Add(slices.Collect(maps.Keys(metadata.Fields)))
+	}
+
+	return fields.List()
+}
+
+func idField(metadata common.ObjectMetadata) string {
+	for name := range metadata.Fields {
+		if strings.EqualFold(name, defaultIDField) {
+			return name
+		}
+	}
+
+	return defaultIDField
+}
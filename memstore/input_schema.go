@@ -5,6 +5,7 @@ import (
 	"hash"
 	"sort"
 
+	"github.com/amp-labs/connectors/internal/hashing"
 	"github.com/kaptinlin/jsonschema"
 )
 
@@ -13,19 +14,19 @@ import (
 // in the $defs field or when managing a collection of reusable schema components.
 type InputSchemaMap map[string]*InputSchema
 
-// UpdateHash implements the hashable interface for InputSchemaMap, enabling deterministic
+// UpdateHash implements the hashing.Hashable interface for InputSchemaMap, enabling deterministic
 // hash computation for schema maps.
 //
 // The method ensures deterministic hashing by:
 //   - Sorting map keys alphabetically before processing (Go maps have random iteration order)
-//   - Using the hashBuilder to consistently encode each key-value pair
+//   - Using the hashing.Builder to consistently encode each key-value pair
 //   - Recursively hashing nested InputSchema values
 //
 // This allows InputSchemaMap instances with identical content to produce identical hashes
 // regardless of insertion order or Go's internal map ordering, making it suitable for
 // cache keys and content-addressable storage.
 func (m InputSchemaMap) UpdateHash(h hash.Hash) error {
-	builder := &hashBuilder{h: h}
+	builder := hashing.NewBuilder(h)
 
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -263,14 +264,14 @@ type InputSchema struct {
 	XAmpAssociation *AssociationSchema `json:"x-amp-association,omitempty"`
 }
 
-// UpdateHash implements the hashable interface for InputSchema, enabling deterministic
+// UpdateHash implements the hashing.Hashable interface for InputSchema, enabling deterministic
 // hash computation for JSON schema definitions.
 //
 // This method produces a stable hash for the entire schema structure by:
 //   - Processing all schema fields in a fixed order (matching struct field declaration order)
 //   - Sorting all map keys (Defs, DependentSchemas, DependentRequired) alphabetically before hashing
 //   - Including array indices when hashing ordered collections (AllOf, AnyOf, OneOf, etc.)
-//   - Using type-safe encoding via hashBuilder for each field type
+//   - Using type-safe encoding via hashing.Builder for each field type
 //   - Recursively hashing nested InputSchema structures
 //
 // The deterministic nature ensures that:
@@ -288,7 +289,7 @@ type InputSchema struct {
 //
 //nolint:cyclop,funlen // Complex schema hashing requires processing all JSON Schema keywords sequentially
 func (is *InputSchema) UpdateHash(h hash.Hash) error {
-	builder := &hashBuilder{h: h}
+	builder := hashing.NewBuilder(h)
 
 	builder.String(is.ID)
 	builder.String(is.Schema)
@@ -72,6 +72,8 @@ func constructor(base *components.Connector) (*Connector, error) {
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
 
@@ -88,6 +88,8 @@ func constructor(base *components.Connector, expectedMetadataKeys []string) (*Co
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector)
+
 	connector.Writer = writer.NewHTTPWriter(
 		connector.HTTPClient().Client,
 		registry,
@@ -0,0 +1,62 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// AwsSigV4Params are the AWS credentials used to sign requests.
+type AwsSigV4Params struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials.
+	SessionToken string
+
+	// Region overrides the region of the provider's AwsSigV4Opts.
+	Region string
+
+	// RoleARN is assumed via STS with the keys above, optionally with an external ID.
+	RoleARN         string
+	ExternalID      string
+	RoleSessionName string
+}
+
+func createAwsSigV4HTTPClient( //nolint:ireturn
+	ctx context.Context,
+	client *http.Client,
+	info *ProviderInfo,
+	creds *AwsSigV4Params,
+) (common.AuthenticatedHTTPClient, error) {
+	region := info.AwsSigV4Opts.Region
+	if len(creds.Region) != 0 {
+		region = creds.Region
+	}
+
+	// The region is usually a catalog variable, which must be substituted before creating the client.
+	if len(region) == 0 || strings.Contains(region, "{{") {
+		return nil, fmt.Errorf("%w: aws region not given", ErrClient)
+	}
+
+	if len(info.AwsSigV4Opts.Service) == 0 {
+		return nil, fmt.Errorf("%w: aws service not given", ErrClient)
+	}
+
+	if len(creds.AccessKeyID) == 0 || len(creds.SecretAccessKey) == 0 {
+		return nil, fmt.Errorf("%w: aws access key not given", ErrClient)
+	}
+
+	return common.NewAWSSigV4Client(ctx, getClient(client), common.AWSSigV4Params{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Region:          region,
+		Service:         info.AwsSigV4Opts.Service,
+		RoleARN:         creds.RoleARN,
+		ExternalID:      creds.ExternalID,
+		RoleSessionName: creds.RoleSessionName,
+	})
+}
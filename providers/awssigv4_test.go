@@ -0,0 +1,75 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAwsSigV4(t *testing.T) {
+	t.Parallel()
+
+	var authorization, securityToken string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		securityToken = r.Header.Get("X-Amz-Security-Token")
+	}))
+	t.Cleanup(server.Close)
+
+	info := &ProviderInfo{
+		AuthType:     AwsSigV4,
+		BaseURL:      server.URL,
+		AwsSigV4Opts: &AwsSigV4Opts{Region: "us-east-1", Service: "execute-api"},
+	}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		AwsSigV4Creds: &AwsSigV4Params{
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "secret",
+			SessionToken:    "session",
+			Region:          "eu-west-1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/items",
+		strings.NewReader(`{"name":"item"}`))
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	_ = rsp.Body.Close()
+
+	if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+		!strings.Contains(authorization, "/eu-west-1/execute-api/aws4_request") {
+		t.Errorf("unexpected authorization %q", authorization)
+	}
+
+	if securityToken != "session" {
+		t.Errorf("expected session token, got %q", securityToken)
+	}
+}
+
+func TestAwsSigV4UnresolvedRegion(t *testing.T) {
+	t.Parallel()
+
+	info := &ProviderInfo{
+		AuthType:     AwsSigV4,
+		AwsSigV4Opts: &AwsSigV4Opts{Region: "{{.region}}", Service: "execute-api"},
+	}
+
+	_, err := info.NewClient(context.Background(), &NewClientParams{
+		AwsSigV4Creds: &AwsSigV4Params{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "secret"},
+	})
+	if !errors.Is(err, ErrClient) {
+		t.Fatalf("expected ErrClient, got %v", err)
+	}
+}
@@ -78,5 +78,7 @@ func constructor(params common.ConnectorParams, base *components.Connector) (*Co
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
@@ -103,6 +103,8 @@ func constructor(params common.ConnectorParams, base *components.Connector) (*Co
 	connector.NoopVerifier = webhook.NewVerifier(connector.JSONHTTPClient(), connector.ProviderInfo(), clientID)
 	connector.subscribeStrategy = subscriber.NewStrategy(connector.JSONHTTPClient(), connector.ProviderInfo(), clientID)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
 
@@ -91,6 +91,8 @@ func constructor(base *components.Connector) (*Connector, error) {
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector)
+
 	return connector, nil
 }
 
@@ -19,6 +19,9 @@ type Connector struct {
 	// Require authenticated client
 	common.RequireAuthenticatedClient
 
+	// QuotaTracker records the rate limits reported with every response.
+	*common.QuotaTracker
+
 	// supported operations
 	components.SchemaProvider
 	components.Reader
@@ -33,7 +36,13 @@ func NewConnector(params common.ConnectorParams) (*Connector, error) {
 
 //nolint:funlen
 func constructor(base *components.Connector) (*Connector, error) {
-	connector := &Connector{Connector: base}
+	connector := &Connector{
+		Connector:    base,
+		QuotaTracker: common.NewQuotaTracker(extractQuota),
+	}
+
+	// Wrapped before any operation captures the client.
+	connector.HTTPClient().Client = connector.QuotaTracker.Client(connector.HTTPClient().Client)
 
 	// Set the metadata provider for the connector
 	connector.SchemaProvider = schema.NewOpenAPISchemaProvider(connector.ProviderContext.Module(), metadata.Schemas)
@@ -76,5 +85,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
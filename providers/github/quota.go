@@ -0,0 +1,31 @@
+package github
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.QuotaConnector = &Connector{}
+
+// extractQuota parses the X-RateLimit-* headers, the scope is the rate limit resource, e.g. "core" or "search".
+// https://docs.github.com/en/rest/using-the-rest-api/rate-limits-for-the-rest-api#checking-the-status-of-your-rate-limit
+func extractQuota(rsp *http.Response, observedAt time.Time) []common.QuotaStatus {
+	scope := rsp.Header.Get("X-RateLimit-Resource")
+	if scope == "" {
+		scope = "core"
+	}
+
+	status, ok := common.QuotaFromHeaders(rsp.Header, common.RateLimitHeaders{
+		Limit:     "X-RateLimit-Limit",
+		Remaining: "X-RateLimit-Remaining",
+		Reset:     "X-RateLimit-Reset",
+	}, scope, observedAt)
+	if !ok {
+		return nil
+	}
+
+	return []common.QuotaStatus{status}
+}
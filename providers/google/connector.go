@@ -16,6 +16,7 @@ import (
 var (
 	_ connectors.SubscribeConnector              = &Connector{}
 	_ connectors.SubscriptionMaintainerConnector = &Connector{}
+	_ connectors.ScopeChecker                    = &Connector{}
 )
 
 // ReadParamsOpts are Google-specific options for common.ReadParams.Opts.
@@ -93,6 +94,25 @@ func NewConnectorForProvider(provider providers.Provider, params common.Connecto
 	return connector, nil
 }
 
+// CheckScopes reports objects and operations of the module which the granted scopes do not permit.
+func (c *Connector) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	if c.Calendar != nil {
+		return c.Calendar.CheckScopes(ctx, params)
+	}
+
+	if c.Contacts != nil {
+		return c.Contacts.CheckScopes(ctx, params)
+	}
+
+	if c.Mail != nil {
+		return c.Mail.CheckScopes(ctx, params)
+	}
+
+	return nil, common.ErrNotImplemented
+}
+
 func (c *Connector) ListObjectMetadata(
 	ctx context.Context, objectNames []string,
 ) (*connectors.ListObjectMetadataResult, error) {
@@ -56,7 +56,11 @@ func shouldReadEventsForAllCalendars(params common.ReadParams) bool {
 func (a *Adapter) readEventsAcrossCalendars(
 	ctx context.Context, params common.ReadParams,
 ) (*common.ReadResult, error) {
-	if err := params.ValidateParams(true); err != nil {
+	// Values are normalized by the reader of every calendar, see Adapter.Reader.
+	validated := params
+	validated.NormalizeValues = false
+
+	if err := validated.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
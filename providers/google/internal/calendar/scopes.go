@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/google/internal/core"
+)
+
+// CheckScopes reports objects and operations which the granted scopes do not permit.
+// Granted scopes are returned in the "scope" field of the token response.
+func (a *Adapter) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	return a.registry.CheckScopes(a.Module(), params), nil
+}
+
+// requiredScopes lists scopes per object. The "calendar" scope grants everything,
+// "calendar.readonly" grants reading everything.
+// https://developers.google.com/workspace/calendar/api/auth
+func requiredScopes() components.EndpointRegistryInput {
+	return components.EndpointRegistryInput{
+		providers.ModuleGoogleCalendar: {
+			{
+				Endpoint: objectNameEvents,
+				Scopes: core.ScopeRequirements(
+					core.Scopes("calendar.events.readonly", "calendar.events", "calendar.readonly", "calendar"),
+					core.Scopes("calendar.events", "calendar"),
+				),
+			},
+			{
+				Endpoint: objectNameCalendarList,
+				Scopes: core.ScopeRequirements(
+					core.Scopes("calendar.calendarlist.readonly", "calendar.calendarlist", "calendar.readonly", "calendar"),
+					core.Scopes("calendar.calendarlist", "calendar"),
+				),
+			},
+			{
+				Endpoint: objectNameACL,
+				Scopes: core.ScopeRequirements(
+					core.Scopes("calendar.acls.readonly", "calendar.acls", "calendar"),
+					core.Scopes("calendar.acls", "calendar"),
+				),
+			},
+			{
+				Endpoint: objectNameSettings,
+				Scopes: common.ScopeRequirements{
+					Read: []string{core.Scopes("calendar.settings.readonly", "calendar.readonly", "calendar")},
+				},
+			},
+		},
+	}
+}
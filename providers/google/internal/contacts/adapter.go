@@ -23,6 +23,9 @@ type Adapter struct {
 	components.Reader
 	components.Writer
 	components.Deleter
+
+	// registry supports every object and declares the OAuth scopes each object requires, used by CheckScopes.
+	registry *components.EndpointRegistry
 }
 
 func NewAdapter(params common.ConnectorParams) (*Adapter, error) {
@@ -34,6 +37,13 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		Connector: base,
 	}
 
+	registry, err := components.NewEmptyEndpointRegistryWithScopes(requiredScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	adapter.registry = registry
+
 	errorHandler := interpreter.ErrorHandler{
 		JSON: interpreter.DirectFaultyResponder{Callback: adapter.interpretJSONError},
 		HTML: interpreter.DirectFaultyResponder{Callback: adapter.interpretHTMLError},
@@ -43,7 +53,7 @@ func constructor(base *components.Connector) (*Adapter, error) {
 
 	adapter.Reader = reader.NewHTTPReader(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.ReadHandlers{
 			BuildRequest:  adapter.buildReadRequest,
@@ -52,9 +62,11 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		},
 	)
 
+	adapter.Reader = reader.NewNormalizingReader(adapter.Reader, adapter.SchemaProvider)
+
 	adapter.Writer = writer.NewHTTPWriter(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.WriteHandlers{
 			BuildRequest:  adapter.buildWriteRequest,
@@ -65,7 +77,7 @@ func constructor(base *components.Connector) (*Adapter, error) {
 
 	adapter.Deleter = deleter.NewHTTPDeleter(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.DeleteHandlers{
 			BuildRequest:  adapter.buildDeleteRequest,
@@ -0,0 +1,43 @@
+package contacts
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/google/internal/core"
+)
+
+// CheckScopes reports objects and operations which the granted scopes do not permit.
+// Granted scopes are returned in the "scope" field of the token response.
+func (a *Adapter) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	return a.registry.CheckScopes(a.Module(), params), nil
+}
+
+// requiredScopes lists scopes per object.
+// https://developers.google.com/people/api/rest/v1/people/connections/list
+func requiredScopes() components.EndpointRegistryInput {
+	return components.EndpointRegistryInput{
+		providers.ModuleGoogleContacts: {
+			{
+				Endpoint: "{" + objectNameMyConnections + "," + objectNameContactGroups + "}",
+				Scopes:   core.ScopeRequirements(core.Scopes("contacts.readonly", "contacts"), core.Scopes("contacts")),
+			},
+			{
+				Endpoint: objectNameOtherContacts,
+				Scopes: common.ScopeRequirements{
+					Read: []string{core.Scopes("contacts.other.readonly")},
+				},
+			},
+			{
+				Endpoint: objectNamePeopleDirectory,
+				Scopes: common.ScopeRequirements{
+					Read: []string{core.Scopes("directory.readonly")},
+				},
+			},
+		},
+	}
+}
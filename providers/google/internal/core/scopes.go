@@ -0,0 +1,36 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// scopePrefix qualifies the names of Google OAuth scopes.
+// https://developers.google.com/identity/protocols/oauth2/scopes
+const scopePrefix = "https://www.googleapis.com/auth/"
+
+// Scopes qualifies the scope names and joins them as alternatives, any of which satisfies the requirement.
+// Scopes which are URLs already, such as "https://mail.google.com/", are kept as is.
+func Scopes(names ...string) string {
+	scopes := make([]string, len(names))
+
+	for index, name := range names {
+		if strings.HasPrefix(name, "https://") {
+			scopes[index] = name
+		} else {
+			scopes[index] = scopePrefix + name
+		}
+	}
+
+	return strings.Join(scopes, "|")
+}
+
+// ScopeRequirements requires the read scope for reading, and the write scope for writing and deleting.
+func ScopeRequirements(read, write string) common.ScopeRequirements {
+	return common.ScopeRequirements{
+		Read:   []string{read},
+		Write:  []string{write},
+		Delete: []string{write},
+	}
+}
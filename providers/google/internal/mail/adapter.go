@@ -21,6 +21,9 @@ type Adapter struct {
 	components.Reader
 	components.Writer
 	components.Deleter
+
+	// registry supports every object and declares the OAuth scopes each object requires, used by CheckScopes.
+	registry *components.EndpointRegistry
 }
 
 func NewAdapter(params common.ConnectorParams) (*Adapter, error) {
@@ -32,6 +35,13 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		Connector: base,
 	}
 
+	registry, err := components.NewEmptyEndpointRegistryWithScopes(requiredScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	adapter.registry = registry
+
 	adapter.SchemaProvider = schema.NewOpenAPISchemaProvider(adapter.ProviderContext.Module(), Schemas)
 
 	errorHandler := interpreter.ErrorHandler{
@@ -41,7 +51,7 @@ func constructor(base *components.Connector) (*Adapter, error) {
 
 	adapter.Reader = reader.NewHTTPReader(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.ReadHandlers{
 			BuildRequest:  adapter.buildReadRequest,
@@ -50,9 +60,11 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		},
 	)
 
+	adapter.Reader = reader.NewNormalizingReader(adapter.Reader, adapter.SchemaProvider)
+
 	adapter.Writer = writer.NewHTTPWriter(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.WriteHandlers{
 			BuildRequest:  adapter.buildWriteRequest,
@@ -63,7 +75,7 @@ func constructor(base *components.Connector) (*Adapter, error) {
 
 	adapter.Deleter = deleter.NewHTTPDeleter(
 		adapter.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		adapter.registry,
 		adapter.ProviderContext.Module(),
 		operations.DeleteHandlers{
 			BuildRequest:  adapter.buildDeleteRequest,
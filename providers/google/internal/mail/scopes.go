@@ -0,0 +1,75 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/google/internal/core"
+)
+
+// fullAccessScope grants everything, it is the only scope permitting permanent deletion.
+const fullAccessScope = "https://mail.google.com/"
+
+// CheckScopes reports objects and operations which the granted scopes do not permit.
+// Granted scopes are returned in the "scope" field of the token response.
+func (a *Adapter) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	return a.registry.CheckScopes(a.Module(), params), nil
+}
+
+// requiredScopes lists scopes per object, "gmail.modify" grants everything except permanent deletion.
+// https://developers.google.com/workspace/gmail/api/auth/scopes
+func requiredScopes() components.EndpointRegistryInput {
+	readMail := core.Scopes("gmail.readonly", "gmail.modify", fullAccessScope)
+	readSettings := core.Scopes("gmail.readonly", "gmail.settings.basic", "gmail.modify", fullAccessScope)
+
+	return components.EndpointRegistryInput{
+		providers.ModuleGoogleGmail: {
+			{
+				Endpoint: objectNameMessages,
+				Scopes: common.ScopeRequirements{
+					Read:   []string{readMail},
+					Write:  []string{core.Scopes("gmail.insert", "gmail.modify", fullAccessScope)},
+					Delete: []string{core.Scopes(fullAccessScope)},
+				},
+			},
+			{
+				Endpoint: "threads",
+				Scopes: common.ScopeRequirements{
+					Read:   []string{readMail},
+					Write:  []string{core.Scopes("gmail.modify", fullAccessScope)},
+					Delete: []string{core.Scopes(fullAccessScope)},
+				},
+			},
+			{
+				Endpoint: objectNameDrafts,
+				Scopes: core.ScopeRequirements(
+					core.Scopes("gmail.readonly", "gmail.compose", "gmail.modify", fullAccessScope),
+					core.Scopes("gmail.compose", "gmail.modify", fullAccessScope),
+				),
+			},
+			{
+				Endpoint: "labels",
+				Scopes: core.ScopeRequirements(
+					core.Scopes("gmail.readonly", "gmail.labels", "gmail.modify", fullAccessScope),
+					core.Scopes("gmail.labels", "gmail.modify", fullAccessScope),
+				),
+			},
+			{
+				Endpoint: "history",
+				Scopes:   common.ScopeRequirements{Read: []string{readMail}},
+			},
+			{
+				Endpoint: "filters",
+				Scopes:   core.ScopeRequirements(readSettings, core.Scopes("gmail.settings.basic")),
+			},
+			{
+				Endpoint: "{delegates,forwardingAddresses,sendAs}",
+				Scopes:   core.ScopeRequirements(readSettings, core.Scopes("gmail.settings.sharing")),
+			},
+		},
+	}
+}
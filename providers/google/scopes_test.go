@@ -0,0 +1,105 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestCheckScopes(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		module   common.ModuleID
+		input    common.ScopeCheckParams
+		expected []common.MissingScopes
+	}{
+		{
+			name:   "Read only calendar scope does not permit writing events",
+			module: providers.ModuleGoogleCalendar,
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://www.googleapis.com/auth/calendar.readonly"},
+				ObjectNames:   []string{"events"},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "calendar", ObjectName: "events", Operation: common.ScopeOperationWrite,
+					Scopes: []string{
+						"https://www.googleapis.com/auth/calendar.events|https://www.googleapis.com/auth/calendar",
+					},
+				},
+				{
+					Module: "calendar", ObjectName: "events", Operation: common.ScopeOperationDelete,
+					Scopes: []string{
+						"https://www.googleapis.com/auth/calendar.events|https://www.googleapis.com/auth/calendar",
+					},
+				},
+			},
+		},
+		{
+			name:   "Full calendar scope permits every object",
+			module: providers.ModuleGoogleCalendar,
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://www.googleapis.com/auth/calendar"},
+			},
+			expected: nil,
+		},
+		{
+			name:   "Modify scope does not permit deleting messages permanently",
+			module: providers.ModuleGoogleGmail,
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://www.googleapis.com/auth/gmail.modify"},
+				ObjectNames:   []string{"messages", "labels"},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "gmail", ObjectName: "messages", Operation: common.ScopeOperationDelete,
+					Scopes: []string{"https://mail.google.com/"},
+				},
+			},
+		},
+		{
+			name:   "Directory is read with its own scope",
+			module: providers.ModuleGoogleContacts,
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://www.googleapis.com/auth/contacts"},
+				Operations:    []common.ScopeOperation{common.ScopeOperationRead},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "contacts", ObjectName: "otherContacts", Operation: common.ScopeOperationRead,
+					Scopes: []string{"https://www.googleapis.com/auth/contacts.other.readonly"},
+				},
+				{
+					Module: "contacts", ObjectName: "peopleDirectory", Operation: common.ScopeOperationRead,
+					Scopes: []string{"https://www.googleapis.com/auth/directory.readonly"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, err := constructTestConnector("https://www.googleapis.com", tt.module)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := conn.CheckScopes(t.Context(), tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testutils.CheckOutput(t, tt.name, tt.expected, result.Missing)
+
+			if (len(tt.expected) == 0) != (result.Err() == nil) {
+				t.Fatalf("%s: unexpected error %v", tt.name, result.Err())
+			}
+		})
+	}
+}
@@ -91,6 +91,8 @@ func constructor(params common.ConnectorParams) func(*components.Connector) (*Co
 			},
 		)
 
+		connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 		return connector, nil
 	}
 }
@@ -0,0 +1,64 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// CheckCredentials performs the catalog's AuthHealthCheck request with the authenticated client
+// and classifies the outcome. The ProviderInfo must have catalog substitutions applied,
+// see ReadInfo and SubstituteWith.
+//
+// Failures reported by the provider are described by the result. An error is returned only
+// when the check cannot be performed, e.g. common.ErrCredentialCheckNotConfigured when
+// the provider has no health check URL.
+func (i *ProviderInfo) CheckCredentials(
+	ctx context.Context, client common.AuthenticatedHTTPClient,
+) (*common.CredentialCheckResult, error) {
+	check := i.AuthHealthCheck
+	if check == nil || check.Url == "" {
+		return nil, fmt.Errorf("%w: %s", common.ErrCredentialCheckNotConfigured, i.Name)
+	}
+
+	if strings.Contains(check.Url, "{{") {
+		return nil, fmt.Errorf("%w: %s health check URL has unresolved catalog variables",
+			common.ErrCredentialCheckNotConfigured, i.Name)
+	}
+
+	method := check.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, check.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		// The provider was not reached, which is classified as provider down for network failures.
+		return common.NewCredentialCheckResult(0, err), nil
+	}
+
+	defer res.Body.Close()
+
+	successCodes := check.SuccessStatusCodes
+	if len(successCodes) == 0 {
+		successCodes = []int{http.StatusOK, http.StatusNoContent}
+	}
+
+	if slices.Contains(successCodes, res.StatusCode) {
+		return common.NewCredentialCheckResult(res.StatusCode, nil), nil
+	}
+
+	body, _ := io.ReadAll(res.Body) // body is only used to describe the failure
+
+	return common.NewCredentialCheckResult(res.StatusCode, common.InterpretError(res, body)), nil
+}
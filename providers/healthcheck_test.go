@@ -0,0 +1,101 @@
+package providers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+func TestCheckCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   common.CredentialStatus
+	}{
+		{name: "Success", status: http.StatusOK, want: common.CredentialStatusHealthy},
+		{name: "Revoked token", status: http.StatusUnauthorized, want: common.CredentialStatusAuthInvalidated},
+		{name: "Missing scope", status: http.StatusForbidden, want: common.CredentialStatusForbidden},
+		{
+			name:   "API disabled",
+			status: http.StatusBadRequest,
+			body:   `{"error": "FEATURE_DISABLED"}`,
+			want:   common.CredentialStatusAPIDisabled,
+		},
+		{name: "Outage", status: http.StatusBadGateway, want: common.CredentialStatusProviderDown},
+		{name: "Unexpected success code", status: http.StatusAccepted, want: common.CredentialStatusUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("expected POST, got %s", r.Method)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			info := &ProviderInfo{Name: "test", AuthHealthCheck: &AuthHealthCheck{
+				Method:             http.MethodPost,
+				SuccessStatusCodes: []int{http.StatusOK},
+				Url:                server.URL,
+			}}
+
+			result, err := info.CheckCredentials(t.Context(), server.Client())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Status != tt.want {
+				t.Errorf("expected %s, got %s (%v)", tt.want, result.Status, result.Err)
+			}
+
+			if result.StatusCode != tt.status {
+				t.Errorf("expected status code %d, got %d", tt.status, result.StatusCode)
+			}
+		})
+	}
+}
+
+func TestCheckCredentialsNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	for _, info := range []*ProviderInfo{
+		{Name: "none"},
+		{Name: "unresolved", AuthHealthCheck: &AuthHealthCheck{Url: "https://{{.workspace}}.example.com/me"}},
+	} {
+		_, err := info.CheckCredentials(t.Context(), http.DefaultClient)
+		if !errors.Is(err, common.ErrCredentialCheckNotConfigured) {
+			t.Errorf("%s: expected ErrCredentialCheckNotConfigured, got %v", info.Name, err)
+		}
+	}
+}
+
+func TestCheckCredentialsProviderUnreachable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	info := &ProviderInfo{Name: "test", AuthHealthCheck: &AuthHealthCheck{Url: url}}
+
+	result, err := info.CheckCredentials(t.Context(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Status != common.CredentialStatusProviderDown {
+		t.Errorf("expected provider down, got %s (%v)", result.Status, result.Err)
+	}
+}
@@ -33,12 +33,13 @@ func init() { //nolint:funlen
 		},
 		Support: Support{
 			BulkWrite: BulkWriteSupport{
-				Insert: false,
-				Update: false,
-				Upsert: false,
+				Insert: true,
+				Update: true,
+				Upsert: true,
 				Delete: false,
 			},
 			Delete:    true,
+			Merge:     true,
 			Proxy:     true,
 			Read:      true,
 			Subscribe: true,
@@ -55,6 +56,12 @@ func init() { //nolint:funlen
 				BaseURL:     "https://api.hubapi.com/crm",
 				DisplayName: "HubSpot CRM",
 				Support: Support{
+					BulkWrite: BulkWriteSupport{
+						Insert: true,
+						Update: true,
+						Upsert: true,
+						Delete: false,
+					},
 					BatchWrite: &BatchWriteSupport{
 						Create: BatchWriteSupportConfig{
 							DefaultRecordLimit: new(100), // nolint:mnd
@@ -68,6 +75,7 @@ func init() { //nolint:funlen
 						},
 					},
 					Delete:    true,
+					Merge:     true,
 					Read:      true,
 					Subscribe: true,
 					Write:     true,
@@ -0,0 +1,30 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.BulkWriteConnector = &Connector{}
+
+// StartBulkWrite writes any number of records through the CRM Imports API.
+// Unlike BatchWrite, which sends at most 100 records per call, records are uploaded as a single CSV file.
+func (c *Connector) StartBulkWrite(
+	ctx context.Context, params *common.BulkWriteParams,
+) (*common.BulkWriteJob, error) {
+	return c.importsAdapter.StartBulkWrite(ctx, params)
+}
+
+// GetBulkWriteJob returns the state of an import started by StartBulkWrite.
+func (c *Connector) GetBulkWriteJob(ctx context.Context, jobID string) (*common.BulkWriteJob, error) {
+	return c.importsAdapter.GetBulkWriteJob(ctx, jobID)
+}
+
+// GetBulkWriteErrors returns a page of rows which an import failed to write.
+func (c *Connector) GetBulkWriteErrors(
+	ctx context.Context, jobID string, nextPage common.NextPageToken,
+) (*common.BulkWriteErrorsResult, error) {
+	return c.importsAdapter.GetBulkWriteErrors(ctx, jobID, nextPage)
+}
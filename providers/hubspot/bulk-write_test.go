@@ -0,0 +1,387 @@
+package hubspot
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/imports"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestStartBulkWrite(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	importRequest := testutils.DataFromFile(t, "bulk-write/import-request.json")
+	importFile := testutils.DataFromFile(t, "bulk-write/contacts.csv")
+	responseStarted := testutils.DataFromFile(t, "bulk-write/import-started.json")
+	responseSchemaCars := testutils.DataFromFile(t, "custom/delete/schema-cars.json")
+
+	tests := []startBulkWriteTestCase{
+		{
+			Name:         "Object name must be given",
+			Input:        &common.BulkWriteParams{Type: common.WriteTypeCreate},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name: "Records must be given",
+			Input: &common.BulkWriteParams{
+				ObjectName: "contacts",
+				Type:       common.WriteTypeCreate,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordData},
+		},
+		{
+			Name: "Upsert must match records by a field",
+			Input: &common.BulkWriteParams{
+				ObjectName: "contacts",
+				Type:       common.WriteTypeUpsert,
+				Records:    []common.Record{{"email": "ada@example.com"}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingBulkWriteIDField},
+		},
+		{
+			Name: "Records must fit a single import file",
+			Input: &common.BulkWriteParams{
+				ObjectName: "contacts",
+				Type:       common.WriteTypeCreate,
+				Records:    make([]common.Record, 1_048_576),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{imports.ErrImportFileTooLarge},
+		},
+		{
+			Name: "Records are imported as CSV file with associations",
+			Input: &common.BulkWriteParams{
+				ObjectName: "contacts",
+				Type:       common.WriteTypeUpsert,
+				IDField:    "email",
+				Records: []common.Record{{
+					"email":        "ada@example.com",
+					"firstname":    "Ada",
+					"company":      "example.com",
+					"car":          "33451",
+					"numemployees": float64(120),
+					"interests__c": []any{"art", "travel"},
+				}, {
+					"email":        "bob@example.com",
+					"firstname":    "Bob, Jr.",
+					"hs_language":  "en",
+					"numemployees": 5,
+				}, {
+					"email":       "quentin@@example.com",
+					"firstname":   "Quentin",
+					"company":     "example.com",
+					"hs_language": "en",
+				}},
+				Associations: []common.BulkWriteAssociation{{
+					Field:      "company",
+					ObjectName: "companies",
+					IDField:    "domain",
+					Label:      "279",
+				}, {
+					Field:      "car",
+					ObjectName: "cars",
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/cars"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSchemaCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/imports"),
+						multipartImport(importRequest, importFile),
+					},
+					Then: mockserver.Response(http.StatusOK, responseStarted),
+				}},
+			}.Server(),
+			Comparator: compareBulkWriteJob,
+			Expected: &common.BulkWriteJob{
+				JobID:         "45187299",
+				State:         common.BulkWriteJobStatePending,
+				ProviderState: "STARTED",
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestGetBulkWriteJob(t *testing.T) {
+	t.Parallel()
+
+	responseDone := testutils.DataFromFile(t, "bulk-write/import-done.json")
+
+	tests := []getBulkWriteJobTestCase{
+		{
+			Name:         "Job id must be given",
+			Input:        "",
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name:  "Finished import is done",
+			Input: "45187299",
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/crm/v3/imports/45187299"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseDone),
+			}.Server(),
+			Comparator: compareBulkWriteJob,
+			Expected: &common.BulkWriteJob{
+				JobID:            "45187299",
+				State:            common.BulkWriteJobStateDone,
+				ProviderState:    "DONE",
+				RecordsProcessed: 3,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestGetBulkWriteErrors(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseFirstPage := testutils.DataFromFile(t, "bulk-write/errors-first-page.json")
+	responseLastPage := testutils.DataFromFile(t, "bulk-write/errors-last-page.json")
+
+	tests := []getBulkWriteErrorsTestCase{
+		{
+			Name: "First page of errors points to the next one",
+			Input: bulkWriteErrorsInput{
+				JobID: "45187299",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/crm/v3/imports/45187299/errors"),
+					mockcond.QueryParam("limit", "100"),
+					mockcond.QueryParam("includeErrorMessage", "true"),
+					mockcond.QueryParam("includeRowData", "true"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseFirstPage),
+			}.Server(),
+			Comparator: compareBulkWriteErrors,
+			Expected: &common.BulkWriteErrorsResult{
+				Errors: []common.BulkWriteRecordError{{
+					RecordIndex: 2,
+					Code:        "INVALID_EMAIL",
+					Message:     "Email address quentin@@example.com is invalid",
+					Value:       "quentin@@example.com",
+				}},
+				NextPage: "2215871",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Last page of errors with unknown line",
+			Input: bulkWriteErrorsInput{
+				JobID:    "45187299",
+				NextPage: "2215871",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/crm/v3/imports/45187299/errors"),
+					mockcond.QueryParam("after", "2215871"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseLastPage),
+			}.Server(),
+			Comparator: compareBulkWriteErrors,
+			Expected: &common.BulkWriteErrorsResult{
+				Errors: []common.BulkWriteRecordError{{
+					RecordIndex: -1,
+					Code:        "INCORRECT_NUMBER_OF_COLUMNS",
+					Message:     "Expected 7 columns, found 5",
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+// multipartImport matches the import request and the CSV file of a multipart upload.
+func multipartImport(expectedRequest, expectedFile []byte) mockcond.CustomCondition {
+	return func(_ http.ResponseWriter, r *http.Request) bool {
+		if err := r.ParseMultipartForm(1 << 20); err != nil { // nolint:mnd
+			return false
+		}
+
+		var actual, expected any
+		if json.Unmarshal([]byte(r.FormValue("importRequest")), &actual) != nil ||
+			json.Unmarshal(expectedRequest, &expected) != nil ||
+			!reflect.DeepEqual(actual, expected) {
+			return false
+		}
+
+		files := r.MultipartForm.File["files"]
+		if len(files) != 1 || files[0].Filename != "contacts.csv" {
+			return false
+		}
+
+		file, err := files[0].Open()
+		if err != nil {
+			return false
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+
+		return err == nil && string(data) == string(expectedFile)
+	}
+}
+
+// compareBulkWriteJob ignores the raw response, which only has to be present.
+func compareBulkWriteJob(_ string, actual, expected *common.BulkWriteJob) *testutils.CompareResult {
+	result := testutils.NewCompareResult()
+
+	if actual == nil || expected == nil {
+		result.Assert("job", expected, actual)
+
+		return result
+	}
+
+	if len(actual.Raw) == 0 {
+		result.AddDiff("raw job is missing")
+	}
+
+	job := *actual
+	job.Raw = nil
+	result.Assert("job", *expected, job)
+
+	return result
+}
+
+// compareBulkWriteErrors ignores the raw errors, which only have to be present.
+func compareBulkWriteErrors(_ string, actual, expected *common.BulkWriteErrorsResult) *testutils.CompareResult {
+	result := testutils.NewCompareResult()
+
+	if actual == nil || expected == nil {
+		result.Assert("errors", expected, actual)
+
+		return result
+	}
+
+	page := *actual
+	page.Errors = make([]common.BulkWriteRecordError, len(actual.Errors))
+
+	for index, recordError := range actual.Errors {
+		if len(recordError.Raw) == 0 {
+			result.AddDiff("raw error %v is missing", index)
+		}
+
+		recordError.Raw = nil
+		page.Errors[index] = recordError
+	}
+
+	result.Assert("errors", *expected, page)
+
+	return result
+}
+
+type (
+	testCaseTypeStartBulkWrite = testconn.TestCase[*common.BulkWriteParams, *common.BulkWriteJob]
+	startBulkWriteTestCase     testCaseTypeStartBulkWrite
+)
+
+func (c startBulkWriteTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeStartBulkWrite(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.StartBulkWrite(t.Context(), c.Input)
+	testCaseTypeStartBulkWrite(c).Validate(t, err, output)
+}
+
+type (
+	testCaseTypeGetBulkWriteJob = testconn.TestCase[string, *common.BulkWriteJob]
+	getBulkWriteJobTestCase     testCaseTypeGetBulkWriteJob
+)
+
+func (c getBulkWriteJobTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeGetBulkWriteJob(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.GetBulkWriteJob(t.Context(), c.Input)
+	testCaseTypeGetBulkWriteJob(c).Validate(t, err, output)
+}
+
+type bulkWriteErrorsInput struct {
+	JobID    string
+	NextPage common.NextPageToken
+}
+
+type (
+	testCaseTypeGetBulkWriteErrors = testconn.TestCase[bulkWriteErrorsInput, *common.BulkWriteErrorsResult]
+	getBulkWriteErrorsTestCase     testCaseTypeGetBulkWriteErrors
+)
+
+func (c getBulkWriteErrorsTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeGetBulkWriteErrors(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.GetBulkWriteErrors(t.Context(), c.Input.JobID, c.Input.NextPage)
+	testCaseTypeGetBulkWriteErrors(c).Validate(t, err, output)
+}
@@ -6,11 +6,13 @@ import (
 	"github.com/amp-labs/connectors/internal/components"
 	"github.com/amp-labs/connectors/internal/components/deleter"
 	"github.com/amp-labs/connectors/internal/components/operations"
+	"github.com/amp-labs/connectors/internal/components/reader"
 	"github.com/amp-labs/connectors/providers"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/associations"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/batch"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/core"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/custom"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/imports"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/search"
 )
 
@@ -32,14 +34,24 @@ type Connector struct {
 	// Provides access to an authenticated client.
 	common.RequireAuthenticatedClient
 
+	// QuotaTracker records the rate limits reported with every response.
+	*common.QuotaTracker
+
 	// Operations
 	components.Deleter
 
+	// normalizingReader honors value normalization of connectors.ReadConnector.
+	normalizingReader components.Reader
+
 	// These delegate complex functionality to keep Connector modular and prevent code bloat.
-	customAdapter      *custom.Adapter  // used for connectors.UpsertMetadataConnector capabilities.
+	customAdapter      *custom.Adapter  // used for connectors.UpsertMetadataConnector and DeleteMetadataConnector.
 	batchAdapter       *batch.Adapter   // used for connectors.BatchWriteConnector capabilities.
+	importsAdapter     *imports.Adapter // used for connectors.BulkWriteConnector capabilities.
 	searchStrategy     *search.Strategy // used for connectors.SearchConnector capabilities.
 	associationsFiller associations.Filler
+
+	// registry supports every object and declares the OAuth scopes each object requires, used by CheckScopes.
+	registry *components.EndpointRegistry
 }
 
 var _ connectors.WebhookVerifierConnector = &Connector{}
@@ -52,16 +64,27 @@ func NewConnector(params common.ConnectorParams) (*Connector, error) {
 
 func constructor(base *components.Connector) (*Connector, error) {
 	connector := &Connector{
-		Connector: base,
+		Connector:    base,
+		QuotaTracker: common.NewQuotaTracker(extractQuota),
 	}
 
+	// Wrapped before any adapter captures the client.
+	connector.HTTPClient().Client = connector.QuotaTracker.Client(connector.HTTPClient().Client)
+
 	// Note: error handler must return common.HTTPError.
 	// Check method in the internal package "custom", method "readGroupName" which relies on error casting.
 	connector.SetErrorHandler(core.InterpretJSONError)
 
+	registry, err := components.NewEmptyEndpointRegistryWithScopes(requiredScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	connector.registry = registry
+
 	connector.Deleter = deleter.NewHTTPDeleter(
 		connector.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		connector.registry,
 		connector.ProviderContext.Module(),
 		operations.DeleteHandlers{
 			BuildRequest:  connector.buildDeleteRequest,
@@ -70,10 +93,15 @@ func constructor(base *components.Connector) (*Connector, error) {
 		},
 	)
 
+	connector.normalizingReader = reader.NewNormalizingReader(reader.NewDelegateReader(connector.read), connector)
+
 	connector.customAdapter = custom.NewAdapter(connector.JSONHTTPClient(), connector.ProviderInfo())
 	associationsStrategy := associations.NewStrategy(connector.JSONHTTPClient(), connector.ProviderInfo())
 	connector.associationsFiller = associationsStrategy
 	connector.batchAdapter = batch.NewAdapter(connector.HTTPClient(), connector.ProviderInfo(), associationsStrategy)
+	connector.importsAdapter = imports.NewAdapter(
+		connector.JSONHTTPClient(), connector.ProviderInfo(), knownObjectTypeIDs(),
+	)
 	connector.searchStrategy = search.NewStrategy(
 		connector.JSONHTTPClient(), connector.ProviderInfo(), connector.associationsFiller,
 	)
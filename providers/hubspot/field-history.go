@@ -0,0 +1,340 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/search"
+)
+
+var _ connectors.FieldHistoryConnector = &Connector{}
+
+// historyPageSize is the most records HubSpot returns per page when property history is requested.
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/deals/get-deals
+const historyPageSize = 50
+
+// ReadFieldHistory returns the changes of the requested properties using "propertiesWithHistory".
+// HubSpot keeps the history of every property, so each recorded value becomes a change
+// whose old value is the one recorded before it. The first value of a property has no old value.
+//
+// Records are chosen in one of three ways:
+//   - RecordIDs are read in batches,
+//   - with Since, the records modified since then are found with the Search API,
+//     in time windows which hold at most 10,000 records each,
+//   - otherwise, every record of the object is listed.
+//
+// Changes made before Since are skipped. Archived is ignored, the history is never archived.
+func (c *Connector) ReadFieldHistory(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*common.FieldHistoryResult, error) {
+	if params == nil {
+		return nil, common.ErrMissingObjects
+	}
+
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	var (
+		response *historyResponse
+		nextPage string
+		err      error
+	)
+
+	switch {
+	case len(params.RecordIDs) != 0:
+		response, nextPage, err = c.readHistoryOfRecords(ctx, params)
+	case !params.Since.IsZero():
+		response, nextPage, err = c.readHistoryOfModifiedRecords(ctx, params)
+	default:
+		response, err = c.listHistory(ctx, params)
+		if response != nil {
+			nextPage = response.Paging.Next.After
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]common.FieldChange, 0)
+
+	for _, record := range response.Results {
+		changes, err := record.fieldChanges(params.Since)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, changes...)
+	}
+
+	return &common.FieldHistoryResult{
+		Rows:     rows,
+		NextPage: common.NextPageToken(nextPage),
+		Done:     nextPage == "",
+	}, nil
+}
+
+// listHistory lists a page of all records with the history of the requested properties.
+func (c *Connector) listHistory(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*historyResponse, error) {
+	url, err := c.getCRMObjectsURL(params.ObjectName.String())
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("propertiesWithHistory", strings.Join(slices.Sorted(maps.Keys(params.Fields)), ","))
+	url.WithQueryParam("limit", strconv.Itoa(historyPageSize))
+
+	if len(params.NextPage) != 0 {
+		url.WithQueryParam("after", params.NextPage.String())
+	}
+
+	rsp, err := c.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalHistory(rsp)
+}
+
+// readHistoryOfRecords reads the next batch of the given records.
+// The page token is the position of the first record of the batch.
+func (c *Connector) readHistoryOfRecords(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*historyResponse, string, error) {
+	offset := 0
+
+	if len(params.NextPage) != 0 {
+		var err error
+
+		offset, err = strconv.Atoi(params.NextPage.String())
+		if err != nil || offset < 0 || offset >= len(params.RecordIDs) {
+			return nil, "", fmt.Errorf("%w: %q", common.ErrNextPageInvalid, params.NextPage)
+		}
+	}
+
+	end := min(offset+historyPageSize, len(params.RecordIDs))
+
+	nextPage := ""
+	if end < len(params.RecordIDs) {
+		nextPage = strconv.Itoa(end)
+	}
+
+	response, err := c.batchReadHistory(ctx, params, params.RecordIDs[offset:end])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, nextPage, nil
+}
+
+// readHistoryOfModifiedRecords finds a page of records modified since the given time, then reads their history.
+// Like searchCRMObjectsAPIByWindows, the time range is bisected until each window
+// holds at most 10,000 records, and the page token carries the windows left to read.
+func (c *Connector) readHistoryOfModifiedRecords(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*historyResponse, string, error) {
+	partition, err := search.NewPartition(params.NextPage, params.Since, time.Time{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	url, err := c.getCRMObjectsSearchURL(params.ObjectName.String())
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := SearchParams{
+		ObjectName:   params.ObjectName.String(),
+		FilterGroups: []FilterGroup{{Filters: Filters{}}},
+	}
+
+	for {
+		windowQuery := query.applyWindow(partition.Current(), partition.IsLast())
+
+		payload := map[string]any{
+			"filterGroups": windowQuery.FilterGroups,
+			"sorts":        []SortBy{BuildSort(ObjectFieldHsObjectId, SortDirectionAsc)},
+			"properties":   []string{string(ObjectFieldHsObjectId)},
+			"limit":        historyPageSize,
+		}
+
+		if partition.After != "" {
+			payload["after"] = partition.After
+		}
+
+		rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), payload)
+		if err != nil {
+			return nil, "", err
+		}
+
+		total, err := getSearchTotal(rsp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if total > searchResultsLimit && partition.Split(time.Now()) {
+			continue
+		}
+
+		found, err := unmarshalHistory(rsp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		nextPage, err := partition.Next(found.Paging.Next.After)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return c.batchReadFoundHistory(ctx, params, found, nextPage.String())
+	}
+}
+
+// batchReadFoundHistory reads the history of the records found by a search.
+func (c *Connector) batchReadFoundHistory(
+	ctx context.Context, params *common.FieldHistoryParams, found *historyResponse, nextPage string,
+) (*historyResponse, string, error) {
+	if len(found.Results) == 0 {
+		return found, nextPage, nil
+	}
+
+	identifiers := make([]string, len(found.Results))
+	for index, record := range found.Results {
+		identifiers[index] = record.ID
+	}
+
+	response, err := c.batchReadHistory(ctx, params, identifiers)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, nextPage, nil
+}
+
+// batchReadHistory reads the history of the requested properties for the given records.
+// Records which do not exist are left out.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/deals/batch/get-deals
+func (c *Connector) batchReadHistory(
+	ctx context.Context, params *common.FieldHistoryParams, identifiers []string,
+) (*historyResponse, error) {
+	url, err := c.getCRMObjectsBatchReadURL(params.ObjectName.String())
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]map[string]string, len(identifiers))
+	for index, identifier := range identifiers {
+		inputs[index] = map[string]string{"id": identifier}
+	}
+
+	rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), map[string]any{
+		"inputs":                inputs,
+		"properties":            []string{string(ObjectFieldHsObjectId)},
+		"propertiesWithHistory": slices.Sorted(maps.Keys(params.Fields)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalHistory(rsp)
+}
+
+// unmarshalHistory parses the records with their history, an empty response is an error.
+func unmarshalHistory(rsp *common.JSONHTTPResponse) (*historyResponse, error) {
+	response, err := common.UnmarshalJSON[historyResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return response, nil
+}
+
+type historyResponse struct {
+	Results []historyRecord `json:"results"`
+	Paging  struct {
+		Next struct {
+			After string `json:"after"`
+		} `json:"next"`
+	} `json:"paging"`
+}
+
+type historyRecord struct {
+	ID string `json:"id"`
+	// PropertiesWithHistory lists every value a property had, newest first.
+	PropertiesWithHistory map[string][]map[string]any `json:"propertiesWithHistory"`
+}
+
+// fieldChanges converts the values of each property into changes, oldest first.
+// An empty value means the property was cleared.
+func (r historyRecord) fieldChanges(since time.Time) ([]common.FieldChange, error) {
+	changes := make([]common.FieldChange, 0)
+
+	for _, field := range slices.Sorted(maps.Keys(r.PropertiesWithHistory)) {
+		entries := r.PropertiesWithHistory[field]
+
+		var oldValue any
+
+		for index := len(entries) - 1; index >= 0; index-- {
+			entry := entries[index]
+
+			changedAt, err := time.Parse(time.RFC3339Nano, fmt.Sprint(entry["timestamp"]))
+			if err != nil {
+				return nil, fmt.Errorf("history of property %q of record %s: %w", field, r.ID, err)
+			}
+
+			var newValue any
+			if value, ok := entry["value"].(string); ok && value != "" {
+				newValue = value
+			}
+
+			if !changedAt.Before(since) {
+				changes = append(changes, common.FieldChange{
+					RecordID:  r.ID,
+					Field:     field,
+					OldValue:  oldValue,
+					NewValue:  newValue,
+					ChangedAt: changedAt,
+					ChangedBy: historyEntryAuthor(entry),
+					Raw:       entry,
+				})
+			}
+
+			oldValue = newValue
+		}
+	}
+
+	return changes, nil
+}
+
+// historyEntryAuthor is the id of the user who changed the value,
+// otherwise the source of the change, such as a workflow or an integration.
+func historyEntryAuthor(entry map[string]any) string {
+	if userID, ok := entry["updatedByUserId"].(float64); ok && userID != 0 {
+		return strconv.FormatFloat(userID, 'f', -1, 64)
+	}
+
+	if sourceID, ok := entry["sourceId"].(string); ok && sourceID != "" {
+		return sourceID
+	}
+
+	if sourceType, ok := entry["sourceType"].(string); ok {
+		return sourceType
+	}
+
+	return ""
+}
@@ -0,0 +1,290 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadFieldHistory(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseListDeals := testutils.DataFromFile(t, "field-history/list-deals.json")
+	responseSearchDeals := testutils.DataFromFile(t, "field-history/search-deals.json")
+	responseBatchReadDeals := testutils.DataFromFile(t, "field-history/batch-read-deals.json")
+
+	stageCreated := common.FieldChange{
+		RecordID:  "3001",
+		Field:     "dealstage",
+		OldValue:  nil,
+		NewValue:  "appointmentscheduled",
+		ChangedAt: time.Date(2026, 9, 1, 9, 30, 0, 125000000, time.UTC),
+		ChangedBy: "81",
+	}
+	stageWon := common.FieldChange{
+		RecordID:  "3001",
+		Field:     "dealstage",
+		OldValue:  "appointmentscheduled",
+		NewValue:  "closedwon",
+		ChangedAt: time.Date(2026, 10, 2, 14, 0, 0, 0, time.UTC),
+		ChangedBy: "81",
+	}
+	ownerAssigned := common.FieldChange{
+		RecordID:  "3001",
+		Field:     "hubspot_owner_id",
+		OldValue:  nil,
+		NewValue:  "81",
+		ChangedAt: time.Date(2026, 9, 1, 9, 30, 0, 125000000, time.UTC),
+		ChangedBy: "81",
+	}
+	ownerCleared := common.FieldChange{
+		RecordID:  "3001",
+		Field:     "hubspot_owner_id",
+		OldValue:  "81",
+		NewValue:  nil,
+		ChangedAt: time.Date(2026, 10, 5, 8, 15, 0, 0, time.UTC),
+		ChangedBy: "enrollmentId:5508",
+	}
+
+	tests := []readFieldHistoryTestCase{
+		{
+			Name:         "Object name must be given",
+			Input:        &common.FieldHistoryParams{Fields: connectors.Fields("dealstage")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name:         "At least one field must be given",
+			Input:        &common.FieldHistoryParams{ObjectName: "deals"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingFields},
+		},
+		{
+			Name: "History of all records is listed oldest change first",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "deals",
+				Fields:     connectors.Fields("hubspot_owner_id", "dealstage"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/crm/v3/objects/deals"),
+					mockcond.QueryParam("propertiesWithHistory", "dealstage,hubspot_owner_id"),
+					mockcond.QueryParam("limit", "50"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseListDeals),
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows:     []common.FieldChange{stageCreated, stageWon, ownerAssigned, ownerCleared},
+				NextPage: "3002",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "History since a time is read for modified records",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "deals",
+				Fields:     connectors.Fields("hubspot_owner_id", "dealstage"),
+				Since:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/deals/search"),
+						mockcond.Body(`{
+							"filterGroups":[{"filters":[{
+								"propertyName":"hs_lastmodifieddate","operator":"GTE","value":"2026-10-01T00:00:00Z"
+							}]}],
+							"sorts":[{"propertyName":"hs_object_id","direction":"ASCENDING"}],
+							"properties":["hs_object_id"],
+							"limit":50
+						}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSearchDeals),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/deals/batch/read"),
+						mockcond.Body(`{
+							"inputs":[{"id":"3001"}],
+							"properties":["hs_object_id"],
+							"propertiesWithHistory":["dealstage","hubspot_owner_id"]
+						}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseBatchReadDeals),
+				}},
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows:     []common.FieldChange{stageWon, ownerCleared},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Search window with too many records is split before reading",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "deals",
+				Fields:     connectors.Fields("hubspot_owner_id", "dealstage"),
+				Since:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				NextPage: `{"windows":[{"since":"2026-10-01T00:00:00Z","until":"2026-10-10T00:00:00Z"},` +
+					`{"since":"2026-10-10T00:00:00Z"}]}`,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/deals/search"),
+						mockcond.Body(`{
+							"filterGroups":[{"filters":[
+								{"propertyName":"hs_lastmodifieddate","operator":"GTE","value":"2026-10-01T00:00:00Z"},
+								{"propertyName":"hs_lastmodifieddate","operator":"LT","value":"2026-10-10T00:00:00Z"}
+							]}],
+							"sorts":[{"propertyName":"hs_object_id","direction":"ASCENDING"}],
+							"properties":["hs_object_id"],
+							"limit":50
+						}`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK,
+						`{"total":10001,"results":[],"paging":{"next":{"after":"50"}}}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/deals/search"),
+						mockcond.Body(`{
+							"filterGroups":[{"filters":[
+								{"propertyName":"hs_lastmodifieddate","operator":"GTE","value":"2026-10-01T00:00:00Z"},
+								{"propertyName":"hs_lastmodifieddate","operator":"LT","value":"2026-10-05T12:00:00Z"}
+							]}],
+							"sorts":[{"propertyName":"hs_object_id","direction":"ASCENDING"}],
+							"properties":["hs_object_id"],
+							"limit":50
+						}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSearchDeals),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/deals/batch/read"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseBatchReadDeals),
+				}},
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows: []common.FieldChange{stageWon, ownerCleared},
+				NextPage: `{"windows":[{"since":"2026-10-05T12:00:00Z","until":"2026-10-10T00:00:00Z"},` +
+					`{"since":"2026-10-10T00:00:00Z"}]}`,
+				Done: false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "History of given records is read in batches",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "deals",
+				Fields:     connectors.Fields("dealstage", "hubspot_owner_id"),
+				RecordIDs:  []string{"3001"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/deals/batch/read"),
+					mockcond.Body(`{
+						"inputs":[{"id":"3001"}],
+						"properties":["hs_object_id"],
+						"propertiesWithHistory":["dealstage","hubspot_owner_id"]
+					}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseBatchReadDeals),
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows:     []common.FieldChange{stageCreated, stageWon, ownerAssigned, ownerCleared},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Page token of given records must be a position",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "deals",
+				Fields:     connectors.Fields("dealstage"),
+				RecordIDs:  []string{"3001"},
+				NextPage:   "3002",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrNextPageInvalid},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+// compareFieldHistory ignores the raw history entries, which only have to be present.
+func compareFieldHistory(_ string, actual, expected *common.FieldHistoryResult) *testutils.CompareResult {
+	result := testutils.NewCompareResult()
+
+	if actual == nil || expected == nil {
+		result.Assert("history", expected, actual)
+
+		return result
+	}
+
+	page := *actual
+	page.Rows = make([]common.FieldChange, len(actual.Rows))
+
+	for index, row := range actual.Rows {
+		if len(row.Raw) == 0 {
+			result.AddDiff("raw change %v is missing", index)
+		}
+
+		row.Raw = nil
+		page.Rows[index] = row
+	}
+
+	result.Assert("history", *expected, page)
+
+	return result
+}
+
+type (
+	testCaseTypeReadFieldHistory = testconn.TestCase[*common.FieldHistoryParams, *common.FieldHistoryResult]
+	readFieldHistoryTestCase     testCaseTypeReadFieldHistory
+)
+
+func (c readFieldHistoryTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeReadFieldHistory(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.ReadFieldHistory(t.Context(), c.Input)
+	testCaseTypeReadFieldHistory(c).Validate(t, err, output)
+}
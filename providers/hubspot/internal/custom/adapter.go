@@ -42,3 +42,38 @@ func (a *Adapter) getPropertyGroupNameURL(objectName, groupName string) (*urlbui
 func (a *Adapter) getPropertyGroupNameCreationURL(objectName string) (*urlbuilder.URL, error) {
 	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "properties", objectName, "groups")
 }
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/properties/get-property
+// https://developers.hubspot.com/docs/api-reference/latest/crm/properties/archive-property
+// Note: Version APIVersion2026March is NOT FOUND at the moment for this endpoint. Using older V3.
+func (a *Adapter) getPropertyURL(objectName, propertyName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "properties", objectName, propertyName)
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/properties/get-properties
+// Note: Version APIVersion2026March is NOT FOUND at the moment for this endpoint. Using older V3.
+func (a *Adapter) getPropertiesURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "properties", objectName)
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/schemas/get-schema
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/schemas/delete-schema
+func (a *Adapter) getSchemaURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm-object-schemas", core.APIVersion2026March, "schemas", objectName)
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/contacts/get-contacts
+// NOTE: the path layout still follows the older v3 structure.
+func (a *Adapter) getObjectsURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "objects", objectName)
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/contacts/search-contacts
+func (a *Adapter) getObjectsSearchURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "objects", objectName, "search")
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/contacts/batch/archive-contacts
+func (a *Adapter) getObjectsBatchArchiveURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "objects", objectName, "batch", "archive")
+}
@@ -0,0 +1,510 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// maxArchiveBatchSize is the number of records HubSpot archives in a single batch call.
+const maxArchiveBatchSize = 100
+
+// ErrRecordsNotArchived is returned when records of an object are still listed after archiving them.
+var ErrRecordsNotArchived = errors.New("records are still listed after being archived")
+
+// DeleteMetadata archives custom properties and property groups, and deletes custom object schemas.
+//
+// Properties are deleted first, then property groups, then objects,
+// so that a group emptied by the same call is no longer held by its properties.
+// Anything still in use is refused and reported with its dependencies, unless params.Force is set:
+//   - a property is in use while records have a value for it,
+//   - a property group is in use while it holds properties,
+//   - a custom object is in use while it has records.
+//
+// HubSpot deletes an object schema only after all of its records are removed,
+// therefore a forced object deletion archives the records first.
+// Properties defined by HubSpot and standard objects are always refused.
+// Items which do not exist are reported with no action.
+// On failure, the items processed before the error are returned along with it.
+//
+// See: https://developers.hubspot.com/docs/api-reference/latest/crm/objects/schemas/guide
+func (a *Adapter) DeleteMetadata(
+	ctx context.Context, params *common.DeleteMetadataParams,
+) (*common.DeleteMetadataResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	result := &common.DeleteMetadataResult{}
+
+	var err error
+
+	result.Fields, err = a.deleteProperties(ctx, params.Fields, params.Force)
+	if err != nil {
+		return result, err
+	}
+
+	result.FieldGroups, err = a.deletePropertyGroups(ctx, params.FieldGroups, params.Force)
+	if err != nil {
+		return result, err
+	}
+
+	result.Objects, err = a.deleteObjects(ctx, params.Objects, params.Force)
+	if err != nil {
+		return result, err
+	}
+
+	result.Success = !isAnyRefused(result)
+
+	return result, nil
+}
+
+func (a *Adapter) deleteProperties(
+	ctx context.Context, properties map[common.ObjectName][]string, force bool,
+) (map[string]map[string]common.MetadataDeleteResult, error) {
+	if len(properties) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	results := make(map[string]map[string]common.MetadataDeleteResult)
+
+	for objectName, propertyNames := range properties {
+		fields := make(map[string]common.MetadataDeleteResult)
+		results[string(objectName)] = fields
+
+		for _, propertyName := range propertyNames {
+			field, err := a.deleteProperty(ctx, string(objectName), propertyName, force)
+			if err != nil {
+				return results, err
+			}
+
+			fields[propertyName] = *field
+		}
+	}
+
+	return results, nil
+}
+
+// deleteProperty archives a custom property, which holds data while any record has a value for it.
+func (a *Adapter) deleteProperty(
+	ctx context.Context, objectName, propertyName string, force bool,
+) (*common.MetadataDeleteResult, error) {
+	result := &common.MetadataDeleteResult{Name: propertyName}
+
+	url, err := a.getPropertyURL(objectName, propertyName)
+	if err != nil {
+		return nil, err
+	}
+
+	property, err := getOptional[PropertyModel](ctx, a.Client, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if property == nil {
+		result.Action = common.DeleteMetadataActionNone
+
+		return result, nil
+	}
+
+	if !property.isArchivable() {
+		result.Action = common.DeleteMetadataActionRefused
+		result.Warnings = []string{"property is defined by HubSpot and cannot be archived"}
+
+		return result, nil
+	}
+
+	count, err := a.countRecords(ctx, objectName, &searchFilter{
+		PropertyName: propertyName,
+		Operator:     "HAS_PROPERTY",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if count != 0 {
+		result.Dependencies = []string{fmt.Sprintf("%d records have a value", count)}
+	}
+
+	return a.deleteDependent(ctx, url, result, force)
+}
+
+func (a *Adapter) deletePropertyGroups(
+	ctx context.Context, groups map[common.ObjectName][]string, force bool,
+) (map[string]map[string]common.MetadataDeleteResult, error) {
+	if len(groups) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	results := make(map[string]map[string]common.MetadataDeleteResult)
+
+	for objectName, groupNames := range groups {
+		fieldGroups := make(map[string]common.MetadataDeleteResult)
+		results[string(objectName)] = fieldGroups
+
+		for _, groupName := range groupNames {
+			group, err := a.deletePropertyGroup(ctx, string(objectName), groupName, force)
+			if err != nil {
+				return results, err
+			}
+
+			fieldGroups[groupName] = *group
+		}
+	}
+
+	return results, nil
+}
+
+// deletePropertyGroup archives a property group, which is in use while it holds any property.
+func (a *Adapter) deletePropertyGroup(
+	ctx context.Context, objectName, groupName string, force bool,
+) (*common.MetadataDeleteResult, error) {
+	result := &common.MetadataDeleteResult{Name: groupName}
+
+	url, err := a.getPropertyGroupNameURL(objectName, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := getOptional[GroupNameModel](ctx, a.Client, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if group == nil {
+		result.Action = common.DeleteMetadataActionNone
+
+		return result, nil
+	}
+
+	properties, err := a.fetchProperties(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Archived properties are not listed, including those archived earlier by this call.
+	for _, property := range properties {
+		if property.GroupName == groupName {
+			result.Dependencies = append(result.Dependencies, "property "+property.Name)
+		}
+	}
+
+	return a.deleteDependent(ctx, url, result, force)
+}
+
+func (a *Adapter) deleteObjects(
+	ctx context.Context, objectNames []common.ObjectName, force bool,
+) (map[string]common.MetadataDeleteResult, error) {
+	if len(objectNames) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	results := make(map[string]common.MetadataDeleteResult)
+
+	for _, objectName := range objectNames {
+		object, err := a.deleteObject(ctx, string(objectName), force)
+		if err != nil {
+			return results, err
+		}
+
+		results[string(objectName)] = *object
+	}
+
+	return results, nil
+}
+
+// deleteObject deletes the schema of a custom object once no records are left.
+func (a *Adapter) deleteObject(
+	ctx context.Context, objectName string, force bool,
+) (*common.MetadataDeleteResult, error) {
+	result := &common.MetadataDeleteResult{Name: objectName}
+
+	url, err := a.getSchemaURL(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := getOptional[SchemaModel](ctx, a.Client, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema == nil {
+		result.Action = common.DeleteMetadataActionNone
+
+		return result, nil
+	}
+
+	if !schema.isCustom() {
+		result.Action = common.DeleteMetadataActionRefused
+		result.Warnings = []string{"only custom objects can be deleted"}
+
+		return result, nil
+	}
+
+	count, err := a.countRecords(ctx, schema.ObjectTypeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return a.deleteDependent(ctx, url, result, force)
+	}
+
+	result.Dependencies = []string{fmt.Sprintf("%d records", count)}
+	if !force {
+		result.Action = common.DeleteMetadataActionRefused
+
+		return result, nil
+	}
+
+	if err = a.archiveRecords(ctx, schema.ObjectTypeID, count); err != nil {
+		return nil, err
+	}
+
+	return a.deleteDependent(ctx, url, result, force)
+}
+
+// deleteDependent removes the resource unless something depends on it and the deletion is not forced.
+func (a *Adapter) deleteDependent(
+	ctx context.Context, url *urlbuilder.URL, result *common.MetadataDeleteResult, force bool,
+) (*common.MetadataDeleteResult, error) {
+	if len(result.Dependencies) != 0 && !force {
+		result.Action = common.DeleteMetadataActionRefused
+
+		return result, nil
+	}
+
+	if _, err := a.Client.Delete(ctx, url.String()); err != nil {
+		return nil, err
+	}
+
+	result.Action = common.DeleteMetadataActionDelete
+
+	return result, nil
+}
+
+func (a *Adapter) fetchProperties(ctx context.Context, objectName string) ([]PropertyModel, error) {
+	url, err := a.getPropertiesURL(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := common.UnmarshalJSON[PropertiesResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return properties.Results, nil
+}
+
+// countRecords returns the number of records matching the filter, all records are counted when it is nil.
+func (a *Adapter) countRecords(ctx context.Context, objectName string, filter *searchFilter) (int64, error) {
+	url, err := a.getObjectsSearchURL(objectName)
+	if err != nil {
+		return 0, err
+	}
+
+	payload := &searchPayload{Limit: 1}
+	if filter != nil {
+		payload.FilterGroups = []searchFilterGroup{{Filters: []searchFilter{*filter}}}
+	}
+
+	response, err := a.Client.Post(ctx, url.String(), payload)
+	if err != nil {
+		return 0, err
+	}
+
+	search, err := common.UnmarshalJSON[searchResponse](response)
+	if err != nil {
+		return 0, err
+	}
+
+	if search == nil {
+		return 0, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return search.Total, nil
+}
+
+// archiveRecords archives every record of an object, one page at a time, until none is listed.
+// Records listed again once archived, or more records than counted, fail the deletion rather than looping forever.
+func (a *Adapter) archiveRecords(ctx context.Context, objectName string, count int64) error {
+	listURL, err := a.getObjectsURL(objectName)
+	if err != nil {
+		return err
+	}
+
+	listURL.WithQueryParam("limit", strconv.Itoa(maxArchiveBatchSize))
+
+	archiveURL, err := a.getObjectsBatchArchiveURL(objectName)
+	if err != nil {
+		return err
+	}
+
+	// Every page but the last one is full, the extra page is the empty listing which ends the loop.
+	maxPages := count/maxArchiveBatchSize + 2
+	archived := datautils.NewSet[string]()
+
+	for range maxPages {
+		response, err := a.Client.Get(ctx, listURL.String())
+		if err != nil {
+			return err
+		}
+
+		records, err := common.UnmarshalJSON[recordsResponse](response)
+		if err != nil {
+			return err
+		}
+
+		if records == nil || len(records.Results) == 0 {
+			return nil
+		}
+
+		for _, record := range records.Results {
+			if archived.Has(record.ID) {
+				return fmt.Errorf("%w: record %v of %v", ErrRecordsNotArchived, record.ID, objectName)
+			}
+
+			archived.AddOne(record.ID)
+		}
+
+		payload := &archivePayload{Inputs: records.Results}
+		if _, err = a.Client.Post(ctx, archiveURL.String(), payload); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w: more than %d records of %v", ErrRecordsNotArchived, count, objectName)
+}
+
+// getOptional fetches a resource, returning nil when it does not exist.
+func getOptional[T any](ctx context.Context, client *common.JSONHTTPClient, url *urlbuilder.URL) (*T, error) {
+	response, err := client.Get(ctx, url.String())
+	if err != nil {
+		var httpError *common.HTTPError
+		if errors.As(err, &httpError) && httpError.Status == http.StatusNotFound {
+			return nil, nil // nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	object, err := common.UnmarshalJSON[T](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if object == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return object, nil
+}
+
+func isAnyRefused(result *common.DeleteMetadataResult) bool {
+	for _, fields := range result.Fields {
+		for _, field := range fields {
+			if field.Action == common.DeleteMetadataActionRefused {
+				return true
+			}
+		}
+	}
+
+	for _, groups := range result.FieldGroups {
+		for _, group := range groups {
+			if group.Action == common.DeleteMetadataActionRefused {
+				return true
+			}
+		}
+	}
+
+	for _, object := range result.Objects {
+		if object.Action == common.DeleteMetadataActionRefused {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PropertyModel represents a HubSpot property as returned by the Properties API.
+type PropertyModel struct {
+	Name                 string                `json:"name"`
+	GroupName            string                `json:"groupName"`
+	HubspotDefined       bool                  `json:"hubspotDefined"`
+	ModificationMetadata *ModificationMetadata `json:"modificationMetadata,omitempty"`
+}
+
+// ModificationMetadata describes which changes HubSpot permits for a property.
+type ModificationMetadata struct {
+	Archivable bool `json:"archivable"`
+}
+
+func (p PropertyModel) isArchivable() bool {
+	if p.HubspotDefined {
+		return false
+	}
+
+	return p.ModificationMetadata == nil || p.ModificationMetadata.Archivable
+}
+
+type PropertiesResponse struct {
+	Results []PropertyModel `json:"results"`
+}
+
+// SchemaModel represents the definition of a HubSpot object.
+type SchemaModel struct {
+	ObjectTypeID string `json:"objectTypeId"`
+}
+
+// isCustom reports whether the object was defined by a portal, such objects have ids like "2-123456".
+func (s SchemaModel) isCustom() bool {
+	return strings.HasPrefix(s.ObjectTypeID, "2-")
+}
+
+type searchPayload struct {
+	FilterGroups []searchFilterGroup `json:"filterGroups,omitempty"`
+	Limit        int                 `json:"limit"`
+}
+
+type searchFilterGroup struct {
+	Filters []searchFilter `json:"filters"`
+}
+
+type searchFilter struct {
+	PropertyName string `json:"propertyName"`
+	Operator     string `json:"operator"`
+}
+
+type searchResponse struct {
+	Total int64 `json:"total"`
+}
+
+type recordIdentifier struct {
+	ID string `json:"id"`
+}
+
+type recordsResponse struct {
+	Results []recordIdentifier `json:"results"`
+}
+
+type archivePayload struct {
+	Inputs []recordIdentifier `json:"inputs"`
+}
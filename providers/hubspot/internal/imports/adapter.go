@@ -0,0 +1,55 @@
+package imports
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/core"
+)
+
+// Adapter writes records in bulk using HubSpot's CRM Imports API.
+// Records are uploaded as a CSV file, which HubSpot processes asynchronously,
+// therefore the number of records is not limited by the size of a batch.
+//
+// https://developers.hubspot.com/docs/guides/api/crm/imports
+type Adapter struct {
+	Client       *common.JSONHTTPClient
+	providerInfo *providers.ProviderInfo
+
+	// objectTypeIDs maps object names to HubSpot object type ids, e.g. "contacts" to "0-1".
+	// Imports refer to objects only by type id.
+	objectTypeIDs map[string]string
+}
+
+// NewAdapter creates a new imports Adapter configured to work with HubSpot's APIs.
+// Object names missing from objectTypeIDs are resolved via their schema.
+func NewAdapter(
+	client *common.JSONHTTPClient, providerInfo *providers.ProviderInfo, objectTypeIDs map[string]string,
+) *Adapter {
+	return &Adapter{
+		Client:        client,
+		providerInfo:  providerInfo,
+		objectTypeIDs: objectTypeIDs,
+	}
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/start-import
+// Note: Version APIVersion2026March is NOT FOUND at the moment for this endpoint. Using older V3.
+func (a *Adapter) getImportsURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "imports")
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/get-import
+func (a *Adapter) getImportURL(importID string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "imports", importID)
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/get-import-errors
+func (a *Adapter) getImportErrorsURL(importID string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm", core.APIVersion3, "imports", importID, "errors")
+}
+
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/schemas/get-schema
+func (a *Adapter) getSchemaURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.providerInfo.BaseURL, "crm-object-schemas", core.APIVersion2026March, "schemas", objectName)
+}
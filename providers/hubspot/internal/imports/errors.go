@@ -0,0 +1,118 @@
+package imports
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+const errorsPageSize = 100
+
+// GetBulkWriteErrors returns a page of rows the import failed to write, each with the reason.
+// Rows are matched to the written records by their line number in the file.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/get-import-errors
+func (a *Adapter) GetBulkWriteErrors(
+	ctx context.Context, jobID string, nextPage common.NextPageToken,
+) (*common.BulkWriteErrorsResult, error) {
+	if jobID == "" {
+		return nil, common.ErrMissingRecordID
+	}
+
+	url, err := a.getImportErrorsURL(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("limit", strconv.Itoa(errorsPageSize))
+	url.WithQueryParam("includeErrorMessage", "true")
+	url.WithQueryParam("includeRowData", "true")
+
+	if nextPage != "" {
+		url.WithQueryParam("after", nextPage.String())
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	errorsPage, err := common.UnmarshalJSON[errorsResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := common.UnmarshalJSON[rawErrorsResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if errorsPage == nil || raw == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	result := &common.BulkWriteErrorsResult{
+		Errors: make([]common.BulkWriteRecordError, len(errorsPage.Results)),
+	}
+
+	for index, importErr := range errorsPage.Results {
+		result.Errors[index] = importErr.toRecordError(raw.Results[index])
+	}
+
+	if errorsPage.Paging != nil && errorsPage.Paging.Next != nil {
+		result.NextPage = common.NextPageToken(errorsPage.Paging.Next.After)
+	}
+
+	result.Done = result.NextPage == ""
+
+	return result, nil
+}
+
+type errorsResponse struct {
+	Results []importError `json:"results"`
+	Paging  *paging       `json:"paging,omitempty"`
+}
+
+type rawErrorsResponse struct {
+	Results []map[string]any `json:"results"`
+}
+
+type paging struct {
+	Next *struct {
+		After string `json:"after"`
+	} `json:"next,omitempty"`
+}
+
+type importError struct {
+	ErrorType    string     `json:"errorType"`
+	ErrorMessage string     `json:"errorMessage"`
+	ExtraContext string     `json:"extraContext"`
+	InvalidValue any        `json:"invalidValue"`
+	SourceData   sourceData `json:"sourceData"`
+}
+
+type sourceData struct {
+	// LineNumber counts the lines of the file from 1, where the first line is the header.
+	LineNumber int `json:"lineNumber"`
+}
+
+func (e importError) toRecordError(raw map[string]any) common.BulkWriteRecordError {
+	recordIndex := -1
+	if e.SourceData.LineNumber > 1 {
+		recordIndex = e.SourceData.LineNumber - 2 // nolint:mnd
+	}
+
+	message := e.ErrorMessage
+	if message == "" {
+		message = e.ExtraContext
+	}
+
+	return common.BulkWriteRecordError{
+		RecordIndex: recordIndex,
+		Code:        e.ErrorType,
+		Message:     message,
+		Value:       e.InvalidValue,
+		Raw:         raw,
+	}
+}
@@ -0,0 +1,135 @@
+package imports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// multipleValuesSeparator joins the values of multi-select properties within a CSV cell.
+const multipleValuesSeparator = ";"
+
+// Limits of a single import file.
+// https://knowledge.hubspot.com/import-and-export/set-up-your-import-file#file-requirements
+const (
+	maxImportFileRows = 1_048_576
+	maxImportFileSize = 512 << 20 // 512 MB
+)
+
+// ErrImportFileTooLarge is returned when records exceed what a single import file may hold.
+// Such records must be split across several bulk writes.
+var ErrImportFileTooLarge = errors.New("records exceed the import file limits")
+
+// newImportFile converts records into CSV, one column per field, sorted by name.
+// A field missing from a record is left blank, which HubSpot skips rather than clearing the value.
+// Records which don't fit the row or size limit of an import file are rejected.
+func newImportFile(records []common.Record) ([]string, []byte, error) {
+	// The header takes a row of its own.
+	if len(records)+1 > maxImportFileRows {
+		return nil, nil, fmt.Errorf("%w: %d records, at most %d rows are accepted",
+			ErrImportFileTooLarge, len(records), maxImportFileRows-1)
+	}
+
+	columns := make(map[string]bool)
+
+	for _, record := range records {
+		for field := range record {
+			columns[field] = true
+		}
+	}
+
+	header := slices.Sorted(maps.Keys(columns))
+
+	var buffer bytes.Buffer
+
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(header); err != nil {
+		return nil, nil, err
+	}
+
+	row := make([]string, len(header))
+
+	for _, record := range records {
+		for index, field := range header {
+			value, err := formatValue(record[field])
+			if err != nil {
+				return nil, nil, fmt.Errorf("field %q: %w", field, err)
+			}
+
+			row[index] = value
+		}
+
+		if err := writer.Write(row); err != nil {
+			return nil, nil, err
+		}
+
+		// Buffered rows are not counted yet, the check after flushing catches them.
+		if buffer.Len() > maxImportFileSize {
+			return nil, nil, fmt.Errorf("%w: file is larger than %d bytes", ErrImportFileTooLarge, maxImportFileSize)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	if buffer.Len() > maxImportFileSize {
+		return nil, nil, fmt.Errorf("%w: file is larger than %d bytes", ErrImportFileTooLarge, maxImportFileSize)
+	}
+
+	return header, buffer.Bytes(), nil
+}
+
+// formatValue renders a field value the way HubSpot imports expect it.
+// Dates are written as Unix timestamps in milliseconds, which HubSpot accepts regardless of the date format.
+func formatValue(value any) (string, error) {
+	switch typed := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return typed, nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return fmt.Sprint(typed), nil
+	case time.Time:
+		return strconv.FormatInt(typed.UnixMilli(), 10), nil
+	case []string:
+		return strings.Join(typed, multipleValuesSeparator), nil
+	case []any:
+		values := make([]string, len(typed))
+
+		for index, item := range typed {
+			text, err := formatValue(item)
+			if err != nil {
+				return "", err
+			}
+
+			values[index] = text
+		}
+
+		return strings.Join(values, multipleValuesSeparator), nil
+	default:
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return "", err
+		}
+
+		return string(data), nil
+	}
+}
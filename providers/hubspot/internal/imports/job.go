@@ -0,0 +1,80 @@
+package imports
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// https://developers.hubspot.com/docs/guides/api/crm/imports#get-the-status-of-imports
+var jobStates = map[string]common.BulkWriteJobState{ // nolint:gochecknoglobals
+	"STARTED":    common.BulkWriteJobStatePending,
+	"DEFERRED":   common.BulkWriteJobStatePending,
+	"PROCESSING": common.BulkWriteJobStateInProgress,
+	"DONE":       common.BulkWriteJobStateDone,
+	"FAILED":     common.BulkWriteJobStateFailed,
+	"CANCELED":   common.BulkWriteJobStateCanceled,
+	"REVERTED":   common.BulkWriteJobStateCanceled,
+}
+
+// GetBulkWriteJob returns the state of an import.
+// Rows which failed are reported once the import is done, even when its state is "DONE".
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/get-import
+func (a *Adapter) GetBulkWriteJob(ctx context.Context, jobID string) (*common.BulkWriteJob, error) {
+	if jobID == "" {
+		return nil, common.ErrMissingRecordID
+	}
+
+	url, err := a.getImportURL(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return newJob(response)
+}
+
+func newJob(response *common.JSONHTTPResponse) (*common.BulkWriteJob, error) {
+	imported, err := common.UnmarshalJSON[importResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := common.UnmarshalJSON[map[string]any](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if imported == nil || raw == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	state, ok := jobStates[imported.State]
+	if !ok {
+		// Unknown states are not final, the import is still being worked on.
+		state = common.BulkWriteJobStateInProgress
+	}
+
+	return &common.BulkWriteJob{
+		JobID:            imported.ID,
+		State:            state,
+		ProviderState:    imported.State,
+		RecordsProcessed: imported.Metadata.Counters["TOTAL_ROWS"],
+		Raw:              *raw,
+	}, nil
+}
+
+type importResponse struct {
+	ID       string         `json:"id"`
+	State    string         `json:"state"`
+	Metadata importMetadata `json:"metadata"`
+}
+
+type importMetadata struct {
+	Counters map[string]int64 `json:"counters"`
+}
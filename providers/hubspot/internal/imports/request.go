@@ -0,0 +1,185 @@
+package imports
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+const (
+	// recordIDProperty holds the HubSpot record id of every object.
+	recordIDProperty = "hs_object_id"
+
+	columnTypeObjectID    = "HUBSPOT_OBJECT_ID"
+	columnTypeAlternateID = "HUBSPOT_ALTERNATE_ID"
+
+	associationCategoryHubSpot = "HUBSPOT_DEFINED"
+)
+
+var ErrInvalidAssociationLabel = errors.New("association label must be a type id optionally prefixed by category")
+
+// https://developers.hubspot.com/docs/guides/api/crm/imports#import-operations
+var importOperations = map[common.WriteType]string{ // nolint:gochecknoglobals
+	common.WriteTypeCreate: "CREATE",
+	common.WriteTypeUpdate: "UPDATE",
+	common.WriteTypeUpsert: "UPSERT",
+}
+
+// importRequest is the JSON part of the multipart request starting an import.
+// https://developers.hubspot.com/docs/guides/api/crm/imports#format-the-importrequest-data
+type importRequest struct {
+	Name             string            `json:"name"`
+	ImportOperations map[string]string `json:"importOperations"`
+	DateFormat       string            `json:"dateFormat"`
+	Files            []importFile      `json:"files"`
+}
+
+type importFile struct {
+	FileName       string         `json:"fileName"`
+	FileFormat     string         `json:"fileFormat"`
+	FileImportPage fileImportPage `json:"fileImportPage"`
+}
+
+type fileImportPage struct {
+	HasHeader      bool            `json:"hasHeader"`
+	ColumnMappings []columnMapping `json:"columnMappings"`
+}
+
+// columnMapping tells which property a CSV column is written to.
+// An association column belongs to the associated object and points back to the imported object.
+type columnMapping struct {
+	ColumnObjectTypeID   string          `json:"columnObjectTypeId"`
+	ColumnName           string          `json:"columnName"`
+	PropertyName         string          `json:"propertyName"`
+	ColumnType           string          `json:"columnType,omitempty"`
+	ToColumnObjectTypeID string          `json:"toColumnObjectTypeId,omitempty"`
+	ForeignKeyType       *foreignKeyType `json:"foreignKeyType,omitempty"`
+}
+
+type foreignKeyType struct {
+	AssociationTypeID   int    `json:"associationTypeId"`
+	AssociationCategory string `json:"associationCategory"`
+}
+
+// newImportRequest describes a single file import, where every column of the header is mapped.
+// The objectTypeIDs must hold the imported object and every associated object.
+func newImportRequest(
+	params *common.BulkWriteParams, fileName string, header []string, objectTypeIDs map[common.ObjectName]string,
+) (*importRequest, error) {
+	objectTypeID := objectTypeIDs[params.ObjectName]
+
+	associations := make(map[string]common.BulkWriteAssociation)
+	for _, association := range params.Associations {
+		associations[association.Field] = association
+	}
+
+	mappings := make([]columnMapping, len(header))
+
+	for index, column := range header {
+		association, ok := associations[column]
+		if !ok {
+			mappings[index] = newPropertyMapping(params, objectTypeID, column)
+
+			continue
+		}
+
+		mapping, err := newAssociationMapping(association, objectTypeID, objectTypeIDs[association.ObjectName])
+		if err != nil {
+			return nil, err
+		}
+
+		mappings[index] = *mapping
+	}
+
+	name := params.Name
+	if name == "" {
+		name = fmt.Sprintf("Bulk %s of %s", params.Type, params.ObjectName)
+	}
+
+	return &importRequest{
+		Name:             name,
+		ImportOperations: map[string]string{objectTypeID: importOperations[params.Type]},
+		DateFormat:       "YEAR_MONTH_DAY",
+		Files: []importFile{{
+			FileName:   fileName,
+			FileFormat: "CSV",
+			FileImportPage: fileImportPage{
+				HasHeader:      true,
+				ColumnMappings: mappings,
+			},
+		}},
+	}, nil
+}
+
+// newPropertyMapping maps a column to the property of the same name.
+// Records are matched for update and upsert by the column of the ID field.
+func newPropertyMapping(params *common.BulkWriteParams, objectTypeID, column string) columnMapping {
+	mapping := columnMapping{
+		ColumnObjectTypeID: objectTypeID,
+		ColumnName:         column,
+		PropertyName:       column,
+	}
+
+	if params.Type != common.WriteTypeCreate && column == params.IDField {
+		mapping.ColumnType = identifierColumnType(column)
+	}
+
+	return mapping
+}
+
+func newAssociationMapping(
+	association common.BulkWriteAssociation, objectTypeID, associatedTypeID string,
+) (*columnMapping, error) {
+	propertyName := association.IDField
+	if propertyName == "" {
+		propertyName = recordIDProperty
+	}
+
+	foreignKey, err := parseAssociationLabel(association.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &columnMapping{
+		ColumnObjectTypeID:   associatedTypeID,
+		ColumnName:           association.Field,
+		PropertyName:         propertyName,
+		ColumnType:           identifierColumnType(propertyName),
+		ToColumnObjectTypeID: objectTypeID,
+		ForeignKeyType:       foreignKey,
+	}, nil
+}
+
+func identifierColumnType(propertyName string) string {
+	if propertyName == recordIDProperty {
+		return columnTypeObjectID
+	}
+
+	return columnTypeAlternateID
+}
+
+// parseAssociationLabel reads the association type, such as "279" or "USER_DEFINED:12".
+// Without a label HubSpot uses the default association between the objects.
+func parseAssociationLabel(label string) (*foreignKeyType, error) {
+	if label == "" {
+		return nil, nil // nolint:nilnil
+	}
+
+	category, typeID, found := strings.Cut(label, ":")
+	if !found {
+		category, typeID = associationCategoryHubSpot, label
+	}
+
+	associationTypeID, err := strconv.Atoi(typeID)
+	if err != nil || category == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAssociationLabel, label)
+	}
+
+	return &foreignKeyType{
+		AssociationTypeID:   associationTypeID,
+		AssociationCategory: category,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package imports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"regexp"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// objectTypeIDPattern matches object type ids, such as "0-1" for contacts or "2-123456" for a custom object.
+var objectTypeIDPattern = regexp.MustCompile(`^\d+-\d+$`)
+
+// StartBulkWrite uploads the records as a CSV file and starts an import writing them.
+// Association columns link each record to an existing record of another object.
+// The returned job is polled with GetBulkWriteJob, the failed rows are listed with GetBulkWriteErrors.
+// Records exceeding the row or size limit of a single import file are rejected with ErrImportFileTooLarge.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/imports/start-import
+func (a *Adapter) StartBulkWrite(
+	ctx context.Context, params *common.BulkWriteParams,
+) (*common.BulkWriteJob, error) {
+	if params == nil {
+		return nil, common.ErrMissingRecordData
+	}
+
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	header, data, err := newImportFile(params.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	objectTypeIDs, err := a.resolveObjectTypeIDs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := params.ObjectName.String() + ".csv"
+
+	request, err := newImportRequest(params, fileName, header, objectTypeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentType, err := newMultipartBody(request, fileName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.getImportsURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// The JSON client cannot send multipart content, the underlying client is used instead.
+	rsp, rspBody, err := a.Client.HTTPClient.Post(ctx, url.String(), body, common.Header{
+		Key:   "Content-Type",
+		Value: contentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.ParseJSONResponse(ctx, rsp, rspBody) // nolint:bodyclose
+	if err != nil {
+		return nil, err
+	}
+
+	return newJob(response)
+}
+
+// resolveObjectTypeIDs finds the type id of the written object and of every associated object.
+func (a *Adapter) resolveObjectTypeIDs(
+	ctx context.Context, params *common.BulkWriteParams,
+) (map[common.ObjectName]string, error) {
+	objectNames := []common.ObjectName{params.ObjectName}
+	for _, association := range params.Associations {
+		objectNames = append(objectNames, association.ObjectName)
+	}
+
+	objectTypeIDs := make(map[common.ObjectName]string)
+
+	for _, objectName := range objectNames {
+		if _, ok := objectTypeIDs[objectName]; ok {
+			continue
+		}
+
+		objectTypeID, err := a.resolveObjectTypeID(ctx, objectName.String())
+		if err != nil {
+			return nil, err
+		}
+
+		objectTypeIDs[objectName] = objectTypeID
+	}
+
+	return objectTypeIDs, nil
+}
+
+func (a *Adapter) resolveObjectTypeID(ctx context.Context, objectName string) (string, error) {
+	if objectTypeIDPattern.MatchString(objectName) {
+		return objectName, nil
+	}
+
+	if objectTypeID, ok := a.objectTypeIDs[objectName]; ok {
+		return objectTypeID, nil
+	}
+
+	url, err := a.getSchemaURL(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return "", err
+	}
+
+	schema, err := common.UnmarshalJSON[schemaResponse](response)
+	if err != nil {
+		return "", err
+	}
+
+	if schema == nil || schema.ObjectTypeID == "" {
+		return "", common.ErrEmptyJSONHTTPResponse
+	}
+
+	return schema.ObjectTypeID, nil
+}
+
+// newMultipartBody holds the import request and the file, returns the body with its content type.
+func newMultipartBody(request *importRequest, fileName string, data []byte) ([]byte, string, error) {
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buffer bytes.Buffer
+
+	writer := multipart.NewWriter(&buffer)
+
+	if err = writer.WriteField("importRequest", string(requestData)); err != nil {
+		return nil, "", err
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="files"; filename=%q`, fileName))
+	header.Set("Content-Type", "text/csv")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err = part.Write(data); err != nil {
+		return nil, "", err
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buffer.Bytes(), writer.FormDataContentType(), nil
+}
+
+type schemaResponse struct {
+	ObjectTypeID string `json:"objectTypeId"`
+}
@@ -24,6 +24,11 @@ const (
 func (s Strategy) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
 	ctx = logging.With(ctx, "connector", "hubspot")
 
+	// HubSpot searches by field filters only, a free-text term would otherwise be ignored.
+	if params.IsTextSearch() {
+		return nil, fmt.Errorf("%w: text search", common.ErrOperationNotSupportedForObject)
+	}
+
 	// Check if the NextPage token exceeds the search results limit.
 	// HubSpot's search API returns a 400 error if you try to paginate beyond 10,000 records.
 	// By detecting this proactively, we can return a specific error that callers can handle.
@@ -0,0 +1,122 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Window is a range of last modified timestamps which is searched by a single query.
+// Until is exclusive for every window but the last one of a partition,
+// which ends the read with the bound given by the caller.
+type Window struct {
+	Since time.Time `json:"since"`
+	// Until is zero when there is no upper bound.
+	Until time.Time `json:"until,omitzero"`
+}
+
+// Partition splits an incremental read into windows, each holding fewer records than the search results limit.
+// Windows are read in order, a window is bisected whenever its first page reports too many results.
+//
+// A partition that was never split is paged by the bare HubSpot "after" cursor,
+// otherwise it is carried by the next page token in JSON form, for example:
+//
+//	{"windows":[{"since":"2024-01-01T00:00:00Z","until":"2024-07-01T00:00:00Z"},
+//	{"since":"2024-07-01T00:00:00Z"}],"after":"200"}
+type Partition struct {
+	// Windows yet to be read, the first one is the current window.
+	Windows []Window `json:"windows"`
+	// After is the HubSpot cursor within the current window.
+	After string `json:"after,omitempty"`
+
+	split bool
+}
+
+// NewPartition parses the next page token of an incremental read between since and until.
+func NewPartition(token common.NextPageToken, since, until time.Time) (*Partition, error) {
+	if !strings.HasPrefix(token.String(), "{") {
+		return &Partition{
+			Windows: []Window{{Since: since, Until: until}},
+			After:   token.String(),
+		}, nil
+	}
+
+	partition := &Partition{split: true}
+	if err := json.Unmarshal([]byte(token), partition); err != nil {
+		return nil, fmt.Errorf("%w: %w", common.ErrNextPageInvalid, err)
+	}
+
+	if len(partition.Windows) == 0 {
+		return nil, fmt.Errorf("%w: partition has no windows", common.ErrNextPageInvalid)
+	}
+
+	return partition, nil
+}
+
+// Current is the window being read.
+func (p *Partition) Current() Window {
+	return p.Windows[0]
+}
+
+// IsLast reports whether the current window ends the read, then its Until is inclusive.
+func (p *Partition) IsLast() bool {
+	return len(p.Windows) == 1
+}
+
+// Split bisects the current window before any of its pages is read.
+// An open window is split relative to the time now.
+// Returns false when the window is already read or is too narrow to be split.
+func (p *Partition) Split(now time.Time) bool {
+	if p.After != "" {
+		return false
+	}
+
+	current := p.Current()
+
+	until := current.Until
+	if until.IsZero() {
+		until = now
+	}
+
+	// Timestamps are filtered with the precision of a second.
+	middle := current.Since.Add(until.Sub(current.Since) / 2).Truncate(time.Second) // nolint:mnd
+	if !middle.After(current.Since) || !middle.Before(until) {
+		return false
+	}
+
+	p.Windows = append([]Window{
+		{Since: current.Since, Until: middle},
+		{Since: middle, Until: current.Until},
+	}, p.Windows[1:]...)
+	p.split = true
+
+	return true
+}
+
+// Next advances the partition past the page which returned the given cursor.
+// The current window is done when the cursor is empty.
+// Returns an empty token once every window was read.
+func (p *Partition) Next(after string) (common.NextPageToken, error) {
+	p.After = after
+
+	if after == "" {
+		p.Windows = p.Windows[1:]
+		if len(p.Windows) == 0 {
+			return "", nil
+		}
+	}
+
+	if !p.split {
+		return common.NextPageToken(p.After), nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	return common.NextPageToken(data), nil
+}
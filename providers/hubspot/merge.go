@@ -0,0 +1,107 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+var _ connectors.MergeConnector = &Connector{}
+
+type mergePayload struct {
+	PrimaryObjectID string `json:"primaryObjectId"`
+	ObjectIDToMerge string `json:"objectIdToMerge"`
+}
+
+// Merge merges losers into the winner one at a time, as HubSpot merges a single pair per request.
+// The merged record may get a new ID, which becomes the primary of the next request.
+// Field values are written once all records are merged.
+// On failure the records merged so far are returned along with the error.
+func (c *Connector) Merge(ctx context.Context, params common.MergeParams) (*common.MergeResult, error) {
+	ctx = logging.With(ctx, "connector", "hubspot")
+
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	url, err := c.getCRMObjectsMergeURL(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		primaryID = params.WinnerId
+		merged    *writeResponse
+		mergedIDs = make([]string, 0, len(params.LoserIds))
+	)
+
+	for _, loserID := range params.LoserIds {
+		json, err := c.JSONHTTPClient().Post(ctx, url.String(), mergePayload{
+			PrimaryObjectID: primaryID,
+			ObjectIDToMerge: loserID,
+		})
+		if err != nil {
+			return partialMergeResult(primaryID, mergedIDs), err
+		}
+
+		merged, err = common.UnmarshalJSON[writeResponse](json)
+		if err != nil {
+			return partialMergeResult(primaryID, mergedIDs), err
+		}
+
+		if merged == nil {
+			return partialMergeResult(primaryID, mergedIDs), common.ErrEmptyJSONHTTPResponse
+		}
+
+		primaryID = merged.ID
+		mergedIDs = append(mergedIDs, loserID)
+	}
+
+	if len(params.FieldValues) != 0 {
+		return c.writeMergedFields(ctx, params, primaryID, mergedIDs)
+	}
+
+	record, err := datautils.StructToMap(*merged)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.MergeResult{
+		Success:   true,
+		RecordId:  primaryID,
+		MergedIds: mergedIDs,
+		Data:      record,
+	}, nil
+}
+
+func (c *Connector) writeMergedFields(
+	ctx context.Context, params common.MergeParams, recordID string, mergedIDs []string,
+) (*common.MergeResult, error) {
+	result, err := c.Write(ctx, common.WriteParams{
+		ObjectName: params.ObjectName,
+		RecordId:   recordID,
+		RecordData: params.FieldValues,
+	})
+	if err != nil {
+		return partialMergeResult(recordID, mergedIDs), err
+	}
+
+	return &common.MergeResult{
+		Success:   result.Success,
+		RecordId:  recordID,
+		MergedIds: mergedIDs,
+		Data:      result.Data,
+	}, nil
+}
+
+// partialMergeResult describes a merge interrupted by an error after some losers may have been merged.
+func partialMergeResult(recordID string, mergedIDs []string) *common.MergeResult {
+	return &common.MergeResult{
+		Success:   false,
+		RecordId:  recordID,
+		MergedIds: mergedIDs,
+	}
+}
@@ -0,0 +1,138 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestMerge(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseFirst := testutils.DataFromFile(t, "merge/contacts/first.json")
+	responseSecond := testutils.DataFromFile(t, "merge/contacts/second.json")
+	responseUpdate := testutils.DataFromFile(t, "merge/contacts/update.json")
+
+	tests := []testconn.TestCaseMerge{
+		{
+			Name:         "Object name is required",
+			Input:        common.MergeParams{WinnerId: "151", LoserIds: []string{"152"}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name:         "Losers are required",
+			Input:        common.MergeParams{ObjectName: "contacts", WinnerId: "151"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingMergeRecords},
+		},
+		{
+			Name: "Losers are merged one at a time into the latest record ID",
+			Input: common.MergeParams{
+				ObjectName:  "contacts",
+				WinnerId:    "151",
+				LoserIds:    []string{"152", "153"},
+				FieldValues: map[string]any{"firstname": "Marcus"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/merge"),
+						mockcond.Body(`{"primaryObjectId":"151","objectIdToMerge":"152"}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFirst),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/merge"),
+						mockcond.Body(`{"primaryObjectId":"151","objectIdToMerge":"153"}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSecond),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPATCH(),
+						mockcond.Path("/crm/v3/objects/contacts/201"),
+						mockcond.Body(`{"properties":{"firstname":"Marcus"},"associations":null}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseUpdate),
+				}},
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.MergeResult) *testutils.CompareResult {
+				result := testutils.NewCompareResult()
+				result.Assert("Success", expected.Success, actual.Success)
+				result.Assert("RecordId", expected.RecordId, actual.RecordId)
+				result.Assert("MergedIds", expected.MergedIds, actual.MergedIds)
+				result.Assert("Data.properties", expected.Data["properties"], actual.Data["properties"])
+
+				return result
+			},
+			Expected: &common.MergeResult{
+				Success:   true,
+				RecordId:  "201",
+				MergedIds: []string{"152", "153"},
+				Data: map[string]any{
+					"properties": map[string]any{
+						"createdate":       "2026-10-19T07:40:12.034Z",
+						"email":            "Markus.Blevins@hubspot.com",
+						"firstname":        "Marcus",
+						"hs_object_id":     "201",
+						"lastmodifieddate": "2026-10-19T08:02:14.002Z",
+						"lastname":         "Blevins",
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Records merged before a failure are returned with the error",
+			Input: common.MergeParams{
+				ObjectName: "contacts",
+				WinnerId:   "151",
+				LoserIds:   []string{"152", "153"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/merge"),
+						mockcond.Body(`{"primaryObjectId":"151","objectIdToMerge":"152"}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFirst),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/merge"),
+						mockcond.Body(`{"primaryObjectId":"151","objectIdToMerge":"153"}`),
+					},
+					Then: mockserver.ResponseString(http.StatusBadRequest,
+						`{"status":"error","message":"Contact 153 was already merged","category":"VALIDATION_ERROR"}`),
+				}},
+			}.Server(),
+			Expected: &common.MergeResult{
+				Success:   false,
+				RecordId:  "151",
+				MergedIds: []string{"152"},
+			},
+			ExpectedErrs: []error{common.ErrBadRequest},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (testconn.TestableMerger, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
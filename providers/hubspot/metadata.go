@@ -35,6 +35,14 @@ func (c *Connector) UpsertMetadata(
 	return c.customAdapter.UpsertMetadata(ctx, params)
 }
 
+// DeleteMetadata removes custom properties, property groups and custom objects,
+// refusing those still in use unless the deletion is forced.
+func (c *Connector) DeleteMetadata(
+	ctx context.Context, params *common.DeleteMetadataParams,
+) (*common.DeleteMetadataResult, error) {
+	return c.customAdapter.DeleteMetadata(ctx, params)
+}
+
 // ListObjectMetadata returns object metadata for each object name provided.
 func (c *Connector) ListObjectMetadata( // nolint:cyclop,funlen
 	ctx context.Context,
@@ -2,10 +2,12 @@ package hubspot
 
 import (
 	"net/http"
+	"sync/atomic"
 	"testing"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/custom"
 	"github.com/amp-labs/connectors/test/utils/mockutils"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
@@ -1038,3 +1040,394 @@ func TestUpsertMetadataCRM(t *testing.T) { // nolint:funlen,gocognit,cyclop
 		})
 	}
 }
+
+func TestDeleteMetadataCRM(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responsePropertyAge := testutils.DataFromFile(t, "custom/delete/property-age.json")
+	responsePropertyEmail := testutils.DataFromFile(t, "custom/delete/property-email.json")
+	responseProperties := testutils.DataFromFile(t, "custom/delete/properties-contacts.json")
+	responsePropertyGroup := testutils.DataFromFile(t, "custom/delete/property-group.json")
+	responseSchemaCars := testutils.DataFromFile(t, "custom/delete/schema-cars.json")
+	responseSchemaContacts := testutils.DataFromFile(t, "custom/delete/schema-contacts.json")
+	responseRecordsCars := testutils.DataFromFile(t, "custom/delete/records-cars.json")
+
+	// Records of the custom object are listed until the forced deletion archives them.
+	var carsArchived atomic.Bool
+
+	tests := []testconn.TestCaseDeleteMetadata{
+		{
+			Name:         "At least one field, group or object must be deleted",
+			Input:        &common.DeleteMetadataParams{},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingFieldsMetadata},
+		},
+		{
+			Name: "Unused property is archived and missing property is skipped",
+			Input: &common.DeleteMetadataParams{
+				Fields: map[common.ObjectName][]string{
+					"contacts": {"age__c", "removed__c"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyAge),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/removed__c"),
+					},
+					Then: mockserver.ResponseString(http.StatusNotFound, `{"status":"error"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+						mockcond.Body(`{"filterGroups":[{"filters":[
+							{"propertyName":"age__c","operator":"HAS_PROPERTY"}]}],"limit":1}`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":0,"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodDELETE(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: true,
+				Fields: map[string]map[string]common.MetadataDeleteResult{
+					"contacts": {
+						"age__c":     {Name: "age__c", Action: common.DeleteMetadataActionDelete},
+						"removed__c": {Name: "removed__c", Action: common.DeleteMetadataActionNone},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Property with values and HubSpot property are refused",
+			Input: &common.DeleteMetadataParams{
+				Fields: map[common.ObjectName][]string{
+					"contacts": {"age__c", "email"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyAge),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/email"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyEmail),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":12,"results":[]}`),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: false,
+				Fields: map[string]map[string]common.MetadataDeleteResult{
+					"contacts": {
+						"age__c": {
+							Name:         "age__c",
+							Action:       common.DeleteMetadataActionRefused,
+							Dependencies: []string{"12 records have a value"},
+						},
+						"email": {
+							Name:     "email",
+							Action:   common.DeleteMetadataActionRefused,
+							Warnings: []string{"property is defined by HubSpot and cannot be archived"},
+						},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Forced deletion archives property with values",
+			Input: &common.DeleteMetadataParams{
+				Fields: map[common.ObjectName][]string{
+					"contacts": {"age__c"},
+				},
+				Force: true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyAge),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":12,"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodDELETE(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: true,
+				Fields: map[string]map[string]common.MetadataDeleteResult{
+					"contacts": {
+						"age__c": {
+							Name:         "age__c",
+							Action:       common.DeleteMetadataActionDelete,
+							Dependencies: []string{"12 records have a value"},
+						},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Property group holding properties is refused",
+			Input: &common.DeleteMetadataParams{
+				FieldGroups: map[common.ObjectName][]string{
+					"contacts": {"integrationcreatedproperties"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/groups/integrationcreatedproperties"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyGroup),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseProperties),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: false,
+				FieldGroups: map[string]map[string]common.MetadataDeleteResult{
+					"contacts": {
+						"integrationcreatedproperties": {
+							Name:         "integrationcreatedproperties",
+							Action:       common.DeleteMetadataActionRefused,
+							Dependencies: []string{"property hobby__c", "property isready__c"},
+						},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Custom object with records and standard object are refused",
+			Input: &common.DeleteMetadataParams{
+				Objects: []common.ObjectName{"cars", "contacts"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/cars"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSchemaCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/contacts"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSchemaContacts),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/2-21883416/search"),
+						mockcond.Body(`{"limit":1}`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":2,"results":[]}`),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: false,
+				Objects: map[string]common.MetadataDeleteResult{
+					"cars": {
+						Name:         "cars",
+						Action:       common.DeleteMetadataActionRefused,
+						Dependencies: []string{"2 records"},
+					},
+					"contacts": {
+						Name:     "contacts",
+						Action:   common.DeleteMetadataActionRefused,
+						Warnings: []string{"only custom objects can be deleted"},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Forced deletion archives records of custom object before its schema",
+			Input: &common.DeleteMetadataParams{
+				Objects: []common.ObjectName{"cars"},
+				Force:   true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/cars"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSchemaCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/2-21883416/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":2,"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/objects/2-21883416"),
+						mockcond.QueryParam("limit", "100"),
+						mockcond.CustomCondition(func(http.ResponseWriter, *http.Request) bool {
+							return !carsArchived.Load()
+						}),
+					},
+					Then: mockserver.Response(http.StatusOK, responseRecordsCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/objects/2-21883416"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/2-21883416/batch/archive"),
+						mockcond.Body(`{"inputs":[{"id":"33451"},{"id":"33452"}]}`),
+					},
+					Then: func(w http.ResponseWriter, _ *http.Request) {
+						carsArchived.Store(true)
+						w.WriteHeader(http.StatusNoContent)
+					},
+				}, {
+					If: mockcond.And{
+						mockcond.MethodDELETE(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/cars"),
+						mockcond.CustomCondition(func(http.ResponseWriter, *http.Request) bool {
+							return carsArchived.Load()
+						}),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: true,
+				Objects: map[string]common.MetadataDeleteResult{
+					"cars": {
+						Name:         "cars",
+						Action:       common.DeleteMetadataActionDelete,
+						Dependencies: []string{"2 records"},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Records listed again after archiving stop the forced deletion",
+			Input: &common.DeleteMetadataParams{
+				Fields:  map[common.ObjectName][]string{"contacts": {"age__c"}},
+				Objects: []common.ObjectName{"cars"},
+				Force:   true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePropertyAge),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":0,"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodDELETE(),
+						mockcond.Path("/crm/v3/properties/contacts/age__c"),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm-object-schemas/2026-03/schemas/cars"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSchemaCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/2-21883416/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"total":2,"results":[]}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/crm/v3/objects/2-21883416"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseRecordsCars),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/2-21883416/batch/archive"),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.DeleteMetadataResult{
+				Success: false,
+				Fields: map[string]map[string]common.MetadataDeleteResult{
+					"contacts": {
+						"age__c": {Name: "age__c", Action: common.DeleteMetadataActionDelete},
+					},
+				},
+				Objects: map[string]common.MetadataDeleteResult{},
+			},
+			ExpectedErrs: []error{custom.ErrRecordsNotArchived},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := common.WithAuthToken(t.Context(), "TEST_ACCESS_TOKEN")
+
+			tt.RunWithContext(t, ctx, func() (testconn.TestableMetadataDeleter, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
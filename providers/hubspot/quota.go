@@ -0,0 +1,56 @@
+package hubspot
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.QuotaConnector = &Connector{}
+
+// Quota scopes reported by HubSpot. Daily limits reset at midnight in the account time zone,
+// which is not part of the response, so their reset time is unknown.
+const (
+	QuotaScopeDaily    = "daily"
+	QuotaScopeInterval = "interval"
+	QuotaScopeSecondly = "secondly"
+)
+
+// extractQuota parses the X-HubSpot-RateLimit-* headers.
+// https://developers.hubspot.com/docs/api/usage-details#rate-limits
+func extractQuota(rsp *http.Response, observedAt time.Time) []common.QuotaStatus {
+	var statuses []common.QuotaStatus
+
+	if status, ok := common.QuotaFromHeaders(rsp.Header, common.RateLimitHeaders{
+		Limit:     "X-HubSpot-RateLimit-Daily",
+		Remaining: "X-HubSpot-RateLimit-Daily-Remaining",
+	}, QuotaScopeDaily, observedAt); ok {
+		statuses = append(statuses, status)
+	}
+
+	if status, ok := common.QuotaFromHeaders(rsp.Header, common.RateLimitHeaders{
+		Limit:     "X-HubSpot-RateLimit-Max",
+		Remaining: "X-HubSpot-RateLimit-Remaining",
+	}, QuotaScopeInterval, observedAt); ok {
+		// The rolling window ends at the latest one interval from now.
+		interval, err := strconv.ParseInt(rsp.Header.Get("X-HubSpot-RateLimit-Interval-Milliseconds"), 10, 64)
+		if err == nil {
+			status.Reset = observedAt.Add(time.Duration(interval) * time.Millisecond)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	if status, ok := common.QuotaFromHeaders(rsp.Header, common.RateLimitHeaders{
+		Limit:     "X-HubSpot-RateLimit-Secondly",
+		Remaining: "X-HubSpot-RateLimit-Secondly-Remaining",
+	}, QuotaScopeSecondly, observedAt); ok {
+		status.Reset = observedAt.Add(time.Second)
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
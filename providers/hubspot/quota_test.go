@@ -0,0 +1,44 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestExtractQuota(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("X-HubSpot-RateLimit-Daily", "250000")
+	header.Set("X-HubSpot-RateLimit-Daily-Remaining", "249990")
+	header.Set("X-HubSpot-RateLimit-Interval-Milliseconds", "10000")
+	header.Set("X-HubSpot-RateLimit-Max", "100")
+	header.Set("X-HubSpot-RateLimit-Remaining", "97")
+	header.Set("X-HubSpot-RateLimit-Secondly", "10")
+	header.Set("X-HubSpot-RateLimit-Secondly-Remaining", "9")
+
+	observedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testutils.CheckOutput(t, "X-HubSpot-RateLimit headers",
+		[]common.QuotaStatus{
+			{Scope: QuotaScopeDaily, Remaining: 249990, Limit: 250000, ObservedAt: observedAt},
+			{
+				Scope: QuotaScopeInterval, Remaining: 97, Limit: 100,
+				Reset: observedAt.Add(10 * time.Second), ObservedAt: observedAt,
+			},
+			{
+				Scope: QuotaScopeSecondly, Remaining: 9, Limit: 10,
+				Reset: observedAt.Add(time.Second), ObservedAt: observedAt,
+			},
+		},
+		extractQuota(&http.Response{Header: header}, observedAt),
+	)
+
+	if statuses := extractQuota(&http.Response{Header: http.Header{}}, observedAt); len(statuses) != 0 {
+		t.Errorf("expected no quota without headers, got %v", statuses)
+	}
+}
@@ -21,12 +21,17 @@ import (
 )
 
 // Read reads data from Hubspot. If Since is set, it will use the
-// ReadUsingSearchAPI endpoint instead to filter records, but it will be
-// limited to a maximum of 10,000 records. This is a limit of the
-// search endpoint. If Since is not set, it will use the read endpoint.
+// ReadUsingSearchAPI endpoint instead to filter records. The search endpoint
+// is limited to 10,000 records per query, so the time range is split into
+// smaller windows when needed. If Since is not set, it will use the read endpoint.
 // In case Deleted objects won’t appear in any search results.
 // Deleted objects can only be read by using this endpoint.
-func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) { //nolint:funlen
+// Values are normalized on request, see common.ReadParams.NormalizeValues.
+func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	return c.normalizingReader.Read(ctx, params)
+}
+
+func (c *Connector) read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) { //nolint:funlen
 	ctx = logging.With(ctx, "connector", "hubspot")
 
 	if err := params.ValidateParams(true); err != nil {
@@ -83,7 +88,12 @@ func (c *Connector) readCRMObjectsAPI(
 		filters = append(filters, BuildUntilTimestampFilterGroup(&params))
 	}
 
-	filters = append(filters, BuildBuilderFilters(params.BuilderFilter)...)
+	builderFilters, err := BuildBuilderFilters(params.BuilderFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	filters = append(filters, builderFilters...)
 
 	if len(filters) != 0 {
 		searchParams := SearchParams{
@@ -98,6 +108,8 @@ func (c *Connector) readCRMObjectsAPI(
 			NextPage:          params.NextPage,
 			Fields:            params.Fields,
 			AssociatedObjects: params.AssociatedObjects,
+			Since:             params.Since,
+			Until:             params.Until,
 		}
 
 		return c.ReadUsingSearchAPI(ctx, searchParams)
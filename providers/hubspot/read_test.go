@@ -144,6 +144,53 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 				Done:     false,
 			},
 		},
+		{
+			Name: "Contacts records matching any of the values",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				BuilderFilter: &common.SearchFilter{FieldFilters: []common.FieldFilter{{
+					FieldName: "hs_object_id",
+					Operator:  common.FilterOperatorIN,
+					Value:     []any{"101", 102},
+				}}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/crm/v3/objects/contacts/search"),
+					mockcond.Body(`{
+						"filterGroups": [{"filters": [
+							{"propertyName": "hs_object_id", "operator": "IN", "values": ["101", "102"]}
+						]}],
+						"limit": "200",
+						"properties": ["email"],
+						"sorts": [{"propertyName": "hs_object_id", "direction": "ASCENDING"}]
+					}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContacts),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     3,
+				NextPage: "394",
+				Done:     false,
+			},
+		},
+		{
+			Name: "Filter matching any of no values is rejected",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				BuilderFilter: &common.SearchFilter{FieldFilters: []common.FieldFilter{{
+					FieldName: "hs_object_id",
+					Operator:  common.FilterOperatorIN,
+					Value:     []string{},
+				}}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrBadRequest},
+		},
 		{
 			Name: "Contacts records until time",
 			Input: common.ReadParams{
@@ -85,3 +85,14 @@ func resolveReferencedObjectName(hsType string) string {
 
 	return strings.ToLower(hsType) + "s"
 }
+
+// knownObjectTypeIDs maps object names to their type ids, the reverse of KnownObjectTypes.
+func knownObjectTypeIDs() map[string]string {
+	objectTypeIDs := make(map[string]string, len(KnownObjectTypes))
+
+	for objectTypeID, objectName := range KnownObjectTypes {
+		objectTypeIDs[objectName] = objectTypeID
+	}
+
+	return objectTypeIDs
+}
@@ -0,0 +1,95 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/providers"
+)
+
+var _ connectors.ScopeChecker = &Connector{}
+
+// CheckScopes reports objects and operations which the granted scopes do not permit.
+// HubSpot does not return scopes in the token response, callers can obtain them
+// from GET /oauth/v1/access-tokens/{token}.
+func (c *Connector) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	return c.registry.CheckScopes(c.Module(), params), nil
+}
+
+// requiredScopes lists scopes per CRM object.
+// Apps created before granular scopes were introduced hold the legacy "contacts" scope instead,
+// which covers contacts, companies, deals and engagements.
+// https://developers.hubspot.com/docs/api/scopes
+func requiredScopes() components.EndpointRegistryInput {
+	return components.EndpointRegistryInput{
+		providers.ModuleHubspotCRM: {
+			{
+				Endpoint: "contacts",
+				Scopes:   granularScopes("crm.objects.contacts", "contacts"),
+			},
+			{
+				Endpoint: "companies",
+				Scopes:   granularScopes("crm.objects.companies", "contacts"),
+			},
+			{
+				Endpoint: "deals",
+				Scopes:   granularScopes("crm.objects.deals", "contacts"),
+			},
+			{
+				Endpoint: "{calls,emails,meetings,notes,tasks}",
+				Scopes:   granularScopes("crm.objects.contacts", "contacts"),
+			},
+			{
+				Endpoint: "quotes",
+				Scopes:   granularScopes("crm.objects.quotes", ""),
+			},
+			{
+				Endpoint: "lists",
+				Scopes:   granularScopes("crm.lists", ""),
+			},
+			{
+				Endpoint: "owners",
+				Scopes:   common.ScopeRequirements{Read: []string{"crm.objects.owners.read"}},
+			},
+			{
+				Endpoint: "tickets",
+				Scopes:   singleScope("tickets"),
+			},
+			{
+				Endpoint: "{products,line_items}",
+				Scopes:   singleScope("e-commerce"),
+			},
+		},
+	}
+}
+
+// granularScopes requires "<prefix>.read" for reading and "<prefix>.write" for writing and deleting,
+// either of which may be replaced by the legacy scope when one is given.
+func granularScopes(prefix, legacy string) common.ScopeRequirements {
+	read := prefix + ".read"
+	write := prefix + ".write"
+
+	if legacy != "" {
+		read += "|" + legacy
+		write += "|" + legacy
+	}
+
+	return common.ScopeRequirements{
+		Read:   []string{read},
+		Write:  []string{write},
+		Delete: []string{write},
+	}
+}
+
+// singleScope is for scopes which grant both reading and writing.
+func singleScope(scope string) common.ScopeRequirements {
+	return common.ScopeRequirements{
+		Read:   []string{scope},
+		Write:  []string{scope},
+		Delete: []string{scope},
+	}
+}
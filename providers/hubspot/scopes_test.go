@@ -0,0 +1,87 @@
+package hubspot
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestCheckScopes(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	conn, err := constructTestConnector("https://api.hubapi.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		input    common.ScopeCheckParams
+		expected []common.MissingScopes
+	}{
+		{
+			name: "Granular scopes permit read only",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"crm.objects.contacts.read"},
+				ObjectNames:   []string{"contacts"},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "crm", ObjectName: "contacts", Operation: common.ScopeOperationWrite,
+					Scopes: []string{"crm.objects.contacts.write|contacts"},
+				},
+				{
+					Module: "crm", ObjectName: "contacts", Operation: common.ScopeOperationDelete,
+					Scopes: []string{"crm.objects.contacts.write|contacts"},
+				},
+			},
+		},
+		{
+			name: "Legacy scope is an alternative",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"contacts"},
+				ObjectNames:   []string{"deals", "notes"},
+			},
+			expected: nil,
+		},
+		{
+			name: "Objects without requirements pass",
+			input: common.ScopeCheckParams{
+				ObjectNames: []string{"unknown"},
+				Operations:  []common.ScopeOperation{common.ScopeOperationRead},
+			},
+			expected: nil,
+		},
+		{
+			name: "All declared objects are checked by default",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"contacts", "tickets", "e-commerce", "crm.objects.quotes.read", "crm.lists.read"},
+				Operations:    []common.ScopeOperation{common.ScopeOperationRead},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "crm", ObjectName: "owners", Operation: common.ScopeOperationRead,
+					Scopes: []string{"crm.objects.owners.read"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := conn.CheckScopes(t.Context(), tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testutils.CheckOutput(t, tt.name, tt.expected, result.Missing)
+
+			if (len(tt.expected) == 0) != (result.Err() == nil) {
+				t.Fatalf("%s: unexpected error %v", tt.name, result.Err())
+			}
+		})
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/logging"
 	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/jsonquery"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/associations"
 	"github.com/amp-labs/connectors/providers/hubspot/internal/core"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/search"
 )
 
 const (
@@ -29,8 +31,9 @@ func (c *Connector) Search(ctx context.Context, params *common.SearchParams) (*c
 }
 
 // ReadUsingSearchAPI uses the POST /search endpoint to filter object records and return the result.
-// This endpoint has a limit of 10,000 records. If the result has more than 10,000 records,
-// the caller should employ sorting to paginate through the result on the client side.
+// This endpoint has a limit of 10,000 records. When Since is set, CRM objects are read in time windows,
+// which are bisected until each holds at most 10,000 records. Otherwise, if the result has more than
+// 10,000 records, the caller should employ sorting to paginate through the result on the client side.
 // This endpoint paginates using paging.next.after which is to be used as an offset.
 // Archived results do not appear in search results.
 // Read more @ https://developers.hubspot.com/docs/api/crm/search
@@ -86,16 +89,89 @@ func (c *Connector) ReadUsingSearchAPI( // nolint:cyclop
 }
 
 func (c *Connector) searchCRMObjectsAPI(ctx context.Context, params SearchParams) (*common.ReadResult, error) {
-	url, err := c.getCRMObjectsSearchURL(params.ObjectName)
+	if params.Since.IsZero() {
+		url, err := c.getCRMObjectsSearchURL(params.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+
+		rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), makeFilterBody(params))
+		if err != nil {
+			return nil, err
+		}
+
+		return c.parseSearchCRMObjectsAPI(ctx, params, rsp)
+	}
+
+	return c.searchCRMObjectsAPIByWindows(ctx, params)
+}
+
+// searchCRMObjectsAPIByWindows reads records modified between Since and Until
+// while keeping each search query under the limit of 10,000 results.
+// The time range is bisected until the first page of a window reports a total within the limit.
+// Windows follow one another via the NextPageToken, so the caller pages through one continuous read.
+func (c *Connector) searchCRMObjectsAPIByWindows(
+	ctx context.Context, params SearchParams,
+) (*common.ReadResult, error) {
+	partition, err := search.NewPartition(params.NextPage, params.Since, params.Until)
 	if err != nil {
 		return nil, err
 	}
 
-	rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), makeFilterBody(params))
+	if err := checkSearchResultsLimit(common.NextPageToken(partition.After)); err != nil {
+		return nil, fmt.Errorf(
+			"%w: requested offset %s exceeds limit %d",
+			common.ErrResultsLimitExceeded,
+			partition.After,
+			searchResultsLimit,
+		)
+	}
+
+	url, err := c.getCRMObjectsSearchURL(params.ObjectName)
 	if err != nil {
 		return nil, err
 	}
 
+	for {
+		windowParams := params.applyWindow(partition.Current(), partition.IsLast())
+		windowParams.NextPage = common.NextPageToken(partition.After)
+
+		rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), makeFilterBody(windowParams))
+		if err != nil {
+			return nil, err
+		}
+
+		total, err := getSearchTotal(rsp)
+		if err != nil {
+			return nil, err
+		}
+
+		if total > searchResultsLimit && partition.Split(time.Now()) {
+			logging.Logger(ctx).Debug("splitting search window",
+				"object", params.ObjectName, "total", total)
+
+			continue
+		}
+
+		result, err := c.parseSearchCRMObjectsAPI(ctx, windowParams, rsp)
+		if err != nil {
+			return nil, err
+		}
+
+		result.NextPage, err = partition.Next(result.NextPage.String())
+		if err != nil {
+			return nil, err
+		}
+
+		result.Done = result.NextPage == ""
+
+		return result, nil
+	}
+}
+
+func (c *Connector) parseSearchCRMObjectsAPI(
+	ctx context.Context, params SearchParams, rsp *common.JSONHTTPResponse,
+) (*common.ReadResult, error) {
 	return common.ParseResult(
 		rsp,
 		core.GetRecords,
@@ -141,27 +217,44 @@ func (c *Connector) searchCRM(
 }
 
 // BuildBuilderFilters converts a common.SearchFilter into a slice of HubSpot Filters.
-// Only the eq operator is supported.
-func BuildBuilderFilters(filter *common.SearchFilter) []Filter {
+// Only the eq and in operators are supported.
+func BuildBuilderFilters(filter *common.SearchFilter) ([]Filter, error) {
 	if filter == nil {
-		return nil
+		return nil, nil
 	}
 
 	out := make([]Filter, 0, len(filter.FieldFilters))
 
 	for _, ff := range filter.FieldFilters {
-		if ff.Operator != common.FilterOperatorEQ {
-			continue
+		switch ff.Operator {
+		case common.FilterOperatorEQ:
+			out = append(out, Filter{
+				FieldName: ff.FieldName,
+				Operator:  FilterOperatorTypeEQ,
+				Value:     fmt.Sprintf("%v", ff.Value),
+			})
+		case common.FilterOperatorIN:
+			values, err := ff.InValues()
+			if err != nil {
+				return nil, err
+			}
+
+			strValues := make([]string, len(values))
+			for index, value := range values {
+				strValues[index] = fmt.Sprintf("%v", value)
+			}
+
+			out = append(out, Filter{
+				FieldName: ff.FieldName,
+				Operator:  FilterOperatorIN,
+				Values:    strValues,
+			})
+		default:
+			return nil, fmt.Errorf("%w: filter operator %q", common.ErrOperationNotSupportedForObject, ff.Operator)
 		}
-
-		out = append(out, Filter{
-			FieldName: ff.FieldName,
-			Operator:  FilterOperatorTypeEQ,
-			Value:     fmt.Sprintf("%v", ff.Value),
-		})
 	}
 
-	return out
+	return out, nil
 }
 
 // BuildLastModifiedFilterGroup filters records modified since the given time.
@@ -196,7 +289,7 @@ func BuildUntilTimestampFilterGroup(params *common.ReadParams) Filter {
 
 	// Use the lastmodifieddate field for contacts, and hs_lastmodifieddate for other objects.
 	lastModifiedField := ObjectFieldHsLastModifiedDate
-	if params.ObjectName == string(ObjectTypeContact) {
+	if naming.PluralityAndCaseIgnoreEqual(params.ObjectName, string(ObjectTypeContact)) {
 		lastModifiedField = ObjectFieldLastModifiedDate
 	}
 
@@ -207,6 +300,61 @@ func BuildUntilTimestampFilterGroup(params *common.ReadParams) Filter {
 	}
 }
 
+// applyWindow narrows the last modified timestamp filters to the window.
+// The window ends with an exclusive bound unless it is the last window of the read.
+func (p SearchParams) applyWindow(window search.Window, isLast bool) SearchParams {
+	params := makeReadParamsFromSearchParams(p)
+	params.Since = window.Since
+	params.Until = window.Until
+
+	sinceFilter := BuildLastModifiedFilterGroup(&params)
+	untilFilter := BuildUntilTimestampFilterGroup(&params)
+
+	if !isLast && !untilFilter.isEmpty() {
+		untilFilter.Operator = FilterOperatorTypeLT
+	}
+
+	groups := make([]FilterGroup, len(p.FilterGroups))
+
+	// Bounds are replaced in place, every group keeps its filters in order.
+	for index, group := range p.FilterGroups {
+		filters := make(Filters, 0, len(group.Filters)+2) // nolint:mnd
+		hasSince, hasUntil := false, false
+
+		for _, filter := range group.Filters {
+			switch {
+			case filter.FieldName != sinceFilter.FieldName:
+				filters = append(filters, filter)
+			case filter.Operator == FilterOperatorTypeGTE:
+				filters = append(filters, sinceFilter)
+				hasSince = true
+			case filter.Operator == FilterOperatorTypeLTE || filter.Operator == FilterOperatorTypeLT:
+				if !untilFilter.isEmpty() {
+					filters = append(filters, untilFilter)
+				}
+
+				hasUntil = true
+			default:
+				filters = append(filters, filter)
+			}
+		}
+
+		if !hasSince {
+			filters = append(filters, sinceFilter)
+		}
+
+		if !hasUntil && !untilFilter.isEmpty() {
+			filters = append(filters, untilFilter)
+		}
+
+		groups[index] = FilterGroup{Filters: filters}
+	}
+
+	p.FilterGroups = groups
+
+	return p
+}
+
 // BuildIdFilterGroup filters records greater than the given id.
 func BuildIdFilterGroup(id string) Filter {
 	return Filter{
@@ -233,7 +381,7 @@ func (p SearchParams) applyTimestampFilters() SearchParams {
 	sinceFilter := BuildLastModifiedFilterGroup(&params)
 	untilFilter := BuildUntilTimestampFilterGroup(&params)
 
-	if len(p.FilterGroups) == 0 && (sinceFilter != (Filter{}) || untilFilter != (Filter{})) {
+	if len(p.FilterGroups) == 0 && (!sinceFilter.isEmpty() || !untilFilter.isEmpty()) {
 		// Initialize group because either since or until (or both) should be populated.
 		p.FilterGroups = []FilterGroup{{
 			Filters: Filters{},
@@ -258,7 +406,7 @@ func (p SearchParams) applyTimestampFilters() SearchParams {
 }
 
 func (g FilterGroup) hasFilter(target Filter) bool {
-	if target == (Filter{}) {
+	if target.isEmpty() {
 		return true
 	}
 
@@ -326,3 +474,12 @@ func checkSearchResultsLimit(nextPage common.NextPageToken) error {
 
 	return nil
 }
+
+func getSearchTotal(rsp *common.JSONHTTPResponse) (int64, error) {
+	body, ok := rsp.Body()
+	if !ok {
+		return 0, nil
+	}
+
+	return jsonquery.New(body).IntegerWithDefault("total", 0)
+}
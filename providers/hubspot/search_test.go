@@ -40,6 +40,16 @@ func TestSearch(t *testing.T) { // nolint:funlen,cyclop
 			Server:       mockserver.Dummy(),
 			ExpectedErrs: []error{common.ErrMissingSearchFilters},
 		},
+		{
+			Name: "Text search is not supported",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("id"),
+				Text:       &common.TextSearch{Term: "Johnnie"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
 		{
 			Name: "Correct error message is understood from JSON response",
 			Input: common.SearchParams{
@@ -444,6 +454,157 @@ func TestReadUsingSearchAPI(t *testing.T) {
 	}
 }
 
+func TestReadUsingSearchAPIWindows(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseOverLimit := testutils.DataFromFile(t, "read-via-search/windows/over-limit.json")
+	responseFirstHalf := testutils.DataFromFile(t, "read-via-search/windows/first-half.json")
+	responseSecondHalf := testutils.DataFromFile(t, "read-via-search/windows/second-half.json")
+	responseSecondHalfLastPage := testutils.DataFromFile(t, "read-via-search/windows/second-half-last-page.json")
+
+	searchBody := func(since, untilOperator, until, after string) string {
+		body := `{"limit":"200","properties":["email"],"filterGroups":[{"filters":[` +
+			`{"propertyName":"lastmodifieddate","operator":"GTE","value":"` + since + `"},` +
+			`{"propertyName":"lastmodifieddate","operator":"` + untilOperator + `","value":"` + until + `"}]}]`
+
+		if after != "" {
+			body += `,"after":"` + after + `"`
+		}
+
+		return body + "}"
+	}
+
+	secondWindow := `{"windows":[{"since":"2024-01-02T00:00:00Z","until":"2024-01-03T00:00:00Z"}]}`
+
+	tests := []SearchViaRead{
+		{
+			Name: "Window over the results limit is bisected",
+			Input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+						mockcond.Body(searchBody("2024-01-01T00:00:00Z", "LTE", "2024-01-03T00:00:00Z", "")),
+					},
+					Then: mockserver.Response(http.StatusOK, responseOverLimit),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/crm/v3/objects/contacts/search"),
+						mockcond.Body(searchBody("2024-01-01T00:00:00Z", "LT", "2024-01-02T00:00:00Z", "")),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFirstHalf),
+				}},
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: common.NextPageToken(secondWindow),
+				Done:     false,
+			},
+		},
+		{
+			Name: "Next window is read with the inclusive until bound",
+			Input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				NextPage:   common.NextPageToken(secondWindow),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/crm/v3/objects/contacts/search"),
+					mockcond.Body(searchBody("2024-01-02T00:00:00Z", "LTE", "2024-01-03T00:00:00Z", "")),
+				},
+				Then: mockserver.Response(http.StatusOK, responseSecondHalf),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				NextPage: `{"windows":[{"since":"2024-01-02T00:00:00Z","until":"2024-01-03T00:00:00Z"}],` +
+					`"after":"200"}`,
+				Done: false,
+			},
+		},
+		{
+			Name: "Last page of the last window completes the read",
+			Input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				NextPage: `{"windows":[{"since":"2024-01-02T00:00:00Z","until":"2024-01-03T00:00:00Z"}],` +
+					`"after":"200"}`,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/crm/v3/objects/contacts/search"),
+					mockcond.Body(searchBody("2024-01-02T00:00:00Z", "LTE", "2024-01-03T00:00:00Z", "200")),
+				},
+				Then: mockserver.Response(http.StatusOK, responseSecondHalfLastPage),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     1,
+				NextPage: "",
+				Done:     true,
+			},
+		},
+		{
+			Name: "Window which cannot be split keeps paging up to the results limit",
+			Input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:      time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/crm/v3/objects/contacts/search"),
+					mockcond.Body(searchBody("2024-01-01T00:00:00Z", "LTE", "2024-01-01T00:00:01Z", "")),
+				},
+				Then: mockserver.Response(http.StatusOK, responseOverLimit),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     1,
+				NextPage: "1",
+				Done:     false,
+			},
+		},
+		{
+			Name: "Malformed window token is rejected",
+			Input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				NextPage:   `{"windows":[]}`,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrNextPageInvalid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
 type (
 	SearchViaReadType = testconn.TestCase[SearchParams, *common.ReadResult]
 	SearchViaRead     SearchViaReadType
@@ -0,0 +1,521 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/go-playground/validator"
+)
+
+//nolint:lll
+/*
+Webhooks belong to the public app rather than to a portal. The target URL and every subscription
+apply to all portals which installed the app, and HubSpot allows a single subscription per event type and property.
+Therefore, subscriptions already present on the app are reused instead of being created again.
+As a consequence, any other connection of the app may rely on a subscription, and HubSpot offers no way
+to keep track of which ones do. Subscriptions are therefore never deleted once subscribed,
+neither by UpdateSubscription nor by DeleteSubscription, events of unsubscribed portals are to be
+ignored by the receiver. Unused subscriptions can be removed by the app owner in the developer portal.
+
+Docs: https://developers.hubspot.com/docs/guides/api/app-management/webhooks
+*/
+
+// defaultMaxConcurrentRequests is the delivery throttling of the target URL when none is requested.
+// HubSpot requires at least 5 concurrent requests.
+const defaultMaxConcurrentRequests = 10
+
+var _ connectors.SubscribeConnector = &Connector{}
+
+var (
+	errMissingParams                = errors.New("missing required parameters")
+	errInvalidRequestType           = errors.New("invalid request type")
+	errUnsupportedSubscriptionEvent = errors.New("subscription event is not supported")
+	errWatchFieldsRequired          = errors.New("property changes require a list of watched fields")
+)
+
+// webhookObjects have event types of their own, e.g. "contact.creation".
+var webhookObjects = datautils.NewStringSet( // nolint:gochecknoglobals
+	"company", "contact", "deal", "ticket", "product", "line_item",
+)
+
+// webhookAssociationObjects support the "associationChange" event type.
+var webhookAssociationObjects = datautils.NewStringSet( // nolint:gochecknoglobals
+	"company", "contact", "deal", "ticket", "line_item",
+)
+
+// SubscriptionRequest configures the webhooks of the public app which the connection is installed with.
+type SubscriptionRequest struct {
+	AppID string `json:"appId" validate:"required"`
+	// DeveloperAPIKey belongs to the developer account of the app.
+	// The webhooks API doesn't accept OAuth access tokens.
+	DeveloperAPIKey string `json:"developerApiKey" validate:"required"`
+	// TargetURL receives the events of every subscription of the app.
+	TargetURL string `json:"targetUrl" validate:"required"`
+	// MaxConcurrentRequests throttles deliveries to the target URL. Defaults to 10.
+	MaxConcurrentRequests int `json:"maxConcurrentRequests,omitempty"`
+}
+
+// SubscriptionResult holds the webhook settings of the app and the subscriptions created on behalf of the connection.
+// Subscriptions which were already present on the app are not included.
+type SubscriptionResult struct {
+	AppID         string                `json:"appId"`
+	Settings      *WebhookSettings      `json:"settings,omitempty"`
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+// WebhookSettings of the app.
+// https://developers.hubspot.com/docs/guides/api/app-management/webhooks#webhook-settings
+type WebhookSettings struct {
+	TargetURL  string            `json:"targetUrl"`
+	Throttling WebhookThrottling `json:"throttling"`
+	CreatedAt  string            `json:"createdAt,omitempty"`
+	UpdatedAt  string            `json:"updatedAt,omitempty"`
+}
+
+type WebhookThrottling struct {
+	MaxConcurrentRequests int    `json:"maxConcurrentRequests"`
+	Period                string `json:"period,omitempty"`
+}
+
+// WebhookSubscription is a single event type of the app,
+// property changes are subscribed separately for every property.
+// https://developers.hubspot.com/docs/guides/api/app-management/webhooks#webhook-subscriptions
+type WebhookSubscription struct {
+	ID           string `json:"id,omitempty"`
+	EventType    string `json:"eventType"`
+	PropertyName string `json:"propertyName,omitempty"`
+	Active       bool   `json:"active"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+	UpdatedAt    string `json:"updatedAt,omitempty"`
+}
+
+type webhookSubscriptionsResponse struct {
+	Results []WebhookSubscription `json:"results"`
+}
+
+func (s WebhookSubscription) matches(other WebhookSubscription) bool {
+	return s.EventType == other.EventType && s.PropertyName == other.PropertyName
+}
+
+func (c *Connector) EmptySubscriptionParams() *common.SubscribeParams {
+	return &common.SubscribeParams{
+		Request: &SubscriptionRequest{},
+	}
+}
+
+func (c *Connector) EmptySubscriptionResult() *common.SubscriptionResult {
+	return &common.SubscriptionResult{
+		Result: &SubscriptionResult{},
+	}
+}
+
+// Subscribe points the app webhooks to the target URL and subscribes to the events of every object.
+// Events map to HubSpot event types as follows:
+//
+//	create            -> contact.creation
+//	delete            -> contact.deletion
+//	update            -> contact.propertyChange, one subscription per watched field
+//	associationUpdate -> contact.associationChange
+//
+// Pass-through events, such as "contact.merge", are subscribed as they are.
+// Only the subscriptions created by this call are returned.
+// If any subscription fails, those created by this very call are deleted, no other connection relies on them yet.
+func (c *Connector) Subscribe(
+	ctx context.Context,
+	params common.SubscribeParams,
+) (*common.SubscriptionResult, error) {
+	req, err := validateSubscriptionRequest(params)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted, err := buildWebhookSubscriptions(params.SubscriptionEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := c.putWebhookSettings(ctx, req)
+	if err != nil {
+		return &common.SubscriptionResult{
+			Status: common.SubscriptionStatusFailed,
+		}, fmt.Errorf("failed to update webhook settings: %w", err)
+	}
+
+	_, created, err := c.ensureWebhookSubscriptions(ctx, req.AppID, req.DeveloperAPIKey, wanted)
+	if err != nil {
+		if rollbackErr := c.deleteWebhookSubscriptions(ctx, req.AppID, req.DeveloperAPIKey, created); rollbackErr != nil {
+			return &common.SubscriptionResult{
+				Status: common.SubscriptionStatusFailedToRollback,
+			}, errors.Join(rollbackErr, err)
+		}
+
+		return &common.SubscriptionResult{
+			Status: common.SubscriptionStatusFailed,
+		}, err
+	}
+
+	return &common.SubscriptionResult{
+		Result: &SubscriptionResult{
+			AppID:         req.AppID,
+			Settings:      settings,
+			Subscriptions: created,
+		},
+		ObjectEvents: params.SubscriptionEvents,
+		Status:       common.SubscriptionStatusSuccess,
+	}, nil
+}
+
+// UpdateSubscription subscribes to the requested events which are missing from the app.
+// Subscriptions which are no longer requested are kept, other connections of the app may rely on them.
+// The result keeps the subscriptions created on behalf of the connection, whether by this call or earlier ones.
+func (c *Connector) UpdateSubscription(
+	ctx context.Context,
+	params common.SubscribeParams,
+	previousResult *common.SubscriptionResult,
+) (*common.SubscriptionResult, error) {
+	req, err := validateSubscriptionRequest(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousResult == nil {
+		return nil, fmt.Errorf("%w: previous subscription result cannot be nil", errMissingParams)
+	}
+
+	previous, err := assertSubscriptionResult(*previousResult)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted, err := buildWebhookSubscriptions(params.SubscriptionEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := c.putWebhookSettings(ctx, req)
+	if err != nil {
+		return &common.SubscriptionResult{
+			Status: common.SubscriptionStatusFailed,
+		}, fmt.Errorf("failed to update webhook settings: %w", err)
+	}
+
+	subscriptions, created, err := c.ensureWebhookSubscriptions(ctx, req.AppID, req.DeveloperAPIKey, wanted)
+	if err != nil {
+		return &common.SubscriptionResult{
+			Status: common.SubscriptionStatusFailed,
+		}, err
+	}
+
+	owned := slices.Clone(previous.Subscriptions)
+
+	// Previously created subscriptions which are still requested are refreshed from the app.
+	for index, subscription := range owned {
+		if current := slices.IndexFunc(subscriptions, subscription.matches); current != -1 {
+			owned[index] = subscriptions[current]
+		}
+	}
+
+	for _, subscription := range created {
+		if !slices.ContainsFunc(owned, subscription.matches) {
+			owned = append(owned, subscription)
+		}
+	}
+
+	return &common.SubscriptionResult{
+		Result: &SubscriptionResult{
+			AppID:         req.AppID,
+			Settings:      settings,
+			Subscriptions: owned,
+		},
+		ObjectEvents: params.SubscriptionEvents,
+		Status:       common.SubscriptionStatusSuccess,
+	}, nil
+}
+
+// DeleteSubscription validates the result, but leaves the subscriptions and the target URL of the app in place.
+// Both are shared by all portals of the app, other connections may rely on any of them.
+func (c *Connector) DeleteSubscription(
+	_ context.Context,
+	previousResult common.SubscriptionResult,
+) error {
+	_, err := assertSubscriptionResult(previousResult)
+
+	return err
+}
+
+// ensureWebhookSubscriptions returns the wanted subscriptions, reusing those already present on the app.
+// Inactive subscriptions are activated, the rest are created. Newly created subscriptions
+// are also returned separately, so that they can be rolled back.
+func (c *Connector) ensureWebhookSubscriptions(
+	ctx context.Context, appID, apiKey string, wanted []WebhookSubscription,
+) (subscriptions []WebhookSubscription, created []WebhookSubscription, err error) {
+	existing, err := c.listWebhookSubscriptions(ctx, appID, apiKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	subscriptions = make([]WebhookSubscription, 0, len(wanted))
+	created = make([]WebhookSubscription, 0)
+
+	for _, subscription := range wanted {
+		index := slices.IndexFunc(existing, subscription.matches)
+
+		switch {
+		case index == -1:
+			subscription, err = c.createWebhookSubscription(ctx, appID, apiKey, subscription)
+			if err != nil {
+				return nil, created, fmt.Errorf("failed to subscribe to %s: %w", subscription.EventType, err)
+			}
+
+			created = append(created, subscription)
+		case !existing[index].Active:
+			subscription, err = c.activateWebhookSubscription(ctx, appID, apiKey, existing[index].ID)
+			if err != nil {
+				return nil, created, fmt.Errorf("failed to activate %s: %w", existing[index].EventType, err)
+			}
+		default:
+			subscription = existing[index]
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, created, nil
+}
+
+func (c *Connector) putWebhookSettings(ctx context.Context, req *SubscriptionRequest) (*WebhookSettings, error) {
+	url, err := c.getWebhookSettingsURL(req.AppID, req.DeveloperAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrentRequests := req.MaxConcurrentRequests
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = defaultMaxConcurrentRequests
+	}
+
+	rsp, err := c.JSONHTTPClient().Put(ctx, url.String(), &WebhookSettings{
+		TargetURL: req.TargetURL,
+		Throttling: WebhookThrottling{
+			MaxConcurrentRequests: maxConcurrentRequests,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return common.UnmarshalJSON[WebhookSettings](rsp)
+}
+
+func (c *Connector) listWebhookSubscriptions(
+	ctx context.Context, appID, apiKey string,
+) ([]WebhookSubscription, error) {
+	url, err := c.getWebhookSubscriptionsURL(appID, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.UnmarshalJSON[webhookSubscriptionsResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, nil
+	}
+
+	return response.Results, nil
+}
+
+func (c *Connector) createWebhookSubscription(
+	ctx context.Context, appID, apiKey string, subscription WebhookSubscription,
+) (WebhookSubscription, error) {
+	url, err := c.getWebhookSubscriptionsURL(appID, apiKey)
+	if err != nil {
+		return subscription, err
+	}
+
+	rsp, err := c.JSONHTTPClient().Post(ctx, url.String(), subscription)
+	if err != nil {
+		return subscription, err
+	}
+
+	response, err := common.UnmarshalJSON[WebhookSubscription](rsp)
+	if err != nil {
+		return subscription, err
+	}
+
+	if response == nil {
+		return subscription, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return *response, nil
+}
+
+func (c *Connector) activateWebhookSubscription(
+	ctx context.Context, appID, apiKey, subscriptionID string,
+) (WebhookSubscription, error) {
+	url, err := c.getWebhookSubscriptionsURL(appID, apiKey, subscriptionID)
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	rsp, err := c.JSONHTTPClient().Patch(ctx, url.String(), map[string]any{"active": true})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	response, err := common.UnmarshalJSON[WebhookSubscription](rsp)
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	if response == nil {
+		return WebhookSubscription{}, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return *response, nil
+}
+
+// deleteWebhookSubscriptions deletes every subscription, those which are already gone are skipped.
+// It is only used to roll back subscriptions created by a failed call.
+func (c *Connector) deleteWebhookSubscriptions(
+	ctx context.Context, appID, apiKey string, subscriptions []WebhookSubscription,
+) error {
+	var errs error
+
+	for _, subscription := range subscriptions {
+		url, err := c.getWebhookSubscriptionsURL(appID, apiKey, subscription.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.JSONHTTPClient().Delete(ctx, url.String())
+		if err != nil {
+			var httpErr *common.HTTPError
+			if errors.As(err, &httpErr) && httpErr.Status == http.StatusNotFound {
+				continue
+			}
+
+			logging.Logger(ctx).Error("failed to delete webhook subscription",
+				"subscriptionId", subscription.ID, "eventType", subscription.EventType, "error", err)
+
+			errs = errors.Join(errs, fmt.Errorf("failed to delete subscription %s (id: %s): %w",
+				subscription.EventType, subscription.ID, err))
+		}
+	}
+
+	return errs
+}
+
+// buildWebhookSubscriptions translates object events into HubSpot subscriptions, in a stable order.
+func buildWebhookSubscriptions(
+	subscriptionEvents map[common.ObjectName]common.ObjectEvents,
+) ([]WebhookSubscription, error) {
+	if len(subscriptionEvents) == 0 {
+		return nil, fmt.Errorf("%w: no events to subscribe to", errMissingParams)
+	}
+
+	subscriptions := make([]WebhookSubscription, 0)
+
+	add := func(eventType, propertyName string) {
+		subscription := WebhookSubscription{
+			EventType:    eventType,
+			PropertyName: propertyName,
+			Active:       true,
+		}
+
+		if !slices.ContainsFunc(subscriptions, subscription.matches) {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	for _, objectName := range slices.Sorted(maps.Keys(subscriptionEvents)) {
+		objectEvents := subscriptionEvents[objectName]
+
+		// Event types use singular object names, e.g. "contacts" subscribe to "contact.creation".
+		object := naming.NewSingularString(string(objectName)).String()
+		if !webhookObjects.Has(object) {
+			return nil, fmt.Errorf("%w: %s", common.ErrObjectNotSupported, objectName)
+		}
+
+		for _, event := range objectEvents.Events {
+			switch event {
+			case common.SubscriptionEventTypeCreate:
+				add(object+".creation", "")
+			case common.SubscriptionEventTypeDelete:
+				add(object+".deletion", "")
+			case common.SubscriptionEventTypeUpdate:
+				if objectEvents.WatchFieldsAll || len(objectEvents.WatchFields) == 0 {
+					return nil, fmt.Errorf("%w: %s", errWatchFieldsRequired, objectName)
+				}
+
+				for _, field := range objectEvents.WatchFields {
+					add(object+".propertyChange", field)
+				}
+			case common.SubscriptionEventTypeAssociationUpdate:
+				if !webhookAssociationObjects.Has(object) {
+					return nil, fmt.Errorf("%w: %s for %s", errUnsupportedSubscriptionEvent, event, objectName)
+				}
+
+				add(object+".associationChange", "")
+			default:
+				return nil, fmt.Errorf("%w: %s for %s", errUnsupportedSubscriptionEvent, event, objectName)
+			}
+		}
+
+		for _, eventType := range objectEvents.PassThroughEvents {
+			add(eventType, "")
+		}
+	}
+
+	return subscriptions, nil
+}
+
+func validateSubscriptionRequest(params common.SubscribeParams) (*SubscriptionRequest, error) {
+	if params.Request == nil {
+		return nil, fmt.Errorf("%w: request is nil", errMissingParams)
+	}
+
+	req, ok := params.Request.(*SubscriptionRequest)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected '%T' got '%T'", errInvalidRequestType, req, params.Request)
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %w", errMissingParams, err)
+	}
+
+	return req, nil
+}
+
+func assertSubscriptionResult(result common.SubscriptionResult) (*SubscriptionResult, error) {
+	if result.Result == nil {
+		return nil, fmt.Errorf("%w: Result cannot be nil", errMissingParams)
+	}
+
+	subscriptionResult, ok := result.Result.(*SubscriptionResult)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected '%T' got '%T'", errInvalidRequestType, subscriptionResult, result.Result)
+	}
+
+	if subscriptionResult.AppID == "" {
+		return nil, fmt.Errorf("%w: app id is required", errMissingParams)
+	}
+
+	return subscriptionResult, nil
+}
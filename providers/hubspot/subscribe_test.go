@@ -0,0 +1,390 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestSubscribe(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseSettings := testutils.DataFromFile(t, "subscribe/settings.json")
+	responseExisting := testutils.DataFromFile(t, "subscribe/subscriptions-existing.json")
+	responseContactCreation := testutils.DataFromFile(t, "subscribe/subscription-contact-creation.json")
+	responseContactEmail := testutils.DataFromFile(t, "subscribe/subscription-contact-email.json")
+	responseInvalidProperty := testutils.DataFromFile(t, "subscribe/error-invalid-property.json")
+
+	request := &SubscriptionRequest{
+		AppID:           "123",
+		DeveloperAPIKey: "dev-key",
+		TargetURL:       "https://webhooks.example.com/hubspot",
+	}
+
+	objectEvents := map[common.ObjectName]common.ObjectEvents{
+		"contacts": {
+			Events: []common.SubscriptionEventType{
+				common.SubscriptionEventTypeCreate,
+				common.SubscriptionEventTypeUpdate,
+			},
+			WatchFields: []string{"email", "phone"},
+		},
+		"companies": {
+			Events: []common.SubscriptionEventType{common.SubscriptionEventTypeAssociationUpdate},
+		},
+	}
+
+	settings := &WebhookSettings{
+		TargetURL: "https://webhooks.example.com/hubspot",
+		Throttling: WebhookThrottling{
+			MaxConcurrentRequests: 10,
+			Period:                "SECONDLY",
+		},
+		CreatedAt: "2026-10-01T10:00:00.000Z",
+		UpdatedAt: "2026-10-19T09:30:00.000Z",
+	}
+
+	appSettings := mockcond.And{
+		mockcond.MethodPUT(),
+		mockcond.Path("/webhooks/v3/123/settings"),
+		mockcond.QueryParam("hapikey", "dev-key"),
+		mockcond.Body(`{"targetUrl":"https://webhooks.example.com/hubspot","throttling":{"maxConcurrentRequests":10}}`),
+	}
+
+	appSubscriptions := mockcond.And{
+		mockcond.MethodGET(),
+		mockcond.Path("/webhooks/v3/123/subscriptions"),
+		mockcond.QueryParam("hapikey", "dev-key"),
+	}
+
+	tests := []subscribeTestCase{
+		{
+			Name:         "Request is required",
+			Input:        common.SubscribeParams{SubscriptionEvents: objectEvents},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{errMissingParams},
+		},
+		{
+			Name: "Developer API key is required",
+			Input: common.SubscribeParams{
+				Request: &SubscriptionRequest{
+					AppID:     "123",
+					TargetURL: "https://webhooks.example.com/hubspot",
+				},
+				SubscriptionEvents: objectEvents,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{errMissingParams},
+		},
+		{
+			Name: "Property changes require watched fields",
+			Input: common.SubscribeParams{
+				Request: request,
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"contacts": {
+						Events:         []common.SubscriptionEventType{common.SubscriptionEventTypeUpdate},
+						WatchFieldsAll: true,
+					},
+				},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{errWatchFieldsRequired},
+		},
+		{
+			Name: "Object without webhook events is not supported",
+			Input: common.SubscribeParams{
+				Request: request,
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"calls": {Events: []common.SubscriptionEventType{common.SubscriptionEventTypeCreate}},
+				},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrObjectNotSupported},
+		},
+		{
+			Name: "Products have no association events",
+			Input: common.SubscribeParams{
+				Request: request,
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"products": {Events: []common.SubscriptionEventType{common.SubscriptionEventTypeAssociationUpdate}},
+				},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{errUnsupportedSubscriptionEvent},
+		},
+		{
+			Name:  "Missing subscriptions are created, existing ones are reused but not kept",
+			Input: common.SubscribeParams{Request: request, SubscriptionEvents: objectEvents},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If:   appSettings,
+					Then: mockserver.Response(http.StatusOK, responseSettings),
+				}, {
+					If:   appSubscriptions,
+					Then: mockserver.Response(http.StatusOK, responseExisting),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/webhooks/v3/123/subscriptions"),
+						mockcond.Body(`{"eventType":"contact.creation","active":true}`),
+					},
+					Then: mockserver.Response(http.StatusCreated, responseContactCreation),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/webhooks/v3/123/subscriptions"),
+						mockcond.Body(`{"eventType":"contact.propertyChange","propertyName":"email","active":true}`),
+					},
+					Then: mockserver.Response(http.StatusCreated, responseContactEmail),
+				}},
+			}.Server(),
+			Expected: &common.SubscriptionResult{
+				Result: &SubscriptionResult{
+					AppID:    "123",
+					Settings: settings,
+					Subscriptions: []WebhookSubscription{{
+						ID:        "1",
+						EventType: "contact.creation",
+						Active:    true,
+						CreatedAt: "2026-10-19T09:30:01.000Z",
+						UpdatedAt: "2026-10-19T09:30:01.000Z",
+					}, {
+						ID:           "2",
+						EventType:    "contact.propertyChange",
+						PropertyName: "email",
+						Active:       true,
+						CreatedAt:    "2026-10-19T09:30:02.000Z",
+						UpdatedAt:    "2026-10-19T09:30:02.000Z",
+					}},
+				},
+				ObjectEvents: objectEvents,
+				Status:       common.SubscriptionStatusSuccess,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Created subscriptions are rolled back on failure",
+			Input: common.SubscribeParams{
+				Request: request,
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"contacts": {
+						Events: []common.SubscriptionEventType{
+							common.SubscriptionEventTypeCreate,
+							common.SubscriptionEventTypeUpdate,
+						},
+						WatchFields: []string{"emial"},
+					},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: mockserver.Cases{{
+					If:   appSettings,
+					Then: mockserver.Response(http.StatusOK, responseSettings),
+				}, {
+					If:   appSubscriptions,
+					Then: mockserver.Response(http.StatusOK, []byte(`{"results":[]}`)),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Body(`{"eventType":"contact.creation","active":true}`),
+					},
+					Then: mockserver.Response(http.StatusCreated, responseContactCreation),
+				}, {
+					If:   mockcond.MethodPOST(),
+					Then: mockserver.Response(http.StatusBadRequest, responseInvalidProperty),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodDELETE(),
+						mockcond.Path("/webhooks/v3/123/subscriptions/1"),
+						mockcond.QueryParam("hapikey", "dev-key"),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.SubscriptionResult{
+				Status: common.SubscriptionStatusFailed,
+			},
+			ExpectedErrs: []error{common.ErrBadRequest},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestUpdateSubscription(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseSettings := testutils.DataFromFile(t, "subscribe/settings.json")
+	responseInactive := testutils.DataFromFile(t, "subscribe/subscriptions-inactive.json")
+	responseContactEmail := testutils.DataFromFile(t, "subscribe/subscription-contact-email.json")
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: mockserver.Cases{{
+			If:   mockcond.And{mockcond.MethodPUT(), mockcond.Path("/webhooks/v3/123/settings")},
+			Then: mockserver.Response(http.StatusOK, responseSettings),
+		}, {
+			If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/webhooks/v3/123/subscriptions")},
+			Then: mockserver.Response(http.StatusOK, responseInactive),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodPATCH(),
+				mockcond.Path("/webhooks/v3/123/subscriptions/2"),
+				mockcond.Body(`{"active":true}`),
+			},
+			Then: mockserver.Response(http.StatusOK, responseContactEmail),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodPOST(),
+				mockcond.Path("/webhooks/v3/123/subscriptions"),
+				mockcond.Body(`{"eventType":"contact.propertyChange","propertyName":"phone","active":true}`),
+			},
+			Then: mockserver.ResponseString(http.StatusCreated,
+				`{"id":"6","eventType":"contact.propertyChange","propertyName":"phone","active":true}`),
+		}},
+	}.Server()
+
+	t.Cleanup(server.Close)
+
+	conn, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to construct test connector: %v", err)
+	}
+
+	previousResult := &common.SubscriptionResult{
+		Result: &SubscriptionResult{
+			AppID: "123",
+			Subscriptions: []WebhookSubscription{
+				{ID: "1", EventType: "contact.creation", Active: true},
+				{ID: "4", EventType: "contact.deletion", Active: true},
+			},
+		},
+	}
+
+	params := common.SubscribeParams{
+		Request: &SubscriptionRequest{
+			AppID:           "123",
+			DeveloperAPIKey: "dev-key",
+			TargetURL:       "https://webhooks.example.com/hubspot",
+		},
+		SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+			"contacts": {
+				Events: []common.SubscriptionEventType{
+					common.SubscriptionEventTypeCreate,
+					common.SubscriptionEventTypeUpdate,
+				},
+				WatchFields: []string{"email", "phone"},
+			},
+		},
+	}
+
+	result, err := conn.UpdateSubscription(t.Context(), params, previousResult)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Status != common.SubscriptionStatusSuccess {
+		t.Fatalf("expected status %q, got %q", common.SubscriptionStatusSuccess, result.Status)
+	}
+
+	updated, ok := result.Result.(*SubscriptionResult)
+	if !ok {
+		t.Fatalf("expected *SubscriptionResult, got %T", result.Result)
+	}
+
+	ids := make([]string, len(updated.Subscriptions))
+	for index, subscription := range updated.Subscriptions {
+		ids[index] = subscription.ID
+
+		if !subscription.Active {
+			t.Errorf("expected subscription %s to be active", subscription.ID)
+		}
+	}
+
+	// The activated subscription already existed on the app, so it isn't kept.
+	// The deletion subscription is no longer requested, but other connections may rely on it.
+	testutils.CheckOutput(t, "Subscription ids", []string{"1", "4", "6"}, ids)
+
+	if _, err := conn.UpdateSubscription(t.Context(), params, nil); err == nil {
+		t.Fatal("expected error for nil previous result, got nil")
+	}
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Parallel()
+
+	tests := []testconn.TestCase[common.SubscriptionResult, error]{
+		{
+			Name:         "Result is required",
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{errMissingParams},
+		},
+		{
+			Name: "Subscriptions shared by the app are left in place",
+			Input: common.SubscriptionResult{
+				Result: &SubscriptionResult{
+					AppID: "123",
+					Subscriptions: []WebhookSubscription{
+						{ID: "1", EventType: "contact.creation", Active: true},
+						{ID: "2", EventType: "contact.propertyChange", PropertyName: "email", Active: true},
+					},
+				},
+			},
+			// Any request to the dummy server fails.
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Cleanup(func() {
+				tt.Close()
+			})
+
+			conn, err := constructTestConnector(tt.Server.URL)
+			if err != nil {
+				t.Fatalf("failed to construct test connector: %v", err)
+			}
+
+			err = conn.DeleteSubscription(t.Context(), tt.Input)
+
+			tt.Validate(t, err, nil)
+		})
+	}
+}
+
+type (
+	testCaseTypeSubscribe = testconn.TestCase[common.SubscribeParams, *common.SubscriptionResult]
+	subscribeTestCase     testCaseTypeSubscribe
+)
+
+func (c subscribeTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeSubscribe(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.Subscribe(t.Context(), c.Input)
+	testCaseTypeSubscribe(c).Validate(t, err, output)
+}
@@ -94,6 +94,11 @@ type Filter struct {
 	FieldName string             `json:"propertyName,omitempty"`
 	Operator  FilterOperatorType `json:"operator,omitempty"`
 	Value     string             `json:"value,omitempty"`
+	Values    []string           `json:"values,omitempty"`
+}
+
+func (f Filter) isEmpty() bool {
+	return f.FieldName == "" && f.Operator == "" && f.Value == "" && len(f.Values) == 0
 }
 
 type (
@@ -0,0 +1,128 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/core"
+)
+
+var _ connectors.UpsertConnector = &Connector{}
+
+// SupportsUpsert reports whether Write honors common.WriteParams.UpsertKey.
+// Objects of the Objects API are upserted by a property with unique values, and contacts also by email.
+// The property is looked up, objects without properties are not part of the Objects API.
+func (c *Connector) SupportsUpsert(ctx context.Context, objectName string, field string) (bool, error) {
+	if objectName == core.ObjectContacts && field == "email" {
+		return true, nil
+	}
+
+	url, err := c.getCRMPropertyURL(objectName, field)
+	if err != nil {
+		return false, err
+	}
+
+	rsp, err := c.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		var httpErr *common.HTTPError
+		if errors.As(err, &httpErr) && httpErr.Status == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	property, err := common.UnmarshalJSON[propertyResponse](rsp)
+	if err != nil {
+		return false, err
+	}
+
+	return property.HasUniqueValue, nil
+}
+
+type propertyResponse struct {
+	HasUniqueValue bool `json:"hasUniqueValue"`
+}
+
+type upsertPayload struct {
+	Inputs []upsertInput `json:"inputs"`
+}
+
+type upsertInput struct {
+	IDProperty string `json:"idProperty"`
+	ID         string `json:"id"`
+	Properties any    `json:"properties"`
+}
+
+type upsertResponse struct {
+	Results []upsertResult `json:"results"`
+	Errors  []any          `json:"errors"`
+}
+
+type upsertResult struct {
+	writeResponse
+
+	// New is true when the record was created.
+	New bool `json:"new"`
+}
+
+// upsert writes a single record through the batch upsert endpoint, which is the only
+// endpoint matching records by a unique property.
+func (c *Connector) upsert(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
+	if config.Associations != nil {
+		return nil, fmt.Errorf("%w: associations cannot be written by an upsert", common.ErrOperationNotSupportedForObject)
+	}
+
+	url, err := c.getCRMObjectsUpsertURL(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	json, err := c.JSONHTTPClient().Post(ctx, url.String(), upsertPayload{
+		Inputs: []upsertInput{{
+			IDProperty: config.UpsertKey.Field,
+			ID:         fmt.Sprint(config.UpsertKey.Value),
+			Properties: config.RecordData,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := common.UnmarshalJSON[upsertResponse](json)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rsp.Results) == 0 {
+		// Record level failures are reported with the 207 Multi-Status code.
+		return &common.WriteResult{
+			Success: false,
+			Errors:  rsp.Errors,
+		}, nil
+	}
+
+	result := rsp.Results[0]
+
+	record, err := datautils.StructToMap(result.writeResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := common.WriteOutcomeUpdated
+	if result.New {
+		outcome = common.WriteOutcomeCreated
+	}
+
+	return &common.WriteResult{
+		RecordId: result.ID,
+		Success:  true,
+		Data:     record,
+		Outcome:  outcome,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestUpsert(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseCreated := testutils.DataFromFile(t, "upsert/contacts/created.json")
+	responseUpdated := testutils.DataFromFile(t, "upsert/contacts/updated.json")
+
+	byEmail := &common.UpsertKey{Field: "email", Value: "Markus.Blevins@hubspot.com"}
+
+	tests := []testconn.TestCaseWrite{
+		{
+			Name: "Associations cannot be upserted",
+			Input: common.WriteParams{
+				ObjectName:   "contacts",
+				RecordData:   map[string]any{"firstname": "Markus"},
+				UpsertKey:    byEmail,
+				Associations: []any{},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name: "Upsert creates a contact",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"firstname": "Markus", "lastname": "Blevins"},
+				UpsertKey:  byEmail,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/upsert"),
+					mockcond.Body(`{"inputs":[{"idProperty":"email","id":"Markus.Blevins@hubspot.com",
+						"properties":{"firstname":"Markus","lastname":"Blevins"}}]}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseCreated),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "151",
+				Data: map[string]any{
+					"id": "151",
+				},
+				Outcome: common.WriteOutcomeCreated,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Upsert updates a contact",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"firstname": "Marcus"},
+				UpsertKey:  byEmail,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/upsert"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseUpdated),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "151",
+				Data: map[string]any{
+					"id": "151",
+				},
+				Outcome: common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (testconn.TestableWriter, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestSupportsUpsert(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []supportsUpsertTestCase{
+		{
+			Name:     "Contacts are upserted by email",
+			Input:    upsertKeyField{objectName: "contacts", field: "email"},
+			Server:   mockserver.Dummy(),
+			Expected: true,
+		},
+		{
+			Name:  "Property with unique values is an upsert key",
+			Input: upsertKeyField{objectName: "companies", field: "company_code"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/crm/v3/properties/companies/company_code"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"name":"company_code","hasUniqueValue":true}`),
+			}.Server(),
+			Expected: true,
+		},
+		{
+			Name:  "Property without unique values is not an upsert key",
+			Input: upsertKeyField{objectName: "companies", field: "domain"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/crm/v3/properties/companies/domain"),
+				Then:  mockserver.ResponseString(http.StatusOK, `{"name":"domain","hasUniqueValue":false}`),
+			}.Server(),
+			Expected: false,
+		},
+		{
+			Name:  "Object without properties cannot be upserted",
+			Input: upsertKeyField{objectName: "lists", field: "name"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/crm/v3/properties/lists/name"),
+				Then: mockserver.ResponseString(http.StatusNotFound,
+					`{"status":"error","message":"Unable to infer object type from: lists","category":"OBJECT_NOT_FOUND"}`),
+			}.Server(),
+			Expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+type upsertKeyField struct {
+	objectName string
+	field      string
+}
+
+type (
+	testCaseTypeSupportsUpsert = testconn.TestCase[upsertKeyField, bool]
+	supportsUpsertTestCase     testCaseTypeSupportsUpsert
+)
+
+func (c supportsUpsertTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeSupportsUpsert(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.SupportsUpsert(t.Context(), c.Input.objectName, c.Input.field)
+	testCaseTypeSupportsUpsert(c).Validate(t, err, output)
+}
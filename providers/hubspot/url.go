@@ -60,6 +60,16 @@ func (c *Connector) getCRMPropertiesURL(objectName string) (*urlbuilder.URL, err
 	return c.crmURL(core.APIVersion3, "properties", objectName, "/")
 }
 
+// Returns the endpoint of a single property of an object.
+//
+// Used by SupportsUpsert to tell whether the property has unique values.
+// Output: propertyResponse.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/properties/get-property
+func (c *Connector) getCRMPropertyURL(objectName, propertyName string) (*urlbuilder.URL, error) {
+	return c.crmURL(core.APIVersion3, "properties", objectName, propertyName)
+}
+
 // Returns the base HubSpot Objects API endpoint for CRUD operations.
 //
 // This URL shape is shared by CRUD operations.
@@ -74,6 +84,26 @@ func (c *Connector) getCRMObjectsURL(objectName string) (*urlbuilder.URL, error)
 	return c.crmURL(core.APIVersion3, "objects", objectName)
 }
 
+// Returns the batch upsert endpoint for the HubSpot Objects API.
+//
+// Used by Write when records are matched by a unique property.
+// Output: upsertResponse.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/contacts/batch/upsert-contacts
+func (c *Connector) getCRMObjectsUpsertURL(objectName string) (*urlbuilder.URL, error) {
+	return c.crmURL(core.APIVersion3, "objects", objectName, "batch", "upsert")
+}
+
+// Returns the merge endpoint for the HubSpot Objects API.
+//
+// Used by Merge, one record is merged into the primary per request.
+// Output: writeResponse.
+//
+// https://developers.hubspot.com/docs/api-reference/latest/crm/objects/contacts/merge-contacts
+func (c *Connector) getCRMObjectsMergeURL(objectName string) (*urlbuilder.URL, error) {
+	return c.crmURL(core.APIVersion3, "objects", objectName, "merge")
+}
+
 // Returns the delete endpoint for the HubSpot Objects API.
 //
 // TODO: replace this helper with getCRMObjectsURL once getCRMObjectsURL is migrated to APIVersion2026March.
@@ -147,6 +177,36 @@ func (c *Connector) getEventOccurrencesURL() (*urlbuilder.URL, error) {
 	return c.rootURL("events/event-occurrences", core.APIVersion2026March)
 }
 
+// Returns the webhook settings endpoint of the app, authorized by the developer API key.
+//
+// https://developers.hubspot.com/docs/guides/api/app-management/webhooks#webhook-settings
+func (c *Connector) getWebhookSettingsURL(appID, developerAPIKey string) (*urlbuilder.URL, error) {
+	url, err := c.rootURL("webhooks", core.APIVersion3, appID, "settings")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("hapikey", developerAPIKey)
+
+	return url, nil
+}
+
+// Returns the webhook subscriptions endpoint of the app, or of a single subscription when its id is given.
+//
+// https://developers.hubspot.com/docs/guides/api/app-management/webhooks#webhook-subscriptions
+func (c *Connector) getWebhookSubscriptionsURL(
+	appID, developerAPIKey string, subscriptionID ...string,
+) (*urlbuilder.URL, error) {
+	url, err := c.rootURL(append([]string{"webhooks", core.APIVersion3, appID, "subscriptions"}, subscriptionID...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("hapikey", developerAPIKey)
+
+	return url, nil
+}
+
 func (c *Connector) crmURL(paths ...string) (*urlbuilder.URL, error) {
 	parts := append([]string{"crm"}, paths...)
 
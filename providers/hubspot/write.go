@@ -21,10 +21,14 @@ type writeResponse struct {
 func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
 	ctx = logging.With(ctx, "connector", "hubspot")
 
-	if err := config.ValidateParams(); err != nil {
+	if err := config.ValidateUpsertParams(); err != nil {
 		return nil, err
 	}
 
+	if config.IsUpsert() {
+		return c.upsert(ctx, config)
+	}
+
 	var write common.WriteMethod
 
 	url, err := c.getCRMObjectsURL(config.ObjectName)
@@ -0,0 +1,503 @@
+package providers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrJwtTokenEndpoint is returned when the token endpoint rejects a JWT bearer grant.
+	ErrJwtTokenEndpoint = errors.New("JWT token endpoint error")
+	// ErrJwtKey is returned when the signing key is missing or does not match the algorithm.
+	ErrJwtKey = errors.New("invalid JWT signing key")
+)
+
+const (
+	// defaultJwtAssertionTTL keeps signed JWTs short-lived, most providers reject an exp further than a few minutes.
+	defaultJwtAssertionTTL = 180 * time.Second
+	// defaultJwtAccessTokenTTL is used when the bearer grant response has no expires_in.
+	// The 401 handler re-exchanges for providers whose sessions are shorter.
+	defaultJwtAccessTokenTTL = 30 * time.Minute
+)
+
+// JwtParams are the credentials used to sign JWTs.
+type JwtParams struct {
+	// Key is the signing key. For HS* algorithms it is the shared secret, otherwise
+	// a PEM encoded private key, which may itself be base64 encoded.
+	Key []byte
+
+	// Signer is an already parsed key, *rsa.PrivateKey or *ecdsa.PrivateKey. It takes precedence over Key.
+	Signer any
+
+	// Values are the credential values used by the Issuer and Claims templates.
+	Values map[string]string
+
+	// Claims are added as is after templated claims, and override them.
+	Claims map[string]any
+}
+
+func createJwtHTTPClient( //nolint:ireturn
+	ctx context.Context,
+	client *http.Client,
+	dbg bool,
+	unauth UnauthorizedHandler,
+	isUnauth IsUnauthorizedDecider,
+	info *ProviderInfo,
+	creds *JwtParams,
+) (common.AuthenticatedHTTPClient, error) {
+	signer, err := NewJwtSigner(info.JwtOpts, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []common.CustomAuthClientOption{common.WithCustomClient(getClient(client))}
+
+	if dbg {
+		opts = append(opts, common.WithCustomDebug(common.PrintRequestAndResponse))
+	}
+
+	if isUnauth != nil {
+		opts = append(opts, common.WithCustomIsUnauthorizedHandler(isUnauth))
+	}
+
+	var jwtClient common.AuthenticatedHTTPClient
+
+	switch info.JwtOpts.Mode {
+	case JwtBearerGrant:
+		if info.JwtOpts.TokenURL == "" {
+			return nil, fmt.Errorf("%w: token URL is required for the JWT bearer grant", ErrClient)
+		}
+
+		source := newJwtBearerSource(signer, info.JwtOpts, getClient(client))
+
+		// A token rejected before its expiry is re-exchanged once and the request replayed.
+		opts = append(opts,
+			common.WithCustomDynamicHeaders(source.headers),
+			common.WithCustomUnauthorizedHandler(source.unauthorizedHandler(getClient(client))), //nolint:bodyclose
+		)
+	case JwtSignedRequest:
+		opts = append(opts, common.WithCustomDynamicHeaders(signer.Headers))
+
+		if unauth != nil {
+			opts = append(opts, common.WithCustomUnauthorizedHandler(
+				func(
+					hdrs []common.Header, params []common.QueryParam, req *http.Request, rsp *http.Response,
+				) (*http.Response, error) {
+					return unauth(jwtClient, &UnauthorizedEvent{
+						Headers:     hdrs,
+						QueryParams: params,
+						Provider:    info,
+						Request:     req,
+						Response:    rsp,
+					})
+				}))
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported JWT mode %q", ErrClient, info.JwtOpts.Mode)
+	}
+
+	jwtClient, err = common.NewCustomAuthHTTPClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create JWT auth client: %w", ErrClient, err)
+	}
+
+	return jwtClient, nil
+}
+
+// JwtSigner signs JWTs as described by the catalog JwtOpts.
+type JwtSigner struct {
+	opts   *JwtOpts
+	method jwt.SigningMethod
+	key    any
+	issuer string
+	claims map[string]any
+	ttl    time.Duration
+
+	mutex  sync.Mutex
+	cached string
+	renew  time.Time
+}
+
+// NewJwtSigner validates the options, parses the key and evaluates claim templates.
+func NewJwtSigner(opts *JwtOpts, creds *JwtParams) (*JwtSigner, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("%w: jwt options not found", ErrClient)
+	}
+
+	if creds == nil {
+		return nil, fmt.Errorf("%w: jwt credentials not found", ErrClient)
+	}
+
+	method := jwt.GetSigningMethod(string(opts.Algorithm))
+	if method == nil || !opts.Algorithm.Valid() {
+		return nil, fmt.Errorf("%w: unsupported JWT algorithm %q", ErrClient, opts.Algorithm)
+	}
+
+	key, err := jwtSigningKey(opts.Algorithm, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := evalTemplate(opts.Issuer, creds.Values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to evaluate JWT issuer template: %w", ErrClient, err)
+	}
+
+	claims := make(map[string]any, len(opts.Claims)+len(creds.Claims))
+
+	for name, valueTemplate := range opts.Claims {
+		value, err := evalTemplate(valueTemplate, creds.Values)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to evaluate JWT claim template %q: %w", ErrClient, name, err)
+		}
+
+		claims[name] = value
+	}
+
+	maps.Copy(claims, creds.Claims)
+
+	ttl := defaultJwtAssertionTTL
+	if opts.AssertionTTL > 0 {
+		ttl = time.Duration(opts.AssertionTTL) * time.Second
+	}
+
+	return &JwtSigner{
+		opts:   opts,
+		method: method,
+		key:    key,
+		issuer: issuer,
+		claims: claims,
+		ttl:    ttl,
+	}, nil
+}
+
+// Sign returns a JWT valid from now. The request is only needed for the query string hash.
+func (s *JwtSigner) Sign(now time.Time, req *http.Request) (string, error) {
+	claims := jwt.MapClaims{
+		"exp": now.Add(s.ttl).Unix(),
+	}
+
+	if s.opts.Mode == JwtSignedRequest {
+		claims["iat"] = now.Unix()
+	}
+
+	if s.issuer != "" {
+		claims["iss"] = s.issuer
+	}
+
+	if s.opts.Audience != "" {
+		claims["aud"] = s.opts.Audience
+	}
+
+	if s.opts.QueryStringHash && req != nil {
+		claims["qsh"] = queryStringHash(req.Method, req.URL.Path, req.URL.Query())
+	}
+
+	maps.Copy(claims, s.claims)
+
+	signed, err := jwt.NewWithClaims(s.method, claims).SignedString(s.key)
+	if err != nil {
+		return "", fmt.Errorf("signing JWT: %w", err)
+	}
+
+	return signed, nil
+}
+
+// Headers is a common.DynamicHeadersGenerator for the signedRequest mode.
+// Without a query string hash the JWT does not depend on the request,
+// so it is reused until half of its lifetime has passed.
+func (s *JwtSigner) Headers(req *http.Request) ([]common.Header, error) {
+	signed, err := s.requestToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := s.opts.HeaderPrefix
+	if prefix == "" {
+		prefix = "Bearer"
+	}
+
+	return []common.Header{{Key: "Authorization", Value: prefix + " " + signed}}, nil
+}
+
+func (s *JwtSigner) requestToken(req *http.Request) (string, error) {
+	now := time.Now()
+
+	if s.opts.QueryStringHash {
+		return s.Sign(now, req)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cached != "" && now.Before(s.renew) {
+		return s.cached, nil
+	}
+
+	signed, err := s.Sign(now, req)
+	if err != nil {
+		return "", err
+	}
+
+	s.cached = signed
+	s.renew = now.Add(s.ttl / 2) // nolint:mnd
+
+	return signed, nil
+}
+
+// jwtBearerSource exchanges signed JWTs for access tokens (RFC 7523 section 2.1) and caches them.
+type jwtBearerSource struct {
+	signer   *JwtSigner
+	tokenURL string
+	tokenTTL time.Duration
+	client   *http.Client
+
+	mutex sync.Mutex
+	token *oauth2.Token
+}
+
+func newJwtBearerSource(signer *JwtSigner, opts *JwtOpts, client *http.Client) *jwtBearerSource {
+	tokenTTL := defaultJwtAccessTokenTTL
+	if opts.AccessTokenTTL > 0 {
+		tokenTTL = time.Duration(opts.AccessTokenTTL) * time.Second
+	}
+
+	return &jwtBearerSource{
+		signer:   signer,
+		tokenURL: opts.TokenURL,
+		tokenTTL: tokenTTL,
+		client:   client,
+	}
+}
+
+// exchange creates a signed JWT assertion and exchanges it for an access token.
+func (s *jwtBearerSource) exchange(ctx context.Context) (*oauth2.Token, error) {
+	now := time.Now()
+
+	assertion, err := s.signer.Sign(now, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error building JWT token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending JWT token request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Error       string `json:"error"`
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil && rsp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("decoding JWT token response: %w", err)
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%w: status %d: %s", ErrJwtTokenEndpoint, rsp.StatusCode, body.Error)
+		if body.Error == "invalid_grant" {
+			err = errors.Join(common.ErrInvalidGrant, err)
+		}
+
+		return nil, err
+	}
+
+	if body.AccessToken == "" {
+		return nil, fmt.Errorf("%w: empty access_token in response", ErrJwtTokenEndpoint)
+	}
+
+	token := &oauth2.Token{
+		AccessToken: body.AccessToken,
+		TokenType:   body.TokenType,
+		Expiry:      now.Add(s.tokenTTL),
+	}
+
+	if token.TokenType == "" {
+		token.TokenType = "Bearer"
+	}
+
+	if body.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// current returns the cached token, exchanging a new one within the caller's context once it expires.
+func (s *jwtBearerSource) current(ctx context.Context) (*oauth2.Token, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	fresh, err := s.exchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = fresh
+
+	return fresh, nil
+}
+
+// forceRefresh bypasses the cache and installs the new token as the cached one.
+func (s *jwtBearerSource) forceRefresh(ctx context.Context) (*oauth2.Token, error) {
+	fresh, err := s.exchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	s.token = fresh
+	s.mutex.Unlock()
+
+	return fresh, nil
+}
+
+func (s *jwtBearerSource) headers(req *http.Request) ([]common.Header, error) {
+	token, err := s.current(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("error in headers generator: %w", err)
+	}
+
+	return []common.Header{{Key: "Authorization", Value: token.Type() + " " + token.AccessToken}}, nil
+}
+
+// unauthorizedHandler re-exchanges the JWT on a 401 and replays the request once.
+// If the replay also fails, its response is returned as is.
+// Requests whose consumed body cannot be rewound are not replayed.
+func (s *jwtBearerSource) unauthorizedHandler(rawClient *http.Client) func(
+	[]common.Header, []common.QueryParam, *http.Request, *http.Response,
+) (*http.Response, error) {
+	return func(_ []common.Header, _ []common.QueryParam, req *http.Request, rsp *http.Response) (*http.Response, error) {
+		hasBody := req.Body != nil && req.Body != http.NoBody
+		if hasBody && req.GetBody == nil {
+			return rsp, nil
+		}
+
+		token, err := s.forceRefresh(req.Context())
+		if err != nil {
+			// Don't mask the original 401 if we can't refresh.
+			return rsp, nil //nolint:nilerr
+		}
+
+		replay := req.Clone(req.Context())
+
+		if hasBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return rsp, nil //nolint:nilerr
+			}
+
+			replay.Body = body
+		}
+
+		if rsp != nil && rsp.Body != nil {
+			_ = rsp.Body.Close()
+		}
+
+		replay.Header.Set("Authorization", token.Type()+" "+token.AccessToken)
+
+		return rawClient.Do(replay)
+	}
+}
+
+// jwtSigningKey returns the key matching the algorithm's key type.
+func jwtSigningKey(algorithm JwtOptsAlgorithm, creds *JwtParams) (any, error) {
+	family := string(algorithm)[:2]
+
+	if creds.Signer != nil {
+		switch creds.Signer.(type) {
+		case *rsa.PrivateKey:
+			if family == "RS" || family == "PS" {
+				return creds.Signer, nil
+			}
+		case *ecdsa.PrivateKey:
+			if family == "ES" {
+				return creds.Signer, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: %T cannot sign %s", ErrJwtKey, creds.Signer, algorithm)
+	}
+
+	if len(creds.Key) == 0 {
+		return nil, fmt.Errorf("%w: key not given", ErrJwtKey)
+	}
+
+	if family == "HS" {
+		return creds.Key, nil
+	}
+
+	key, err := parseJwtPrivateKey(creds.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return jwtSigningKey(algorithm, &JwtParams{Signer: key})
+}
+
+// parseJwtPrivateKey parses PKCS1, SEC1 or PKCS8 private keys from PEM or base64-encoded PEM.
+func parseJwtPrivateKey(data []byte) (any, error) {
+	// If the input doesn't look like PEM, try base64-decoding it first.
+	if !strings.Contains(string(data), "-----BEGIN") {
+		if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data))); err == nil {
+			data = decoded
+		}
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrJwtKey)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: tried PKCS1, SEC1 and PKCS8: %w", ErrJwtKey, err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,272 @@
+package providers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func testRSAKeyPEM(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048) // nolint:mnd
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+
+	return key, pem.EncodeToMemory(block)
+}
+
+func TestJwtBearerGrant(t *testing.T) { // nolint:funlen,cyclop
+	t.Parallel()
+
+	key, keyPEM := testRSAKeyPEM(t)
+
+	var (
+		exchanges int
+		apiCalls  int
+		claims    jwt.MapClaims
+	)
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		rsp := newResponse(http.StatusOK, req)
+
+		if req.URL.Path == "/token" {
+			exchanges++
+
+			body, _ := io.ReadAll(req.Body)
+			form, _ := url.ParseQuery(string(body))
+
+			if _, err := jwt.ParseWithClaims(form.Get("assertion"), &claims, func(*jwt.Token) (any, error) {
+				return &key.PublicKey, nil
+			}); err != nil {
+				t.Errorf("assertion: %v", err)
+			}
+
+			rsp.Body = io.NopCloser(strings.NewReader(fmt.Sprintf(`{"access_token":"token-%d"}`, exchanges)))
+
+			return rsp, nil
+		}
+
+		apiCalls++
+
+		// The first token is revoked early, the client must re-exchange once.
+		if req.Header.Get("Authorization") == "Bearer token-1" {
+			rsp.StatusCode = http.StatusUnauthorized
+		}
+
+		return rsp, nil
+	})
+
+	info := &ProviderInfo{
+		AuthType: Jwt,
+		JwtOpts: &JwtOpts{
+			Algorithm: RS256,
+			Mode:      JwtBearerGrant,
+			Issuer:    "{{ .clientId }}",
+			Audience:  "https://login.example.com",
+			Claims:    map[string]string{"sub": "{{ .username }}"},
+			TokenURL:  "https://login.example.com/token",
+		},
+	}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		Client: &http.Client{Transport: transport},
+		JwtCreds: &JwtParams{
+			Key:    keyPEM,
+			Values: map[string]string{"clientId": "client", "username": "user@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for range 2 {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/data", nil)
+
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+
+		_ = rsp.Body.Close()
+
+		if rsp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200 after re-exchange, got %d", rsp.StatusCode)
+		}
+	}
+
+	if exchanges != 2 {
+		t.Errorf("expected 2 token exchanges, got %d", exchanges)
+	}
+
+	if apiCalls != 3 {
+		t.Errorf("expected 3 API calls, got %d", apiCalls)
+	}
+
+	if claims["iss"] != "client" || claims["sub"] != "user@example.com" || claims["aud"] != "https://login.example.com" {
+		t.Errorf("unexpected claims %v", claims)
+	}
+}
+
+type jwtTestContextKey struct{}
+
+func TestJwtBearerGrantReplaysBody(t *testing.T) { // nolint:funlen,cyclop
+	t.Parallel()
+
+	_, keyPEM := testRSAKeyPEM(t)
+
+	var (
+		exchanges int
+		bodies    []string
+	)
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		rsp := newResponse(http.StatusOK, req)
+
+		if req.URL.Path == "/token" {
+			exchanges++
+
+			if req.Context().Value(jwtTestContextKey{}) == nil {
+				t.Error("token is exchanged outside of the caller's context")
+			}
+
+			rsp.Body = io.NopCloser(strings.NewReader(fmt.Sprintf(`{"access_token":"token-%d"}`, exchanges)))
+
+			return rsp, nil
+		}
+
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+
+		// Every token but the latest one is revoked.
+		if req.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", exchanges) {
+			rsp.StatusCode = http.StatusUnauthorized
+		}
+
+		return rsp, nil
+	})
+
+	info := &ProviderInfo{
+		AuthType: Jwt,
+		JwtOpts: &JwtOpts{
+			Algorithm: RS256,
+			Mode:      JwtBearerGrant,
+			Audience:  "https://login.example.com",
+			TokenURL:  "https://login.example.com/token",
+		},
+	}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		Client:   &http.Client{Transport: transport},
+		JwtCreds: &JwtParams{Key: keyPEM},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), jwtTestContextKey{}, true)
+	send := func(body io.Reader) int {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/data", body)
+
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+
+		_ = rsp.Body.Close()
+
+		return rsp.StatusCode
+	}
+
+	// A new token is exchanged after the first call, revoking the one cached by the client.
+	if status := send(strings.NewReader("first")); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+
+	exchanges++
+
+	if status := send(strings.NewReader("second")); status != http.StatusOK {
+		t.Fatalf("expected 200 after re-exchange, got %d", status)
+	}
+
+	exchanges++
+
+	// A body which cannot be rewound is not replayed.
+	if status := send(io.NopCloser(strings.NewReader("third"))); status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without replay, got %d", status)
+	}
+
+	expected := []string{"first", "second", "second", "third"}
+	if strings.Join(bodies, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected bodies %v, got %v", expected, bodies)
+	}
+}
+
+func TestJwtSignedRequest(t *testing.T) {
+	t.Parallel()
+
+	opts := &JwtOpts{
+		Algorithm:       HS256,
+		Mode:            JwtSignedRequest,
+		QueryStringHash: true,
+		HeaderPrefix:    "JWT",
+	}
+
+	signer, err := NewJwtSigner(opts, &JwtParams{Key: []byte("secret"), Claims: map[string]any{"iss": "app-key"}})
+	if err != nil {
+		t.Fatalf("NewJwtSigner: %v", err)
+	}
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com/?param=value", nil)
+
+	headers, err := signer.Headers(req)
+	if err != nil {
+		t.Fatalf("Headers: %v", err)
+	}
+
+	value, found := strings.CutPrefix(headers[0].Value, "JWT ")
+	if !found {
+		t.Fatalf("unexpected header %q", headers[0].Value)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(value, &claims, func(*jwt.Token) (any, error) {
+		return []byte("secret"), nil
+	}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	// The hash of "GET&/&param=value", see TestQueryStringHash.
+	if claims["qsh"] != "f0ac26e46a317ce416f2c00803c685edc2aaea1e7212e6b7ef916a6f50ba2dd6" {
+		t.Errorf("unexpected qsh %v", claims["qsh"])
+	}
+
+	if claims["iss"] != "app-key" || claims["iat"] == nil {
+		t.Errorf("unexpected claims %v", claims)
+	}
+}
+
+func TestJwtKeyMustMatchAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	_, keyPEM := testRSAKeyPEM(t)
+
+	_, err := NewJwtSigner(&JwtOpts{Algorithm: ES256, Mode: JwtSignedRequest}, &JwtParams{Key: keyPEM})
+	if !errors.Is(err, ErrJwtKey) {
+		t.Fatalf("expected key error, got %v", err)
+	}
+}
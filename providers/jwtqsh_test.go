@@ -1,11 +1,11 @@
-package atlassian
+package providers
 
 import (
 	"testing"
 )
 
-// Test cases for createQueryStringHash.
-func TestCreateQueryStringHash(t *testing.T) { // nolint: funlen
+// Test cases for queryStringHash.
+func TestQueryStringHash(t *testing.T) { // nolint: funlen
 	t.Parallel()
 
 	tests := []struct {
@@ -69,7 +69,7 @@ func TestCreateQueryStringHash(t *testing.T) { // nolint: funlen
 		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
 			t.Parallel()
 
-			result := createQueryStringHash(tt.method, tt.path, tt.query)
+			result := queryStringHash(tt.method, tt.path, tt.query)
 			if result != tt.expected {
 				t.Errorf("Expected hash %s, got %s", tt.expected, result)
 			}
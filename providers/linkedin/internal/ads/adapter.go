@@ -92,6 +92,8 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		},
 	)
 
+	adapter.Reader = reader.NewNormalizingReader(adapter.Reader, adapter.SchemaProvider)
+
 	adapter.Writer = writer.NewHTTPWriter(
 		adapter.HTTPClient().Client,
 		components.NewEmptyEndpointRegistry(),
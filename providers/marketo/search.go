@@ -11,6 +11,10 @@ import (
 )
 
 func (c *Connector) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	if params.IsTextSearch() {
+		return nil, fmt.Errorf("%w: text search", common.ErrOperationNotSupportedForObject)
+	}
+
 	url, err := c.constructSearchURL(ctx, params)
 	if err != nil {
 		return nil, err
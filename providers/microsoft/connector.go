@@ -47,6 +47,9 @@ type Connector struct {
 
 	// Dependent services.
 	batchStrategy *batch.Strategy
+
+	// registry supports every object and declares the Graph permissions each object requires, used by CheckScopes.
+	registry *components.EndpointRegistry
 }
 
 // NewConnector creates a new Microsoft connector. It defaults to the Microsoft
@@ -74,6 +77,13 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	connector.SchemaProvider = schema.NewOpenAPISchemaProvider(connector.ProviderContext.Module(), metadata.Schemas)
 
+	registry, err := components.NewEmptyEndpointRegistryWithScopes(requiredScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	connector.registry = registry
+
 	// DirectFaultyResponder (vs. the default FaultyResponder) gives the
 	// callback access to the raw *http.Response, including headers.
 	// handleErrorResponse needs WWW-Authenticate to detect CAE / step-up
@@ -85,7 +95,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	connector.Reader = reader.NewHTTPReader(
 		connector.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		connector.registry,
 		connector.ProviderContext.Module(),
 		operations.ReadHandlers{
 			BuildRequest:  connector.buildReadRequest,
@@ -96,7 +106,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	connector.Writer = writer.NewHTTPWriter(
 		connector.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		connector.registry,
 		connector.ProviderContext.Module(),
 		operations.WriteHandlers{
 			BuildRequest:  connector.buildWriteRequest,
@@ -107,7 +117,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	connector.Deleter = deleter.NewHTTPDeleter(
 		connector.HTTPClient().Client,
-		components.NewEmptyEndpointRegistry(),
+		connector.registry,
 		connector.ProviderContext.Module(),
 		operations.DeleteHandlers{
 			BuildRequest:  connector.buildDeleteRequest,
@@ -121,6 +131,8 @@ func constructor(base *components.Connector) (*Connector, error) {
 	connector.subscribeStrategy = subscriber.NewStrategy(
 		base.JSONHTTPClient(), base.ProviderInfo(), connector.batchStrategy)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
 
@@ -0,0 +1,129 @@
+package microsoft
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/components"
+)
+
+var _ connectors.ScopeChecker = &Connector{}
+
+// graphResources qualify the permissions which Microsoft grants for Graph,
+// for example "https://graph.microsoft.com/Mail.Read".
+var graphResources = []string{ // nolint:gochecknoglobals
+	"https://graph.microsoft.com/",
+	"00000003-0000-0000-c000-000000000000/",
+}
+
+// CheckScopes reports objects and operations which the granted Graph permissions do not permit.
+// Granted permissions are returned in the "scope" field of the token response.
+func (c *Connector) CheckScopes(
+	ctx context.Context, params common.ScopeCheckParams,
+) (*common.ScopeCheckResult, error) {
+	params.GrantedScopes = graphPermissions(params.GrantedScopes)
+
+	return c.registry.CheckScopes(c.Module(), params), nil
+}
+
+// graphPermissions converts granted scopes into the permission names declared by requiredScopes.
+// The scopes may be qualified by the Graph resource and their casing may differ.
+func graphPermissions(granted []string) []string {
+	declared := make(map[string]string)
+
+	for _, endpoint := range requiredScopes()[common.ModuleRoot] {
+		scopes := endpoint.Scopes
+		for _, requirement := range slices.Concat(scopes.Read, scopes.Write, scopes.Delete) {
+			addPermissions(declared, requirement)
+		}
+	}
+
+	permissions := make([]string, len(granted))
+
+	for index, scope := range granted {
+		scope = strings.TrimSpace(scope)
+
+		for _, resource := range graphResources {
+			if len(scope) > len(resource) && strings.EqualFold(scope[:len(resource)], resource) {
+				scope = scope[len(resource):]
+
+				break
+			}
+		}
+
+		if name, ok := declared[strings.ToLower(scope)]; ok {
+			scope = name
+		}
+
+		permissions[index] = scope
+	}
+
+	return permissions
+}
+
+// addPermissions indexes every alternative of the requirement by its lowercase name.
+func addPermissions(declared map[string]string, requirement string) {
+	for name := range strings.SplitSeq(requirement, "|") {
+		declared[strings.ToLower(name)] = name
+	}
+}
+
+// requiredScopes lists delegated permissions for the most common Graph objects.
+// A ReadWrite permission implies the matching Read permission, and directory-wide
+// permissions imply user and group permissions.
+// https://learn.microsoft.com/en-us/graph/permissions-reference
+func requiredScopes() components.EndpointRegistryInput {
+	return components.EndpointRegistryInput{
+		common.ModuleRoot: {
+			{
+				Endpoint: "{me/messages,mailFolders}",
+				Scopes:   permissions("Mail.Read|Mail.ReadWrite", "Mail.ReadWrite"),
+			},
+			{
+				Endpoint: "{me/events,calendars}",
+				Scopes:   permissions("Calendars.Read|Calendars.ReadWrite", "Calendars.ReadWrite"),
+			},
+			{
+				Endpoint: "{contacts,contactFolders}",
+				Scopes:   permissions("Contacts.Read|Contacts.ReadWrite", "Contacts.ReadWrite"),
+			},
+			{
+				Endpoint: "users",
+				Scopes: permissions(
+					"User.Read.All|User.ReadWrite.All|Directory.Read.All|Directory.ReadWrite.All",
+					"User.ReadWrite.All|Directory.ReadWrite.All",
+				),
+			},
+			{
+				Endpoint: "groups",
+				Scopes: permissions(
+					"Group.Read.All|Group.ReadWrite.All|Directory.Read.All|Directory.ReadWrite.All",
+					"Group.ReadWrite.All|Directory.ReadWrite.All",
+				),
+			},
+			{
+				Endpoint: "drive/items",
+				Scopes: permissions(
+					"Files.Read|Files.ReadWrite|Files.Read.All|Files.ReadWrite.All",
+					"Files.ReadWrite|Files.ReadWrite.All",
+				),
+			},
+			{
+				Endpoint: "chats",
+				Scopes:   permissions("Chat.Read|Chat.ReadWrite", "Chat.ReadWrite"),
+			},
+		},
+	}
+}
+
+// permissions requires the read permission for reading, and the write permission for writing and deleting.
+func permissions(read, write string) common.ScopeRequirements {
+	return common.ScopeRequirements{
+		Read:   []string{read},
+		Write:  []string{write},
+		Delete: []string{write},
+	}
+}
@@ -0,0 +1,92 @@
+package microsoft
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestCheckScopes(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	conn, err := constructTestConnector("https://graph.microsoft.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		input    common.ScopeCheckParams
+		expected []common.MissingScopes
+	}{
+		{
+			name: "Read permission does not permit writing",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"Mail.Read"},
+				ObjectNames:   []string{"me/messages"},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "root", ObjectName: "me/messages", Operation: common.ScopeOperationWrite,
+					Scopes: []string{"Mail.ReadWrite"},
+				},
+				{
+					Module: "root", ObjectName: "me/messages", Operation: common.ScopeOperationDelete,
+					Scopes: []string{"Mail.ReadWrite"},
+				},
+			},
+		},
+		{
+			name: "Permissions qualified by the Graph resource are recognized",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{
+					"https://graph.microsoft.com/Mail.ReadWrite",
+					"00000003-0000-0000-c000-000000000000/Calendars.ReadWrite",
+				},
+				ObjectNames: []string{"me/messages", "me/events"},
+			},
+			expected: nil,
+		},
+		{
+			name: "Permissions are compared ignoring their casing",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://GRAPH.microsoft.com/mail.readwrite", "directory.read.all"},
+				ObjectNames:   []string{"me/messages", "users"},
+				Operations:    []common.ScopeOperation{common.ScopeOperationRead},
+			},
+			expected: nil,
+		},
+		{
+			name: "Permissions of other resources are not recognized",
+			input: common.ScopeCheckParams{
+				GrantedScopes: []string{"https://outlook.office.com/Mail.Read"},
+				ObjectNames:   []string{"me/messages"},
+				Operations:    []common.ScopeOperation{common.ScopeOperationRead},
+			},
+			expected: []common.MissingScopes{
+				{
+					Module: "root", ObjectName: "me/messages", Operation: common.ScopeOperationRead,
+					Scopes: []string{"Mail.Read|Mail.ReadWrite"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := conn.CheckScopes(t.Context(), tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testutils.CheckOutput(t, tt.name, tt.expected, result.Missing)
+
+			if (len(tt.expected) == 0) != (result.Err() == nil) {
+				t.Fatalf("%s: unexpected error %v", tt.name, result.Err())
+			}
+		})
+	}
+}
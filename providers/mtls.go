@@ -0,0 +1,223 @@
+package providers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"weak"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+var (
+	// ErrClientCertificate is returned when the mTLS client certificate cannot be loaded.
+	ErrClientCertificate = errors.New("invalid client certificate")
+	// ErrCABundle is returned when the custom CA bundle has no valid certificates.
+	ErrCABundle = errors.New("invalid CA bundle")
+)
+
+// MTLSParams configures mutual TLS. It is applied to the HTTP client before the
+// provider's auth type, so it works together with basic, API key, OAuth2 and custom auth,
+// or on its own for providers with auth type none.
+type MTLSParams struct {
+	// Certificate is the client certificate presented to the server. Ignored when Source is set.
+	Certificate ClientCertificate
+
+	// Source supplies the client certificate on every TLS handshake.
+	// Use it to rotate certificates without rebuilding the connector.
+	Source *ClientCertificateSource
+
+	// CABundlePEM holds additional root certificates, for servers using a private certificate authority.
+	// They are trusted besides the roots of the client's transport, or the system pool when it sets none.
+	CABundlePEM []byte
+}
+
+// ClientCertificate is a client certificate with its private key,
+// given either as PEM blocks or as a PKCS#12 (.p12, .pfx) archive.
+type ClientCertificate struct {
+	// CertificatePEM may contain intermediate certificates after the leaf certificate.
+	CertificatePEM []byte
+	PrivateKeyPEM  []byte
+
+	PKCS12         []byte
+	PKCS12Password string
+}
+
+// Parse returns the certificate in the form used by crypto/tls.
+func (c ClientCertificate) Parse() (*tls.Certificate, error) {
+	certPEM, keyPEM := c.CertificatePEM, c.PrivateKeyPEM
+
+	if len(c.PKCS12) != 0 {
+		var err error
+
+		certPEM, keyPEM, err = pkcs12ToPEM(c.PKCS12, c.PKCS12Password)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrClientCertificate, err)
+		}
+	}
+
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return nil, fmt.Errorf("%w: certificate and private key are required", ErrClientCertificate)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClientCertificate, err)
+	}
+
+	return &cert, nil
+}
+
+// pkcs12ToPEM decodes a PKCS#12 archive into the PEM encoded certificate chain and private key.
+func pkcs12ToPEM(data []byte, password string) ([]byte, []byte, error) {
+	key, leaf, chain, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+	for _, cert := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+
+	return certPEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+// ClientCertificateSource holds the current client certificate of one or more HTTP clients.
+// Transports are tracked by weak references, so that a long-lived source does not keep
+// the clients of discarded connectors alive.
+type ClientCertificateSource struct {
+	mutex      sync.RWMutex
+	cert       *tls.Certificate
+	transports []weak.Pointer[http.Transport]
+}
+
+// NewClientCertificateSource creates a source presenting the certificate.
+func NewClientCertificateSource(certificate ClientCertificate) (*ClientCertificateSource, error) {
+	cert, err := certificate.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientCertificateSource{cert: cert}, nil
+}
+
+// Rotate replaces the certificate. Idle connections established with the previous certificate
+// are closed, so that subsequent requests perform a new handshake.
+func (s *ClientCertificateSource) Rotate(certificate ClientCertificate) error {
+	cert, err := certificate.Parse()
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.cert = cert
+	transports := s.transports
+	s.mutex.Unlock()
+
+	for _, pointer := range transports {
+		if transport := pointer.Value(); transport != nil {
+			transport.CloseIdleConnections()
+		}
+	}
+
+	return nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (s *ClientCertificateSource) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.cert, nil
+}
+
+// register tracks the transport, forgetting those which were garbage collected.
+func (s *ClientCertificateSource) register(transport *http.Transport) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.transports = slices.DeleteFunc(s.transports, func(pointer weak.Pointer[http.Transport]) bool {
+		return pointer.Value() == nil
+	})
+	s.transports = append(s.transports, weak.Make(transport))
+}
+
+// newMTLSClient returns a copy of the client whose transport presents the client certificate.
+// The original client is left untouched.
+func newMTLSClient(client *http.Client, params *MTLSParams) (*http.Client, error) {
+	client = getClient(client)
+
+	var transport *http.Transport
+
+	switch base := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone() // nolint:forcetypeassert
+	case *http.Transport:
+		transport = base.Clone()
+	default:
+		return nil, fmt.Errorf("%w: mTLS requires an *http.Transport, got %T", ErrClient, client.Transport)
+	}
+
+	source := params.Source
+	if source == nil {
+		var err error
+
+		source, err = NewClientCertificateSource(params.Certificate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+
+	tlsConfig.Certificates = nil
+	tlsConfig.GetClientCertificate = source.GetClientCertificate
+
+	if len(params.CABundlePEM) != 0 {
+		pool := rootCAs(tlsConfig)
+		if !pool.AppendCertsFromPEM(params.CABundlePEM) {
+			return nil, ErrCABundle
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	source.register(transport)
+
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+		Timeout:       client.Timeout,
+	}, nil
+}
+
+// rootCAs returns a copy of the roots trusted by the TLS config, which defaults to the system pool.
+func rootCAs(tlsConfig *tls.Config) *x509.CertPool {
+	if tlsConfig.RootCAs != nil {
+		return tlsConfig.RootCAs.Clone()
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return x509.NewCertPool()
+	}
+
+	return pool
+}
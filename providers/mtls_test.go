@@ -0,0 +1,239 @@
+package providers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func testClientCertificate(t *testing.T, commonName string) ClientCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ClientCertificate{
+		CertificatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		PrivateKeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func TestMTLSWithBasicAuth(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _, _ := r.BasicAuth()
+		_, _ = io.WriteString(w, user+":"+r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	source, err := NewClientCertificateSource(testClientCertificate(t, "first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &ProviderInfo{AuthType: Basic, BaseURL: server.URL}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		BasicCreds: &BasicParams{User: "user", Pass: "pass"},
+		MTLS:       &MTLSParams{Source: source, CABundlePEM: caBundle},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	get := func() string {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+
+		defer rsp.Body.Close()
+
+		body, _ := io.ReadAll(rsp.Body)
+
+		return string(body)
+	}
+
+	if got := get(); got != "user:first" {
+		t.Fatalf("expected user:first, got %q", got)
+	}
+
+	if err := source.Rotate(testClientCertificate(t, "second")); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := get(); got != "user:second" {
+		t.Fatalf("expected rotated certificate, got %q", got)
+	}
+}
+
+func TestMTLSInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	info := &ProviderInfo{AuthType: None}
+
+	_, err := info.NewClient(context.Background(), &NewClientParams{
+		MTLS: &MTLSParams{Certificate: ClientCertificate{CertificatePEM: []byte("nope")}},
+	})
+	if !errors.Is(err, ErrClientCertificate) {
+		t.Errorf("expected ErrClientCertificate, got %v", err)
+	}
+
+	_, err = info.NewClient(context.Background(), &NewClientParams{
+		MTLS: &MTLSParams{Certificate: testClientCertificate(t, "client"), CABundlePEM: []byte("nope")},
+	})
+	if !errors.Is(err, ErrCABundle) {
+		t.Errorf("expected ErrCABundle, got %v", err)
+	}
+}
+
+func TestClientCertificateFromPKCS12(t *testing.T) {
+	t.Parallel()
+
+	pemCert := testClientCertificate(t, "archived")
+
+	certBlock, _ := pem.Decode(pemCert.CertificatePEM)
+	keyBlock, _ := pem.Decode(pemCert.PrivateKeyPEM)
+
+	leaf, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := pkcs12.Modern.Encode(key, leaf, nil, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := ClientCertificate{PKCS12: archive, PKCS12Password: "secret"}.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := x509.ParseCertificate(cert.Certificate[0]); got.Subject.CommonName != "archived" {
+		t.Errorf("expected certificate from the archive, got %q", got.Subject.CommonName)
+	}
+
+	_, err = ClientCertificate{PKCS12: archive, PKCS12Password: "wrong"}.Parse()
+	if !errors.Is(err, ErrClientCertificate) {
+		t.Errorf("expected ErrClientCertificate, got %v", err)
+	}
+}
+
+func TestMTLSKeepsTransportRootCAs(t *testing.T) {
+	t.Parallel()
+
+	trusted := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(trusted.Close)
+
+	private := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(private.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(trusted.Certificate())
+
+	base := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+	}}
+
+	client, err := newMTLSClient(base, &MTLSParams{
+		Certificate: testClientCertificate(t, "client"),
+		CABundlePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: private.Certificate().Raw}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, url := range []string{trusted.URL, private.URL} {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do %v: %v", url, err)
+		}
+
+		_ = rsp.Body.Close()
+	}
+
+	unchanged := x509.NewCertPool()
+	unchanged.AddCert(trusted.Certificate())
+
+	if !roots.Equal(unchanged) {
+		t.Error("roots of the original transport must not be modified")
+	}
+}
+
+func TestClientCertificateSourceForgetsDiscardedClients(t *testing.T) {
+	t.Parallel()
+
+	source, err := NewClientCertificateSource(testClientCertificate(t, "shared"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 10 {
+		if _, err := newMTLSClient(nil, &MTLSParams{Source: source}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runtime.GC()
+
+	client, err := newMTLSClient(nil, &MTLSParams{Source: source})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count := len(source.transports); count != 1 {
+		t.Errorf("expected only the live transport to be tracked, got %d", count)
+	}
+
+	runtime.KeepAlive(client)
+}
@@ -99,6 +99,8 @@ func newAdapter(provider providers.Provider, params common.ConnectorParams) (*Ad
 			},
 		)
 
+		adapter.Reader = reader.NewNormalizingReader(adapter.Reader, adapter.SchemaProvider)
+
 		adapter.Writer = writer.NewHTTPWriter(
 			httpClient,
 			registry,
@@ -11,6 +11,10 @@ import (
 // Search implements the SearchConnector interface by sending a search action
 // to the RESTlet with field-based filters (instead of date-range filters used by Read).
 func (a *Adapter) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	if params.IsTextSearch() {
+		return nil, fmt.Errorf("%w: text search", common.ErrOperationNotSupportedForObject)
+	}
+
 	payload, err := buildSearchPayload(params)
 	if err != nil {
 		return nil, err
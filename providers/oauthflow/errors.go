@@ -0,0 +1,18 @@
+package oauthflow
+
+import "errors"
+
+var (
+	// ErrNotAuthorizationCode is returned for providers which do not use the authorization code grant.
+	ErrNotAuthorizationCode = errors.New("provider does not support the OAuth2 authorization code grant")
+	// ErrMissingAuthURL is returned when the catalog has no authorization URL for the provider.
+	ErrMissingAuthURL = errors.New("provider has no OAuth2 authorization URL")
+	// ErrMissingClient is returned when the client id is not configured.
+	ErrMissingClient = errors.New("OAuth2 client id is required")
+	// ErrMissingCodeVerifier is returned when exchanging a PKCE code without the verifier.
+	ErrMissingCodeVerifier = errors.New("PKCE code verifier is required")
+	// ErrInvalidCapture is returned when a token metadata capture expression cannot be used.
+	ErrInvalidCapture = errors.New("token metadata capture must have a single named group 'result'")
+	// ErrCaptureMismatch is returned when a token metadata value does not match its capture expression.
+	ErrCaptureMismatch = errors.New("token metadata value does not match capture expression")
+)
@@ -0,0 +1,196 @@
+// Package oauthflow implements the OAuth2 authorization code flow, with or without PKCE,
+// driven by the provider catalog.
+//
+// The flow has three steps, each configured entirely by providers.ProviderInfo:
+//
+//	flow, err := oauthflow.New(info, oauthflow.Config{ClientID: id, ClientSecret: secret, RedirectURL: callback})
+//	request, err := flow.AuthCodeURL("")          // redirect the user to request.URL, persist State and CodeVerifier
+//	token, err := flow.Exchange(ctx, code, request.CodeVerifier)
+//	fmt.Println(token.Metadata.WorkspaceRef)      // extracted with Oauth2Opts.TokenMetadataFields
+//
+// The ProviderInfo must have catalog substitutions applied, see providers.ReadInfo.
+package oauthflow
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+)
+
+// stateBytes is the amount of randomness in a generated state parameter.
+const stateBytes = 24
+
+// Config holds the OAuth2 client registration.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback registered with the provider.
+	RedirectURL string
+	// Scopes are requested scopes. Scopes listed in Oauth2Opts.ScopeMappings are replaced by their mapping.
+	Scopes []string
+	// HTTPClient is used for the code exchange. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Flow performs the authorization code grant for a single provider.
+type Flow struct {
+	info   *providers.ProviderInfo
+	config *oauth2.Config
+	client *http.Client
+	pkce   bool
+}
+
+// AuthRequest is a consent URL together with the values the caller must keep until the callback.
+type AuthRequest struct {
+	// URL is where the user is sent to grant access.
+	URL string
+	// State must match the state returned to the callback.
+	State string
+	// CodeVerifier must be passed to Exchange. Empty unless the provider uses PKCE.
+	CodeVerifier string
+}
+
+// Token is the result of a code exchange.
+type Token struct {
+	*oauth2.Token
+
+	// Metadata holds values extracted from the token response using Oauth2Opts.TokenMetadataFields.
+	Metadata *TokenMetadata
+}
+
+// New creates the flow for a provider using the authorization code grant, with or without PKCE.
+func New(info *providers.ProviderInfo, config Config) (*Flow, error) {
+	if info.AuthType != providers.Oauth2 || info.Oauth2Opts == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotAuthorizationCode, info.Name)
+	}
+
+	opts := info.Oauth2Opts
+
+	if opts.GrantType != providers.AuthorizationCode && opts.GrantType != providers.AuthorizationCodePKCE {
+		return nil, fmt.Errorf("%w: %s uses %s", ErrNotAuthorizationCode, info.Name, opts.GrantType)
+	}
+
+	if opts.AuthURL == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingAuthURL, info.Name)
+	}
+
+	if config.ClientID == "" {
+		return nil, ErrMissingClient
+	}
+
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Flow{
+		info: info,
+		config: &oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Scopes:       mapScopes(config.Scopes, opts.ScopeMappings),
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  opts.AuthURL,
+				TokenURL: opts.TokenURL,
+				// Providers disagree on whether client credentials go into the header or the body.
+				AuthStyle: oauth2.AuthStyleAutoDetect,
+			},
+		},
+		client: client,
+		pkce:   opts.GrantType == providers.AuthorizationCodePKCE,
+	}, nil
+}
+
+// AuthCodeURL builds the consent URL, including catalog AuthURLParams and, for PKCE providers,
+// the S256 code challenge. A random state is generated when state is empty.
+func (f *Flow) AuthCodeURL(state string) (*AuthRequest, error) {
+	if state == "" {
+		generated, err := randomState()
+		if err != nil {
+			return nil, err
+		}
+
+		state = generated
+	}
+
+	options := make([]oauth2.AuthCodeOption, 0, len(f.info.Oauth2Opts.AuthURLParams)+1)
+	for key, value := range f.info.Oauth2Opts.AuthURLParams {
+		options = append(options, oauth2.SetAuthURLParam(key, value))
+	}
+
+	request := &AuthRequest{State: state}
+
+	if f.pkce {
+		// Reference: https://www.rfc-editor.org/rfc/rfc7636#section-4.3
+		request.CodeVerifier = oauth2.GenerateVerifier()
+		options = append(options, oauth2.S256ChallengeOption(request.CodeVerifier))
+	}
+
+	request.URL = f.config.AuthCodeURL(state, options...)
+
+	return request, nil
+}
+
+// Exchange trades the authorization code for a token and extracts the token metadata.
+// codeVerifier is the AuthRequest.CodeVerifier issued for this consent, required for PKCE providers.
+func (f *Flow) Exchange(ctx context.Context, code, codeVerifier string) (*Token, error) {
+	options := make([]oauth2.AuthCodeOption, 0, 1)
+
+	if f.pkce {
+		if codeVerifier == "" {
+			return nil, ErrMissingCodeVerifier
+		}
+
+		// Reference: https://www.rfc-editor.org/rfc/rfc7636#section-4.5
+		options = append(options, oauth2.VerifierOption(codeVerifier))
+	}
+
+	token, err := f.config.Exchange(context.WithValue(ctx, oauth2.HTTPClient, f.client), code, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := ExtractMetadata(f.info.Oauth2Opts.TokenMetadataFields, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		Token:    token,
+		Metadata: metadata,
+	}, nil
+}
+
+// mapScopes replaces scopes listed in the catalog scope mappings, passing others unchanged.
+func mapScopes(scopes []string, mappings map[string]string) []string {
+	result := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+
+		if mapped, ok := mappings[scope]; ok {
+			scope = mapped
+		}
+
+		result = append(result, scope)
+	}
+
+	return result
+}
+
+func randomState() (string, error) {
+	bts := make([]byte, stateBytes)
+	if _, err := rand.Read(bts); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bts), nil
+}
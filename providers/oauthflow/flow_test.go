@@ -0,0 +1,170 @@
+// nolint
+package oauthflow
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/amp-labs/connectors/providers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authorizationServer is a minimal token endpoint which validates the PKCE verifier.
+func authorizationServer(t *testing.T, challenge *string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.Form.Get("grant_type"))
+		assert.Equal(t, "the-code", r.Form.Get("code"))
+
+		if *challenge != "" {
+			sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+			if base64.RawURLEncoding.EncodeToString(sum[:]) != *challenge {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access",
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"scope":         "read write",
+			"user":          map[string]any{"id": float64(42)},
+			"instance_url":  "https://acme.example.com",
+		})
+	}))
+}
+
+func providerInfo(tokenURL string, grantType providers.Oauth2OptsGrantType) *providers.ProviderInfo {
+	return &providers.ProviderInfo{
+		Name:     "test",
+		AuthType: providers.Oauth2,
+		Oauth2Opts: &providers.Oauth2Opts{
+			GrantType:     grantType,
+			AuthURL:       "https://auth.example.com/authorize",
+			TokenURL:      tokenURL,
+			AuthURLParams: map[string]string{"access_type": "offline"},
+			ScopeMappings: map[string]string{"default": "https://api.example.com/default"},
+			TokenMetadataFields: providers.TokenMetadataFields{
+				ConsumerRefField:  "user.id",
+				WorkspaceRefField: "instance_url",
+				ScopesField:       "scope",
+				OtherFields: &providers.TokenMetadataFieldsOtherFields{{
+					Name:    "subdomain",
+					Path:    "instance_url",
+					Capture: `https://(?<result>[^.]+)\.example\.com`,
+				}},
+			},
+		},
+	}
+}
+
+func TestAuthorizationCodeWithPKCE(t *testing.T) {
+	t.Parallel()
+
+	var challenge string
+
+	server := authorizationServer(t, &challenge)
+	defer server.Close()
+
+	flow, err := New(providerInfo(server.URL, providers.AuthorizationCodePKCE), Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		Scopes:       []string{"default", "offline"},
+		HTTPClient:   server.Client(),
+	})
+	require.NoError(t, err)
+
+	request, err := flow.AuthCodeURL("")
+	require.NoError(t, err)
+	require.NotEmpty(t, request.State)
+	require.NotEmpty(t, request.CodeVerifier)
+
+	consent, err := url.Parse(request.URL)
+	require.NoError(t, err)
+
+	query := consent.Query()
+	assert.Equal(t, "auth.example.com", consent.Host)
+	assert.Equal(t, request.State, query.Get("state"))
+	assert.Equal(t, "offline", query.Get("access_type"))
+	assert.Equal(t, "https://api.example.com/default offline", query.Get("scope"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+	challenge = query.Get("code_challenge")
+
+	_, err = flow.Exchange(t.Context(), "the-code", "")
+	require.ErrorIs(t, err, ErrMissingCodeVerifier)
+
+	_, err = flow.Exchange(t.Context(), "the-code", "wrong-verifier")
+	require.Error(t, err)
+
+	token, err := flow.Exchange(t.Context(), "the-code", request.CodeVerifier)
+	require.NoError(t, err)
+
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, &TokenMetadata{
+		ConsumerRef:  "42",
+		WorkspaceRef: "https://acme.example.com",
+		Scopes:       []string{"read", "write"},
+		Other:        map[string]string{"subdomain": "acme"},
+	}, token.Metadata)
+}
+
+func TestAuthorizationCodeWithoutPKCE(t *testing.T) {
+	t.Parallel()
+
+	challenge := ""
+
+	server := authorizationServer(t, &challenge)
+	defer server.Close()
+
+	flow, err := New(providerInfo(server.URL, providers.AuthorizationCode), Config{
+		ClientID:   "client",
+		HTTPClient: server.Client(),
+	})
+	require.NoError(t, err)
+
+	request, err := flow.AuthCodeURL("my-state")
+	require.NoError(t, err)
+	assert.Equal(t, "my-state", request.State)
+	assert.Empty(t, request.CodeVerifier)
+	assert.NotContains(t, request.URL, "code_challenge")
+
+	token, err := flow.Exchange(t.Context(), "the-code", "")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", token.Metadata.Other["subdomain"])
+}
+
+func TestNewRejectsOtherGrants(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(providerInfo("https://token", providers.ClientCredentials), Config{ClientID: "client"})
+	require.ErrorIs(t, err, ErrNotAuthorizationCode)
+
+	_, err = New(&providers.ProviderInfo{Name: "apikey", AuthType: providers.ApiKey}, Config{ClientID: "client"})
+	require.ErrorIs(t, err, ErrNotAuthorizationCode)
+}
+
+func TestCaptureRequiresResultGroup(t *testing.T) {
+	t.Parallel()
+
+	_, err := capture(`https://([^.]+)\.example\.com`, "https://acme.example.com")
+	require.ErrorIs(t, err, ErrInvalidCapture)
+
+	_, err = capture(`https://(?<result>[^.]+)\.other\.com`, "https://acme.example.com")
+	require.ErrorIs(t, err, ErrCaptureMismatch)
+}
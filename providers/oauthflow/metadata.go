@@ -0,0 +1,133 @@
+package oauthflow
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+)
+
+// captureGroup is the named group holding the value of a capture expression.
+const captureGroup = "result"
+
+// TokenMetadata holds values extracted from a token response.
+// Values which are not configured for the provider, or absent from the response, are empty.
+type TokenMetadata struct {
+	// ConsumerRef identifies the user who granted access, see TokenMetadataFields.ConsumerRefField.
+	ConsumerRef string
+	// WorkspaceRef identifies the provider account or instance, see TokenMetadataFields.WorkspaceRefField.
+	WorkspaceRef string
+	// Scopes are the scopes granted by the provider, see TokenMetadataFields.ScopesField.
+	Scopes []string
+	// Other holds TokenMetadataFields.OtherFields keyed by their name.
+	Other map[string]string
+}
+
+// ExtractMetadata reads the catalog token metadata fields out of the token response.
+// Paths use dot notation for nested fields. Other fields with a Capture expression
+// keep only the part matched by the named group "result".
+func ExtractMetadata(fields providers.TokenMetadataFields, token *oauth2.Token) (*TokenMetadata, error) {
+	metadata := &TokenMetadata{
+		ConsumerRef:  stringAt(token, fields.ConsumerRefField),
+		WorkspaceRef: stringAt(token, fields.WorkspaceRefField),
+		Scopes:       scopesAt(token, fields.ScopesField),
+		Other:        make(map[string]string),
+	}
+
+	if fields.OtherFields == nil {
+		return metadata, nil
+	}
+
+	for _, field := range *fields.OtherFields {
+		value := stringAt(token, field.Path)
+		if value == "" {
+			continue
+		}
+
+		if field.Capture != "" {
+			captured, err := capture(field.Capture, value)
+			if err != nil {
+				return nil, fmt.Errorf("token metadata %s: %w", field.Name, err)
+			}
+
+			value = captured
+		}
+
+		metadata.Other[field.Name] = value
+	}
+
+	return metadata, nil
+}
+
+func capture(expression, value string) (string, error) {
+	pattern, err := regexp.Compile(expression)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCapture, err)
+	}
+
+	index := pattern.SubexpIndex(captureGroup)
+	if index < 0 {
+		return "", ErrInvalidCapture
+	}
+
+	match := pattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", fmt.Errorf("%w: %s", ErrCaptureMismatch, expression)
+	}
+
+	return match[index], nil
+}
+
+// valueAt resolves a dot separated path in the token response.
+func valueAt(token *oauth2.Token, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	parts := strings.Split(path, ".")
+	value := token.Extra(parts[0])
+
+	for _, part := range parts[1:] {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = object[part]
+	}
+
+	return value
+}
+
+func stringAt(token *oauth2.Token, path string) string {
+	switch value := valueAt(token, path).(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// scopesAt reads granted scopes, which providers return either as a list
+// or as a single string separated by spaces or commas.
+func scopesAt(token *oauth2.Token, path string) []string {
+	switch value := valueAt(token, path).(type) {
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool {
+			return r == ' ' || r == ','
+		})
+	case []any:
+		scopes := make([]string, 0, len(value))
+		for _, scope := range value {
+			scopes = append(scopes, fmt.Sprint(scope))
+		}
+
+		return scopes
+	default:
+		return nil
+	}
+}
@@ -79,6 +79,10 @@ func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common
 }
 
 func (c *Connector) Write(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	if params.IsUpsert() {
+		return c.upsert(ctx, params)
+	}
+
 	if c.crmAdapter != nil {
 		return c.crmAdapter.Write(ctx, params)
 	}
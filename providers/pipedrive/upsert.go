@@ -0,0 +1,141 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+var _ connectors.UpsertConnector = &Connector{}
+
+// upsertFields lists fields which the search endpoints can match exactly.
+// https://developers.pipedrive.com/docs/api/v1/Persons#searchPersons
+var upsertFields = datautils.Map[string, datautils.StringSet]{ //nolint:gochecknoglobals
+	"persons":       datautils.NewStringSet("name", "email", "phone"),
+	"organizations": datautils.NewStringSet("name", "address"),
+	"deals":         datautils.NewStringSet("title"),
+	"products":      datautils.NewStringSet("name", "code"),
+}
+
+type searchResponse struct {
+	Data struct {
+		Items []struct {
+			Item struct {
+				// ID is decoded as a number literal, large ids don't fit the precision of float formatting.
+				ID json.Number `json:"id"`
+			} `json:"item"`
+		} `json:"items"`
+	} `json:"data"`
+}
+
+// SupportsUpsert reports whether Write honors common.WriteParams.UpsertKey.
+// Pipedrive has no upsert endpoint, the record is looked up by an exact match search and then written.
+func (c *Connector) SupportsUpsert(_ context.Context, objectName string, field string) (bool, error) {
+	return supportsUpsert(objectName, field), nil
+}
+
+func supportsUpsert(objectName string, field string) bool {
+	fields, ok := upsertFields[objectName]
+
+	return ok && fields.Has(field)
+}
+
+// upsert finds the record by the key and updates it, otherwise creates a new one.
+// The record is created as given, so it must carry the key value, field names of
+// the payload may differ from the searchable fields, e.g. "emails" of v2 persons.
+func (c *Connector) upsert(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	if err := params.ValidateUpsertParams(); err != nil {
+		return nil, err
+	}
+
+	if !supportsUpsert(params.ObjectName, params.UpsertKey.Field) {
+		return nil, fmt.Errorf("%w: %s cannot be upserted by %s",
+			common.ErrOperationNotSupportedForObject, params.ObjectName, params.UpsertKey.Field)
+	}
+
+	recordID, found, err := c.findByField(ctx, params.ObjectName, params.UpsertKey)
+	if err != nil {
+		return nil, err
+	}
+
+	write := params
+	write.UpsertKey = nil
+	outcome := common.WriteOutcomeCreated
+
+	if found {
+		write.RecordId = recordID
+		outcome = common.WriteOutcomeUpdated
+	}
+
+	result, err := c.Write(ctx, write)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Outcome = outcome
+	if found {
+		result.RecordId = recordID
+	}
+
+	return result, nil
+}
+
+func (c *Connector) findByField(ctx context.Context, objectName string, key *common.UpsertKey) (string, bool, error) {
+	url, err := c.getSearchURL(objectName)
+	if err != nil {
+		return "", false, err
+	}
+
+	url.WithQueryParam("term", fmt.Sprint(key.Value))
+	url.WithQueryParam("fields", key.Field)
+	url.WithQueryParam("exact_match", "true")
+	// Two are enough to tell that the key is not unique.
+	url.WithQueryParam("limit", "2")
+
+	resp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return "", false, err
+	}
+
+	response, err := common.UnmarshalJSON[searchResponse](resp)
+	if err != nil {
+		return "", false, err
+	}
+
+	if response == nil {
+		return "", false, common.ErrEmptyJSONHTTPResponse
+	}
+
+	items := response.Data.Items
+
+	switch len(items) {
+	case 0:
+		return "", false, nil
+	case 1:
+		identifier, err := items[0].Item.ID.Int64()
+		if err != nil {
+			return "", false, fmt.Errorf("%w: id %q of the found %s", common.ErrParseError, items[0].Item.ID, objectName)
+		}
+
+		return strconv.FormatInt(identifier, 10), true, nil
+	default:
+		return "", false, fmt.Errorf("%w: %s=%v", common.ErrUpsertKeyNotUnique, key.Field, key.Value)
+	}
+}
+
+// getSearchURL returns the search endpoint of the module's API version.
+// https://developers.pipedrive.com/docs/api/v1/ItemSearch
+func (c *Connector) getSearchURL(objectName string) (*urlbuilder.URL, error) {
+	version := "v1"
+	if c.crmAdapter != nil {
+		version = "api/v2"
+	}
+
+	return urlbuilder.New(c.moduleInfo.BaseURL, version, objectName, "search")
+}
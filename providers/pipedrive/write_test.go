@@ -19,6 +19,8 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 	updateActivityResponse := testutils.DataFromFile(t, "update-activity.json")
 	unsupportedResponse := testutils.DataFromFile(t, "not-found.json")
 	createActivityResponse := testutils.DataFromFile(t, "create-activity.json")
+	searchPersonsResponse := testutils.DataFromFile(t, "search-persons.json")
+	searchPersonsLargeIDResponse := testutils.DataFromFile(t, "search-persons-large-id.json")
 
 	tests := []testconn.TestCaseWrite{
 		{
@@ -129,6 +131,116 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Upsert key must be searchable",
+			Input: common.WriteParams{
+				ObjectName: "activities",
+				RecordData: map[string]any{"subject": "Call"},
+				UpsertKey:  &common.UpsertKey{Field: "subject", Value: "Call"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name: "Upsert updates the person found by email",
+			Input: common.WriteParams{
+				ObjectName: "persons",
+				RecordData: map[string]any{"name": "Jon Snow"},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "jon@winterfell.com"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/v1/persons/search"),
+						mockcond.QueryParam("term", "jon@winterfell.com"),
+						mockcond.QueryParam("fields", "email"),
+						mockcond.QueryParam("exact_match", "true"),
+					},
+					Then: mockserver.Response(http.StatusOK, searchPersonsResponse),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPUT(),
+						mockcond.Path("/v1/persons/12"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"success":true,"data":{"id":12,"name":"Jon Snow"}}`),
+				}},
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "12",
+				Data:     map[string]any{"name": "Jon Snow"},
+				Outcome:  common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Upsert updates the person found by a large id",
+			Input: common.WriteParams{
+				ObjectName: "persons",
+				RecordData: map[string]any{"name": "Jon Snow"},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "jon@winterfell.com"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/v1/persons/search"),
+					},
+					Then: mockserver.Response(http.StatusOK, searchPersonsLargeIDResponse),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPUT(),
+						mockcond.Path("/v1/persons/12345678"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK,
+						`{"success":true,"data":{"id":12345678,"name":"Jon Snow"}}`),
+				}},
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "12345678",
+				Data:     map[string]any{"name": "Jon Snow"},
+				Outcome:  common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Upsert creates a person when none matches",
+			Input: common.WriteParams{
+				ObjectName: "persons",
+				RecordData: map[string]any{"name": "Arya Stark", "email": "arya@winterfell.com"},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "arya@winterfell.com"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/v1/persons/search"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"success":true,"data":{"items":[]}}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/v1/persons"),
+					},
+					Then: mockserver.ResponseString(http.StatusCreated, `{"success":true,"data":{"id":13,"name":"Arya Stark"}}`),
+				}},
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "13",
+				Data:     map[string]any{"name": "Arya Stark"},
+				Outcome:  common.WriteOutcomeCreated,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
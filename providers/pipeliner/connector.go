@@ -77,6 +77,8 @@ func makeConstructor(params common.ConnectorParams) components.ConnectorConstruc
 			},
 		)
 
+		connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 		return connector, nil
 	}
 }
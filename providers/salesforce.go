@@ -69,6 +69,7 @@ func init() { // nolint:funlen
 						Delete: true,
 					},
 					Delete:    true,
+					Merge:     true,
 					Proxy:     true,
 					Read:      true,
 					Subscribe: true,
@@ -135,6 +136,7 @@ func init() { // nolint:funlen
 				Delete: true,
 			},
 			Delete:    true,
+			Merge:     true,
 			Proxy:     true,
 			Read:      true,
 			Subscribe: true,
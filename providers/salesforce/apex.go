@@ -13,6 +13,7 @@ import (
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/logging"
 	"github.com/amp-labs/connectors/internal/simultaneously"
+	crmcore "github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/metadata"
 )
 
@@ -123,7 +124,7 @@ func GenerateApexTriggerNameForCDC(objectName string) (string, error) {
 func (c *Connector) ApexTriggerExists(ctx context.Context, triggerName string) (bool, error) {
 	soql := fmt.Sprintf(
 		"SELECT Id FROM ApexTrigger WHERE Name = '%s'",
-		escapeSOQLString(triggerName),
+		crmcore.EscapeSOQLString(triggerName),
 	)
 
 	return c.toolingEntityExists(ctx, soql)
@@ -21,7 +21,11 @@ func (c *Connector) BulkRead(ctx context.Context, params common.ReadParams) (*Ge
 		return nil, err
 	}
 
-	soql := makeSOQL(params, c.GetTimestampColumn(common.ObjectName(params.ObjectName)))
+	soql, err := makeSOQL(params, c.GetTimestampColumn(common.ObjectName(params.ObjectName)))
+	if err != nil {
+		return nil, err
+	}
+
 	// Note: if params.Deleted is set to true query will return only removed items.
 
 	query := soql.String()
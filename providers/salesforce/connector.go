@@ -8,6 +8,7 @@ import (
 	"github.com/amp-labs/connectors/common/paramsbuilder"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/internal/components/reader"
 	"github.com/amp-labs/connectors/providers"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm"
 	crmcore "github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
@@ -27,6 +28,9 @@ import (
 type Connector struct {
 	*components.ProxyResolver
 
+	// QuotaTracker records the API usage reported with every response.
+	*common.QuotaTracker
+
 	Client *common.JSONHTTPClient
 
 	providerInfo *providers.ProviderInfo
@@ -47,6 +51,9 @@ type Connector struct {
 	// It provides dedicated support for Pardot-specific endpoints and metadata.
 	pardotAdapter *pardot.Adapter
 
+	// crmReader reads the CRM module, normalizing values on request.
+	crmReader components.Reader
+
 	// timestampColumn overrides the default "SystemModstamp" field used in
 	// incremental read queries (Since/Until WHERE clauses). When empty,
 	// "SystemModstamp" is used.
@@ -81,6 +88,11 @@ func NewConnector(opts ...Option) (*Connector, error) {
 		return nil, err
 	}
 
+	// Both the connector client and the module adapters report the quota to the same tracker.
+	conn.QuotaTracker = common.NewQuotaTracker(extractQuota)
+	conn.Client.HTTPClient.Client = conn.QuotaTracker.Client(conn.Client.HTTPClient.Client)
+	connectorParams.AuthenticatedClient = conn.QuotaTracker.Client(connectorParams.AuthenticatedClient)
+
 	// Initialize the Pardot (Account Engagement) adapter if applicable.
 	// Otherwise, initialize default Salesforce CRM module.
 	// Operations are delegated to either one.
@@ -99,6 +111,7 @@ func NewConnector(opts ...Option) (*Connector, error) {
 		}
 
 		conn.ProxyResolver = conn.crmAdapter.Connector.ProxyResolver
+		conn.crmReader = reader.NewNormalizingReader(reader.NewDelegateReader(conn.readCRM), conn)
 	}
 
 	return conn, nil
@@ -7,8 +7,20 @@ import (
 	"github.com/amp-labs/connectors/common"
 )
 
+var (
+	_ connectors.MergeConnector        = &Connector{}
+	_ connectors.FieldHistoryConnector = &Connector{}
+)
+
+// Search finds records matching field filters using SOQL,
+// or records matching a free-text term across objects using SOSL, see common.SearchParams.Text.
 func (c *Connector) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
-	if err := params.ValidateParams(true); err != nil {
+	validate := params.ValidateParams
+	if params.IsTextSearch() {
+		validate = params.ValidateTextSearch
+	}
+
+	if err := validate(true); err != nil {
 		return nil, err
 	}
 
@@ -36,3 +48,40 @@ func (c *Connector) Delete(ctx context.Context, params connectors.DeleteParams)
 
 	return nil, common.ErrNotImplemented
 }
+
+// Merge merges duplicate accounts, contacts, leads, cases or individuals into the winner.
+func (c *Connector) Merge(ctx context.Context, params connectors.MergeParams) (*connectors.MergeResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if c.crmAdapter != nil {
+		return c.crmAdapter.Merge(ctx, params)
+	}
+
+	// Account Engagement has no merge endpoint.
+
+	return nil, common.ErrNotImplemented
+}
+
+// ReadFieldHistory returns changes of tracked fields from the object's history, such as OpportunityFieldHistory.
+// Fields must have history tracking enabled in the org, changes moved by Field Audit Trail are read with Archived.
+func (c *Connector) ReadFieldHistory(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*common.FieldHistoryResult, error) {
+	if params == nil {
+		return nil, common.ErrMissingObjects
+	}
+
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if c.crmAdapter != nil {
+		return c.crmAdapter.ReadFieldHistory(ctx, params)
+	}
+
+	// Account Engagement has no field history.
+
+	return nil, common.ErrNotImplemented
+}
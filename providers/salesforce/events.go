@@ -11,6 +11,7 @@ import (
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/logging"
+	crmcore "github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
 	"github.com/spyzhov/ajson"
 )
 
@@ -536,7 +537,7 @@ func (c *Connector) CustomFieldExists(
 	developerName := strings.TrimSuffix(fieldAPIName, "__c")
 	soql := fmt.Sprintf(
 		"SELECT Id FROM CustomField WHERE TableEnumOrId = '%s' AND DeveloperName = '%s'",
-		escapeSOQLString(objectName), escapeSOQLString(developerName),
+		crmcore.EscapeSOQLString(objectName), crmcore.EscapeSOQLString(developerName),
 	)
 
 	return c.toolingEntityExists(ctx, soql)
@@ -724,7 +725,7 @@ func (c *Connector) findToolingEntityIDByDeveloperName(
 	}
 
 	soql := fmt.Sprintf("SELECT Id FROM %s WHERE DeveloperName = '%s'",
-		objectType, escapeSOQLString(developerName))
+		objectType, crmcore.EscapeSOQLString(developerName))
 	location.WithQueryParam("q", soql)
 
 	resp, err := c.Client.Get(ctx, location.String())
@@ -786,12 +787,3 @@ func getToolingEntityByID[T any](
 
 	return common.UnmarshalJSON[T](resp)
 }
-
-// escapeSOQLString escapes a value for safe inclusion in a SOQL string
-// literal. Backslashes must be escaped before single quotes.
-func escapeSOQLString(s string) string {
-	s = strings.ReplaceAll(s, `\`, `\\`)
-	s = strings.ReplaceAll(s, `'`, `\'`)
-
-	return s
-}
@@ -0,0 +1,253 @@
+package salesforce
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadFieldHistory(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseFirstPage := testutils.DataFromFile(t, "field-history/opportunity-history-first-page.json")
+	responseLastPage := testutils.DataFromFile(t, "field-history/opportunity-history-last-page.json")
+	responseArchive := testutils.DataFromFile(t, "field-history/archive-accounts.json")
+
+	tests := []readFieldHistoryTestCase{
+		{
+			Name:         "Object name must be given",
+			Input:        &common.FieldHistoryParams{Fields: connectors.Fields("StageName")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name:         "At least one field must be given",
+			Input:        &common.FieldHistoryParams{ObjectName: "Opportunity"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingFields},
+		},
+		{
+			Name: "Archived changes are read for given records only",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("owner"),
+				Archived:   true,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name: "Opportunity changes since a time are read from its field history",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "Opportunity",
+				Fields:     connectors.Fields("StageName", "Amount"),
+				Since:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/services/data/v60.0/query"),
+					mockcond.QueryParam("q", "SELECT Id,OpportunityId,Field,OldValue,NewValue,CreatedDate,CreatedById "+
+						"FROM OpportunityFieldHistory "+
+						"WHERE Field IN ('Amount','StageName') AND CreatedDate >= 2026-10-01T00:00:00Z "+
+						"ORDER BY CreatedDate,Id"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseFirstPage),
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows: []common.FieldChange{{
+					RecordID:  "006ak00000NiZ5cAAF",
+					Field:     "StageName",
+					OldValue:  "Prospecting",
+					NewValue:  "Negotiation/Review",
+					ChangedAt: time.Date(2026, 10, 2, 14, 0, 0, 0, time.UTC),
+					ChangedBy: "005ak00000CYBwTAAX",
+				}, {
+					RecordID:  "006ak00000NiZ5cAAF",
+					Field:     "Amount",
+					OldValue:  nil,
+					NewValue:  float64(45000),
+					ChangedAt: time.Date(2026, 10, 2, 14, 0, 0, 0, time.UTC),
+					ChangedBy: "005ak00000CYBwTAAX",
+				}},
+				NextPage: "/services/data/v60.0/query/0r8ak00000HyyQqAAJ-2000",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page of changes is read by its URL",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "Opportunity",
+				Fields:     connectors.Fields("StageName", "Amount"),
+				NextPage:   "/services/data/v60.0/query/0r8ak00000HyyQqAAJ-2000",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/query/0r8ak00000HyyQqAAJ-2000"),
+				Then:  mockserver.Response(http.StatusOK, responseLastPage),
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows: []common.FieldChange{{
+					RecordID:  "006ak00000NiZ5cAAF",
+					Field:     "StageName",
+					OldValue:  "Negotiation/Review",
+					NewValue:  "Closed Won",
+					ChangedAt: time.Date(2026, 10, 9, 16, 45, 12, 0, time.UTC),
+					ChangedBy: "005ak00000CYBwUAAX",
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Custom object changes of given records are read from its history object",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "Car__c",
+				Fields:     connectors.Fields("Color__c"),
+				RecordIDs:  []string{"a01ak00000B7kDmAAJ"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/services/data/v60.0/query"),
+					mockcond.QueryParam("q", "SELECT Id,ParentId,Field,OldValue,NewValue,CreatedDate,CreatedById "+
+						"FROM Car__History "+
+						"WHERE Field IN ('Color__c') AND ParentId IN ('a01ak00000B7kDmAAJ') "+
+						"ORDER BY CreatedDate,Id"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"totalSize":0,"done":true,"records":[]}`),
+			}.Server(),
+			Expected: &common.FieldHistoryResult{
+				Rows: []common.FieldChange{},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Archived changes are filtered by field and time after the query",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("owner"),
+				RecordIDs:  []string{"001ak00000OQTifAAH", "001ak00000OQTmoAAH"},
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Archived:   true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/services/data/v60.0/query"),
+					mockcond.QueryParam("q", "SELECT Id,ParentId,Field,OldValue,NewValue,CreatedDate,CreatedById "+
+						"FROM FieldHistoryArchive "+
+						"WHERE FieldHistoryType = 'Account' AND ParentId IN ('001ak00000OQTifAAH','001ak00000OQTmoAAH')"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseArchive),
+			}.Server(),
+			Comparator: compareFieldHistory,
+			Expected: &common.FieldHistoryResult{
+				Rows: []common.FieldChange{{
+					RecordID:  "001ak00000OQTifAAH",
+					Field:     "Owner",
+					OldValue:  "005ak00000CYBwTAAX",
+					NewValue:  "005ak00000CYBwUAAX",
+					ChangedAt: time.Date(2024, 3, 11, 10, 20, 30, 0, time.UTC),
+					ChangedBy: "005ak00000CYBwTAAX",
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestReadFieldHistoryAccountEngagement(t *testing.T) {
+	t.Parallel()
+
+	tests := []readFieldHistoryTestCase{
+		{
+			Name: "Account Engagement has no field history",
+			Input: &common.FieldHistoryParams{
+				ObjectName: "prospects",
+				Fields:     connectors.Fields("score"),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrNotImplemented},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnectorAccountEngagement(tt.Server.URL)
+			})
+		})
+	}
+}
+
+// compareFieldHistory ignores the raw history rows, which only have to be present.
+func compareFieldHistory(_ string, actual, expected *common.FieldHistoryResult) *testutils.CompareResult {
+	result := testutils.NewCompareResult()
+
+	if actual == nil || expected == nil {
+		result.Assert("history", expected, actual)
+
+		return result
+	}
+
+	page := *actual
+	page.Rows = make([]common.FieldChange, len(actual.Rows))
+
+	for index, row := range actual.Rows {
+		if len(row.Raw) == 0 {
+			result.AddDiff("raw change %v is missing", index)
+		}
+
+		row.Raw = nil
+		page.Rows[index] = row
+	}
+
+	result.Assert("history", *expected, page)
+
+	return result
+}
+
+type (
+	testCaseTypeReadFieldHistory = testconn.TestCase[*common.FieldHistoryParams, *common.FieldHistoryResult]
+	readFieldHistoryTestCase     testCaseTypeReadFieldHistory
+)
+
+func (c readFieldHistoryTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeReadFieldHistory(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.ReadFieldHistory(t.Context(), c.Input)
+	testCaseTypeReadFieldHistory(c).Validate(t, err, output)
+}
@@ -0,0 +1,47 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/graph"
+)
+
+type (
+	// GraphNode is one record write of a graph, see internal package for details.
+	GraphNode = graph.Node
+	// GraphWriteParams lists the nodes written in one transaction.
+	GraphWriteParams = graph.Params
+	// GraphWriteResult maps reference ids of the nodes to their write results.
+	GraphWriteResult = graph.Result
+)
+
+var (
+	ErrEmptyGraph           = graph.ErrEmptyGraph
+	ErrGraphTooLarge        = graph.ErrGraphTooLarge
+	ErrGraphTooDeep         = graph.ErrGraphTooDeep
+	ErrInvalidReferenceID   = graph.ErrInvalidReferenceID
+	ErrDuplicateReferenceID = graph.ErrDuplicateReferenceID
+	ErrUnknownReference     = graph.ErrUnknownReference
+	ErrReferenceCycle       = graph.ErrReferenceCycle
+)
+
+// GraphWrite creates and updates related records of several objects all or none.
+// For example, an Account with its Contacts and an Opportunity is written by nodes
+// where the Contacts and the Opportunity set "AccountId" to "@{newAccount.id}".
+//
+// A failure of any node rolls back the whole graph, then nodes which would have succeeded
+// report common.ErrBatchUnprocessedRecord.
+func (c *Connector) GraphWrite(ctx context.Context, params *GraphWriteParams) (*GraphWriteResult, error) {
+	if params == nil {
+		return nil, ErrEmptyGraph
+	}
+
+	if c.crmAdapter != nil {
+		return c.crmAdapter.GraphWrite(ctx, params)
+	}
+
+	// Account Engagement has no composite resources.
+
+	return nil, common.ErrNotImplemented
+}
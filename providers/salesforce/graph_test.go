@@ -0,0 +1,160 @@
+package salesforce
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/graph"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestGraphWrite(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	payload := testutils.DataFromFile(t, "graph/account-with-contacts-payload.json")
+	responseSuccess := testutils.DataFromFile(t, "graph/account-with-contacts-success.json")
+	responseFailure := testutils.DataFromFile(t, "graph/account-with-contacts-failure.json")
+
+	// Listed out of order, the account must be created first.
+	accountWithContacts := &GraphWriteParams{Nodes: []GraphNode{{
+		ReferenceID: "existingOpportunity",
+		ObjectName:  "Opportunity",
+		RecordID:    "006ak00000BJ4TGAA1",
+		Record:      map[string]any{"AccountId": "@{newAccount.id}", "ContactId": "@{newContact.id}"},
+	}, {
+		ReferenceID: "newContact",
+		ObjectName:  "Contact",
+		Record:      map[string]any{"AccountId": "@{newAccount.id}", "LastName": "Dyer"},
+	}, {
+		ReferenceID: "newAccount",
+		ObjectName:  "Account",
+		Record:      map[string]any{"Name": "Acme"},
+	}}}
+
+	tests := []graphWriteTestCase{
+		{
+			Name:         "Graph must be given",
+			Input:        nil,
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrEmptyGraph},
+		},
+		{
+			Name:         "Graph must have nodes",
+			Input:        &GraphWriteParams{},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrEmptyGraph},
+		},
+		{
+			Name: "Reference ids must be valid",
+			Input: &GraphWriteParams{Nodes: []GraphNode{
+				{ReferenceID: "new-account", ObjectName: "Account"},
+			}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrInvalidReferenceID},
+		},
+		{
+			Name: "Reference ids must be unique",
+			Input: &GraphWriteParams{Nodes: []GraphNode{
+				{ReferenceID: "newAccount", ObjectName: "Account"},
+				{ReferenceID: "newAccount", ObjectName: "Account"},
+			}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrDuplicateReferenceID},
+		},
+		{
+			Name: "References must point to nodes of the graph",
+			Input: &GraphWriteParams{Nodes: []GraphNode{
+				{ReferenceID: "newContact", ObjectName: "Contact", Record: map[string]any{"AccountId": "@{newAccount.id}"}},
+			}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrUnknownReference},
+		},
+		{
+			Name: "References cannot form a cycle",
+			Input: &GraphWriteParams{Nodes: []GraphNode{
+				{ReferenceID: "first", ObjectName: "Account", Record: map[string]any{"ParentId": "@{second.id}"}},
+				{ReferenceID: "second", ObjectName: "Account", Record: map[string]any{"ParentId": "@{first.id}"}},
+			}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrReferenceCycle},
+		},
+		{
+			Name:  "Nodes are written in dependency order",
+			Input: accountWithContacts,
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/services/data/v60.0/composite/graph"),
+					mockcond.Body(string(payload)),
+				},
+				Then: mockserver.Response(http.StatusOK, responseSuccess),
+			}.Server(),
+			Expected: &GraphWriteResult{
+				Success: true,
+				Results: map[string]*common.WriteResult{
+					"newAccount":          {Success: true, RecordId: "001ak00000OQTieAAH"},
+					"newContact":          {Success: true, RecordId: "003ak00000GkLmNAAV"},
+					"existingOpportunity": {Success: true, RecordId: "006ak00000BJ4TGAA1"},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Failed node rolls back the graph",
+			Input: accountWithContacts,
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/composite/graph"),
+				Then:  mockserver.Response(http.StatusOK, responseFailure),
+			}.Server(),
+			Expected: &GraphWriteResult{
+				Success: false,
+				Results: map[string]*common.WriteResult{
+					"newAccount": {Success: false, Errors: []any{common.ErrBatchUnprocessedRecord}},
+					"newContact": {Success: false, Errors: []any{graph.NodeError{
+						ErrorCode: "REQUIRED_FIELD_MISSING",
+						Message:   "Required fields are missing: [LastName]",
+						Fields:    []any{"LastName"},
+					}}},
+					"existingOpportunity": {Success: false, RecordId: "006ak00000BJ4TGAA1", Errors: []any{graph.NodeError{
+						ErrorCode: "PROCESSING_HALTED",
+						Message:   "Invalid reference specified. No value for newContact.id found in newContact.",
+					}}},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+type (
+	testCaseTypeGraphWrite = testconn.TestCase[*GraphWriteParams, *GraphWriteResult]
+	graphWriteTestCase     testCaseTypeGraphWrite
+)
+
+func (c graphWriteTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeGraphWrite(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.GraphWrite(t.Context(), c.Input)
+	testCaseTypeGraphWrite(c).Validate(t, err, output)
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/amp-labs/connectors/providers"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/batch"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/graph"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/history"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/merge"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/metadata"
 	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/search"
 )
@@ -33,6 +36,9 @@ type Adapter struct {
 	customAdapter  *metadata.Adapter // used for connectors.UpsertMetadataConnector capabilities.
 	batchAdapter   *batch.Adapter    // used for connectors.BatchWriteConnector capabilities.
 	searchStrategy *search.Strategy  // used for connectors.SearchConnector capabilities.
+	mergeAdapter   *merge.Adapter    // used for connectors.MergeConnector capabilities.
+	graphAdapter   *graph.Adapter    // used for transactional writes across objects.
+	historyAdapter *history.Adapter  // used for connectors.FieldHistoryConnector capabilities.
 }
 
 // NewAdapter creates a new crm Adapter configured to work with Salesforce's APIs.
@@ -64,6 +70,9 @@ func constructor(base *components.Connector) (*Adapter, error) {
 	adapter.customAdapter = metadata.NewAdapter(adapter.HTTPClient(), adapter.JSONHTTPClient(), adapter.ModuleInfo())
 	adapter.batchAdapter = batch.NewAdapter(adapter.HTTPClient(), adapter.ModuleInfo())
 	adapter.searchStrategy = search.NewStrategy(adapter.JSONHTTPClient(), adapter.ModuleInfo())
+	adapter.mergeAdapter = merge.NewAdapter(adapter.HTTPClient(), adapter.ModuleInfo())
+	adapter.graphAdapter = graph.NewAdapter(adapter.JSONHTTPClient(), adapter.ModuleInfo())
+	adapter.historyAdapter = history.NewAdapter(adapter.JSONHTTPClient(), adapter.ModuleInfo())
 
 	return adapter, nil
 }
@@ -92,6 +101,23 @@ func (a Adapter) Search(ctx context.Context, params *common.SearchParams) (*comm
 	return a.searchStrategy.Search(ctx, params)
 }
 
+func (a Adapter) Merge(ctx context.Context, params common.MergeParams) (*common.MergeResult, error) {
+	// Delegated.
+	return a.mergeAdapter.Merge(ctx, params)
+}
+
+func (a Adapter) GraphWrite(ctx context.Context, params *graph.Params) (*graph.Result, error) {
+	// Delegated.
+	return a.graphAdapter.Write(ctx, params)
+}
+
+func (a Adapter) ReadFieldHistory(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*common.FieldHistoryResult, error) {
+	// Delegated.
+	return a.historyAdapter.ReadFieldHistory(ctx, params)
+}
+
 func (a Adapter) DeployMetadataZip(ctx context.Context, zipData []byte) (string, error) {
 	// Delegated.
 	return a.customAdapter.DeployMetadataZip(ctx, zipData)
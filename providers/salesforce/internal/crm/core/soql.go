@@ -23,6 +23,7 @@ type SOQLBuilder struct {
 	fields string
 	from   string
 	where  []string
+	order  string
 	limit  string
 }
 
@@ -53,6 +54,13 @@ func (s *SOQLBuilder) Limit(limit int64) *SOQLBuilder {
 	return s
 }
 
+// OrderBy sorts the rows by the fields, ascending.
+func (s *SOQLBuilder) OrderBy(fields ...string) *SOQLBuilder {
+	s.order = strings.Join(fields, ",")
+
+	return s
+}
+
 func (s *SOQLBuilder) Where(condition string) *SOQLBuilder {
 	if s.where == nil {
 		s.where = make([]string, 0)
@@ -90,9 +98,22 @@ func (s *SOQLBuilder) String() string {
 		query += " WHERE " + strings.Join(s.where, " AND ")
 	}
 
+	if len(s.order) != 0 {
+		query += " ORDER BY " + s.order
+	}
+
 	if len(s.limit) != 0 {
 		query += " LIMIT " + s.limit
 	}
 
 	return query
 }
+
+// EscapeSOQLString escapes a value for safe inclusion in a SOQL string literal.
+// Backslashes must be escaped before single quotes.
+func EscapeSOQLString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+
+	return value
+}
@@ -0,0 +1,26 @@
+package graph
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
+)
+
+// Adapter writes records of several objects in one transaction via the Composite Graph API.
+type Adapter struct {
+	Client     *common.JSONHTTPClient
+	moduleInfo *providers.ModuleInfo
+}
+
+func NewAdapter(client *common.JSONHTTPClient, moduleInfo *providers.ModuleInfo) *Adapter {
+	return &Adapter{
+		Client:     client,
+		moduleInfo: moduleInfo,
+	}
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_graph.htm
+func (a *Adapter) getGraphURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, core.RestAPISuffix, "composite/graph")
+}
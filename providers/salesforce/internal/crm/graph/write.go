@@ -0,0 +1,390 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/internal/httpkit"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
+)
+
+// Composite Graph limits.
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/composite_graph_limits.htm
+const (
+	maxNodes = 500
+	maxDepth = 15
+)
+
+var (
+	ErrEmptyGraph           = errors.New("graph has no nodes")
+	ErrGraphTooLarge        = fmt.Errorf("graph is limited to %d nodes", maxNodes)
+	ErrGraphTooDeep         = fmt.Errorf("graph is limited to a depth of %d nodes", maxDepth)
+	ErrInvalidReferenceID   = errors.New("reference id must start with a letter and contain only letters, digits and underscores") // nolint:lll
+	ErrDuplicateReferenceID = errors.New("reference id is used by more than one node")
+	ErrUnknownReference     = errors.New("node refers to an unknown reference id")
+	ErrReferenceCycle       = errors.New("node references form a cycle")
+)
+
+var (
+	referenceIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	// referencePattern finds "@{refId.field}" expressions, which the API replaces with values of written records.
+	referencePattern = regexp.MustCompile(`@\{([A-Za-z][A-Za-z0-9_]*)\.`)
+)
+
+// Node is one record write of the graph.
+type Node struct {
+	// ReferenceID names the node. Other nodes use "@{ReferenceID.id}" in Record values
+	// or as RecordID to refer to the record written by this node.
+	ReferenceID string
+	ObjectName  string
+	// RecordID selects the record to update. A record is created when it is empty.
+	RecordID string
+	Record   map[string]any
+}
+
+// Params describes records of several objects which are written all or none.
+type Params struct {
+	// Nodes may be listed in any order, they are sent after the nodes they refer to.
+	Nodes []Node
+}
+
+// Result of the graph.
+type Result struct {
+	// Success is true when every node was written. Otherwise, nothing was written.
+	Success bool
+	// Results holds the outcome of every node keyed by its reference id.
+	Results map[string]*common.WriteResult
+}
+
+// Write executes nodes in one transaction, if any node fails, all changes are rolled back.
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_graph_introduction.htm
+func (a *Adapter) Write(ctx context.Context, params *Params) (*Result, error) {
+	nodes, err := params.order()
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.getGraphURL()
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := a.Client.Post(ctx, url.String(), newPayload(nodes))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.UnmarshalJSON[Response](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || len(response.Graphs) == 0 {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return response.Graphs[0].toResult(nodes)
+}
+
+// order validates the graph and sorts nodes so that each comes after the nodes it refers to.
+// Nodes without dependencies between them keep the order given by the caller.
+func (p *Params) order() ([]Node, error) {
+	if len(p.Nodes) == 0 {
+		return nil, ErrEmptyGraph
+	}
+
+	if len(p.Nodes) > maxNodes {
+		return nil, ErrGraphTooLarge
+	}
+
+	indices := make(map[string]int, len(p.Nodes))
+
+	for index, node := range p.Nodes {
+		if node.ObjectName == "" {
+			return nil, fmt.Errorf("%w: node %s", common.ErrMissingObjects, node.ReferenceID)
+		}
+
+		if !referenceIDPattern.MatchString(node.ReferenceID) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidReferenceID, node.ReferenceID)
+		}
+
+		if _, found := indices[node.ReferenceID]; found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateReferenceID, node.ReferenceID)
+		}
+
+		indices[node.ReferenceID] = index
+	}
+
+	dependencies := make([][]int, len(p.Nodes))
+
+	for index, node := range p.Nodes {
+		for _, reference := range node.references() {
+			dependency, found := indices[reference]
+			if !found {
+				return nil, fmt.Errorf("%w: %s refers to %s", ErrUnknownReference, node.ReferenceID, reference)
+			}
+
+			dependencies[index] = append(dependencies[index], dependency)
+		}
+	}
+
+	return p.sort(dependencies)
+}
+
+// sort is a depth first topological sort, which also measures the depth of the graph.
+func (p *Params) sort(dependencies [][]int) ([]Node, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(p.Nodes))
+	depth := make([]int, len(p.Nodes))
+	ordered := make([]Node, 0, len(p.Nodes))
+
+	var visit func(index int) error
+
+	visit = func(index int) error {
+		switch state[index] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: %s", ErrReferenceCycle, p.Nodes[index].ReferenceID)
+		}
+
+		state[index] = visiting
+		depth[index] = 1
+
+		for _, dependency := range dependencies[index] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+
+			depth[index] = max(depth[index], depth[dependency]+1)
+		}
+
+		if depth[index] > maxDepth {
+			return fmt.Errorf("%w: %s", ErrGraphTooDeep, p.Nodes[index].ReferenceID)
+		}
+
+		state[index] = visited
+		ordered = append(ordered, p.Nodes[index])
+
+		return nil
+	}
+
+	for index := range p.Nodes {
+		if err := visit(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// references lists reference ids used by the node, possibly with duplicates.
+func (n Node) references() []string {
+	var references []string
+
+	collect := func(text string) {
+		for _, match := range referencePattern.FindAllStringSubmatch(text, -1) {
+			references = append(references, match[1])
+		}
+	}
+
+	collect(n.RecordID)
+
+	var walk func(value any)
+
+	walk = func(value any) {
+		switch value := value.(type) {
+		case string:
+			collect(value)
+		case map[string]any:
+			for _, nested := range value {
+				walk(nested)
+			}
+		case []any:
+			for _, nested := range value {
+				walk(nested)
+			}
+		}
+	}
+
+	walk(n.Record)
+
+	return references
+}
+
+// Payload is the request body of the Composite Graph API, a single graph is sent at a time.
+type Payload struct {
+	Graphs []PayloadGraph `json:"graphs"`
+}
+
+type PayloadGraph struct {
+	GraphID          string           `json:"graphId"`
+	CompositeRequest []PayloadRequest `json:"compositeRequest"`
+}
+
+type PayloadRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	ReferenceID string         `json:"referenceId"`
+	Body        map[string]any `json:"body,omitempty"`
+}
+
+func newPayload(nodes []Node) *Payload {
+	requests := make([]PayloadRequest, len(nodes))
+
+	for index, node := range nodes {
+		request := PayloadRequest{
+			Method:      http.MethodPost,
+			URL:         core.URISobjects + "/" + node.ObjectName,
+			ReferenceID: node.ReferenceID,
+			Body:        node.Record,
+		}
+
+		if node.RecordID != "" {
+			request.Method = http.MethodPatch
+			request.URL += "/" + node.RecordID
+		}
+
+		requests[index] = request
+	}
+
+	return &Payload{
+		Graphs: []PayloadGraph{{
+			GraphID:          "graph",
+			CompositeRequest: requests,
+		}},
+	}
+}
+
+type Response struct {
+	Graphs []ResponseGraph `json:"graphs"`
+}
+
+type ResponseGraph struct {
+	GraphID       string `json:"graphId"`
+	IsSuccessful  bool   `json:"isSuccessful"`
+	GraphResponse struct {
+		CompositeResponse []ResponseNode `json:"compositeResponse"`
+	} `json:"graphResponse"`
+}
+
+// ResponseNode holds the response of a single node.
+// The body is a save result on success, nothing for updates, or a list of errors on failure.
+type ResponseNode struct {
+	Body           json.RawMessage `json:"body"`
+	HTTPStatusCode int             `json:"httpStatusCode"`
+	ReferenceID    string          `json:"referenceId"`
+}
+
+type saveResult struct {
+	ID string `json:"id"`
+}
+
+type NodeError struct {
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+	Fields    []any  `json:"fields,omitempty"`
+}
+
+func (g ResponseGraph) toResult(nodes []Node) (*Result, error) {
+	responses := make(map[string]ResponseNode, len(g.GraphResponse.CompositeResponse))
+	for _, response := range g.GraphResponse.CompositeResponse {
+		responses[response.ReferenceID] = response
+	}
+
+	result := &Result{
+		Success: g.IsSuccessful,
+		Results: make(map[string]*common.WriteResult, len(nodes)),
+	}
+
+	// Nodes are in dependency order, so references of updated record ids are already resolved.
+	for _, node := range nodes {
+		response, found := responses[node.ReferenceID]
+		if !found {
+			result.Results[node.ReferenceID] = unprocessedResult()
+
+			continue
+		}
+
+		writeResult, err := response.toWriteResult(node, result.Results)
+		if err != nil {
+			return nil, err
+		}
+
+		if !g.IsSuccessful && writeResult.Success {
+			// Rolled back by the failure of another node.
+			writeResult = unprocessedResult()
+		}
+
+		result.Results[node.ReferenceID] = writeResult
+	}
+
+	return result, nil
+}
+
+func (r ResponseNode) toWriteResult(node Node, written map[string]*common.WriteResult) (*common.WriteResult, error) {
+	if !httpkit.Status2xx(r.HTTPStatusCode) {
+		var nodeErrors []NodeError
+		if err := json.Unmarshal(r.Body, &nodeErrors); err != nil {
+			return nil, fmt.Errorf("%w: node %s: %w", common.ErrBatchUnprocessedRecord, node.ReferenceID, err)
+		}
+
+		return &common.WriteResult{
+			Success:  false,
+			RecordId: resolveRecordID(node.RecordID, written),
+			Errors:   datautils.ToAnySlice(nodeErrors),
+		}, nil
+	}
+
+	if node.RecordID != "" {
+		// Updates respond with 204 No Content.
+		return &common.WriteResult{
+			Success:  true,
+			RecordId: resolveRecordID(node.RecordID, written),
+		}, nil
+	}
+
+	var created saveResult
+	if err := json.Unmarshal(r.Body, &created); err != nil {
+		return nil, fmt.Errorf("%w: node %s: %w", common.ErrBatchUnprocessedRecord, node.ReferenceID, err)
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: created.ID,
+	}, nil
+}
+
+// resolveRecordID replaces the "@{refId.id}" reference with the id of the record written by that node.
+func resolveRecordID(recordID string, written map[string]*common.WriteResult) string {
+	reference, isReference := strings.CutPrefix(recordID, "@{")
+	if !isReference {
+		return recordID
+	}
+
+	referenceID, _, _ := strings.Cut(reference, ".")
+	if result, found := written[referenceID]; found {
+		return result.RecordId
+	}
+
+	return ""
+}
+
+func unprocessedResult() *common.WriteResult {
+	return &common.WriteResult{
+		Success: false,
+		Errors:  []any{common.ErrBatchUnprocessedRecord},
+	}
+}
@@ -0,0 +1,35 @@
+package history
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
+)
+
+// Adapter reads past changes of record fields.
+// Salesforce records them in a history object per tracked object, such as AccountHistory,
+// and moves old changes into FieldHistoryArchive when Field Audit Trail is enabled.
+//
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_associated_objects_history.htm
+type Adapter struct {
+	Client     *common.JSONHTTPClient
+	moduleInfo *providers.ModuleInfo
+}
+
+func NewAdapter(client *common.JSONHTTPClient, moduleInfo *providers.ModuleInfo) *Adapter {
+	return &Adapter{
+		Client:     client,
+		moduleInfo: moduleInfo,
+	}
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_query.htm
+func (a *Adapter) getQueryURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, core.RestAPISuffix, "query")
+}
+
+// getModuleURL resolves the relative URL of the next page of query results.
+func (a *Adapter) getModuleURL(path string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, path)
+}
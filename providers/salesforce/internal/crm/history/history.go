@@ -0,0 +1,124 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// timestampLayout is how Salesforce formats datetime fields, e.g. "2026-10-02T14:00:00.000+0000".
+const timestampLayout = "2006-01-02T15:04:05.000-0700"
+
+// ReadFieldHistory returns the changes of the requested fields, oldest first.
+// With Archived the changes of the given records are read from FieldHistoryArchive instead of the history object.
+// The page token is the URL of the next page of query results.
+func (a *Adapter) ReadFieldHistory(
+	ctx context.Context, params *common.FieldHistoryParams,
+) (*common.FieldHistoryResult, error) {
+	if params.Archived && len(params.RecordIDs) == 0 {
+		return nil, fmt.Errorf("%w: archived history is read for given records only", common.ErrMissingRecordID)
+	}
+
+	url, err := a.buildHistoryURL(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.UnmarshalJSON[queryResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	_, parent := historyObject(params.ObjectName.String())
+	if params.Archived {
+		parent = parentField
+	}
+
+	// Field names are compared the way SOQL does, ignoring case.
+	fields := datautils.NewStringSet()
+	for field := range params.Fields {
+		fields.AddOne(strings.ToLower(field))
+	}
+
+	rows := make([]common.FieldChange, 0, len(response.Records))
+
+	for _, record := range response.Records {
+		change, err := newFieldChange(record, parent)
+		if err != nil {
+			return nil, err
+		}
+
+		// Archive query cannot filter by these, see makeArchiveSOQL.
+		if !fields.Has(strings.ToLower(change.Field)) || change.ChangedAt.Before(params.Since) {
+			continue
+		}
+
+		rows = append(rows, *change)
+	}
+
+	return &common.FieldHistoryResult{
+		Rows:     rows,
+		NextPage: common.NextPageToken(response.NextRecordsURL),
+		Done:     response.NextRecordsURL == "",
+	}, nil
+}
+
+func (a *Adapter) buildHistoryURL(params *common.FieldHistoryParams) (*urlbuilder.URL, error) {
+	if len(params.NextPage) != 0 {
+		return a.getModuleURL(params.NextPage.String())
+	}
+
+	url, err := a.getQueryURL()
+	if err != nil {
+		return nil, err
+	}
+
+	soql := makeHistorySOQL(params)
+	if params.Archived {
+		soql = makeArchiveSOQL(params)
+	}
+
+	url.WithQueryParam("q", soql.String())
+
+	return url, nil
+}
+
+type queryResponse struct {
+	Records        []map[string]any `json:"records"`
+	NextRecordsURL string           `json:"nextRecordsUrl"`
+}
+
+// newFieldChange converts a row of the history object, where parent is the field referencing the changed record.
+func newFieldChange(record map[string]any, parent string) (*common.FieldChange, error) {
+	createdDate, _ := record["CreatedDate"].(string)
+
+	changedAt, err := time.Parse(timestampLayout, createdDate)
+	if err != nil {
+		return nil, fmt.Errorf("history row %v: %w", record["Id"], err)
+	}
+
+	parentID, _ := common.ToStringMap(record).GetCaseInsensitive(parent)
+	recordID, _ := parentID.(string)
+	field, _ := record["Field"].(string)
+	changedBy, _ := record["CreatedById"].(string)
+
+	return &common.FieldChange{
+		RecordID:  recordID,
+		Field:     field,
+		OldValue:  record["OldValue"],
+		NewValue:  record["NewValue"],
+		ChangedAt: changedAt.UTC(),
+		ChangedBy: changedBy,
+		Raw:       record,
+	}, nil
+}
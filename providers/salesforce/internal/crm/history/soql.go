@@ -0,0 +1,105 @@
+package history
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
+)
+
+const (
+	// archiveObject holds the changes moved out of history objects by Field Audit Trail.
+	// nolint:lll
+	// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_fieldhistoryarchive.htm
+	archiveObject = "FieldHistoryArchive"
+
+	// parentField is the field referencing the changed record in archive and custom object history.
+	parentField = "ParentId"
+
+	customObjectSuffix  = "__c"
+	customHistorySuffix = "__History"
+)
+
+// historyObjects lists the standard objects whose history object does not follow the "<Object>History" name.
+var historyObjects = map[string]string{ // nolint:gochecknoglobals
+	"opportunity": "OpportunityFieldHistory",
+}
+
+// historyObject returns the object recording changes of the given object and its field referencing the record.
+// Custom objects "Car__c" are tracked by "Car__History" using ParentId,
+// standard objects "Account" by "AccountHistory" using AccountId.
+func historyObject(objectName string) (string, string) {
+	if name, ok := strings.CutSuffix(objectName, customObjectSuffix); ok {
+		return name + customHistorySuffix, parentField
+	}
+
+	if name, ok := historyObjects[strings.ToLower(objectName)]; ok {
+		return name, objectName + "Id"
+	}
+
+	return objectName + "History", objectName + "Id"
+}
+
+// makeHistorySOQL queries the history object for changes of the fields, oldest first.
+func makeHistorySOQL(params *common.FieldHistoryParams) *core.SOQLBuilder {
+	from, parent := historyObject(params.ObjectName.String())
+
+	soql := (&core.SOQLBuilder{}).
+		SelectFields([]string{"Id", parent, "Field", "OldValue", "NewValue", "CreatedDate", "CreatedById"}).
+		From(from).
+		Where("Field IN (" + quoteList(slices.Sorted(maps.Keys(params.Fields))) + ")")
+
+	if len(params.RecordIDs) != 0 {
+		soql.Where(parent + " IN (" + quoteList(params.RecordIDs) + ")")
+	}
+
+	if !params.Since.IsZero() {
+		soql.Where("CreatedDate >= " + datautils.Time.FormatRFC3339inUTC(params.Since))
+	}
+
+	return soql.OrderBy("CreatedDate", "Id")
+}
+
+// makeArchiveSOQL queries the archived changes of the object.
+//
+// FieldHistoryArchive is a big object, which can be filtered only by its index
+// FieldHistoryType, ParentId, CreatedDate in this order, where only the last filter may be a range.
+// Therefore, changes are filtered by field and, unless a single record is read, by time after the query.
+// Without the ParentId filter every archived change of the object would be scanned, so records are required.
+// nolint:lll
+// https://developer.salesforce.com/docs/atlas.en-us.bigobjects.meta/bigobjects/big_object_querying.htm
+func makeArchiveSOQL(params *common.FieldHistoryParams) *core.SOQLBuilder {
+	soql := (&core.SOQLBuilder{}).
+		SelectFields([]string{"Id", parentField, "Field", "OldValue", "NewValue", "CreatedDate", "CreatedById"}).
+		From(archiveObject).
+		Where("FieldHistoryType = " + quote(params.ObjectName.String()))
+
+	if len(params.RecordIDs) != 1 {
+		return soql.Where(parentField + " IN (" + quoteList(params.RecordIDs) + ")")
+	}
+
+	soql.Where(parentField + " = " + quote(params.RecordIDs[0]))
+
+	if !params.Since.IsZero() {
+		soql.Where("CreatedDate >= " + datautils.Time.FormatRFC3339inUTC(params.Since))
+	}
+
+	return soql
+}
+
+// quote makes a SOQL string literal.
+func quote(value string) string {
+	return "'" + core.EscapeSOQLString(value) + "'"
+}
+
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for index, value := range values {
+		quoted[index] = quote(value)
+	}
+
+	return strings.Join(quoted, ",")
+}
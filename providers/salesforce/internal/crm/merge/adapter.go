@@ -0,0 +1,30 @@
+package merge
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/providers"
+)
+
+const apiVersion = "60.0"
+
+// Adapter merges records via the SOAP API, as the REST API has no merge resource.
+type Adapter struct {
+	XMLClient  *common.XMLHTTPClient
+	moduleInfo *providers.ModuleInfo
+}
+
+func NewAdapter(httpClient *common.HTTPClient, moduleInfo *providers.ModuleInfo) *Adapter {
+	return &Adapter{
+		XMLClient: &common.XMLHTTPClient{
+			HTTPClient: httpClient,
+		},
+		moduleInfo: moduleInfo,
+	}
+}
+
+// The partner endpoint accepts any object, unlike the enterprise one which is bound to an org's WSDL.
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_quickstart_steps_import_wsdl.htm
+func (a *Adapter) getPartnerSoapURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, "services/Soap/u", apiVersion)
+}
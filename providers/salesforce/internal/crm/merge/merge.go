@@ -0,0 +1,204 @@
+package merge
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/xquery"
+)
+
+// maxLosersPerRequest is how many records a single merge call accepts besides the master record.
+const maxLosersPerRequest = 2
+
+// Merge merges losers into the winner, two at a time. Salesforce supports merging
+// accounts, contacts, leads, cases and individuals.
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_merge.htm
+//
+// Field values are written to the master record during the first merge call.
+// Record level failures stop the merge and are reported in the result.
+// Other errors are returned along with the result of the records merged so far.
+func (a *Adapter) Merge(ctx context.Context, params common.MergeParams) (*common.MergeResult, error) {
+	result := &common.MergeResult{
+		Success:  true,
+		RecordId: params.WinnerId,
+	}
+
+	for index, losers := range chunks(params.LoserIds) {
+		master := newMasterRecord(params.ObjectName, params.WinnerId)
+		if index == 0 {
+			master.setFields(params.FieldValues)
+		}
+
+		response, err := a.performMerge(ctx, mergeRequest{
+			Request: mergeRequestItem{
+				MasterRecord:     master,
+				RecordToMergeIds: losers,
+			},
+		})
+		if err != nil {
+			result.Success = false
+
+			return result, err
+		}
+
+		outcome := response.Response.Result
+		if !outcome.Success {
+			result.Success = false
+			result.Errors = outcome.errors()
+
+			return result, nil
+		}
+
+		result.MergedIds = append(result.MergedIds, outcome.MergedRecordIds...)
+	}
+
+	return result, nil
+}
+
+func chunks(ids []string) [][]string {
+	return slices.Collect(slices.Chunk(ids, maxLosersPerRequest))
+}
+
+const envelopeTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+    <soapenv:Header>
+        <SessionHeader xmlns="urn:partner.soap.sforce.com">
+            <sessionId>TODO----accessToken</sessionId>
+        </SessionHeader>
+    </soapenv:Header>
+    <soapenv:Body/>
+</soapenv:Envelope>`
+
+// performMerge sends the request inside a SOAP envelope authenticated by the session header.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_header_sessionheader.htm
+func (a *Adapter) performMerge(ctx context.Context, request mergeRequest) (*mergeResponseBody, error) {
+	accessToken, present := common.GetAuthToken(ctx)
+	if !present {
+		return nil, common.ErrMissingAccessToken
+	}
+
+	data, err := xml.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := xquery.NewXML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := xquery.NewXML([]byte(envelopeTemplate))
+	if err != nil {
+		return nil, err
+	}
+
+	envelope.FindOne("//sessionId").GetChild().SetDataText(accessToken.String())
+	envelope.FindOne("//soapenv:Body").SetDataNode(content)
+
+	url, err := a.getPartnerSoapURL()
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := a.XMLClient.Post(ctx, url.String(), envelope, common.Header{
+		Key:   "Content-Type",
+		Value: "text/xml",
+	}, common.Header{
+		// Empty value is rejected, see https://salesforce.stackexchange.com/a/49273
+		Key:   "SOAPAction",
+		Value: "''",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response soapEnvelope
+	if err = xml.Unmarshal([]byte(rsp.Body.RawXML()), &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body, nil
+}
+
+type mergeRequest struct {
+	XMLName xml.Name         `xml:"urn:partner.soap.sforce.com merge"`
+	Request mergeRequestItem `xml:"request"`
+}
+
+type mergeRequestItem struct {
+	MasterRecord     masterRecord `xml:"masterRecord"`
+	RecordToMergeIds []string     `xml:"recordToMergeIds"`
+}
+
+// masterRecord is the sObject of the partner API, its fields are arbitrary elements.
+type masterRecord struct {
+	Type         string       `xml:"urn:sobject.partner.soap.sforce.com type"`
+	FieldsToNull []string     `xml:"urn:sobject.partner.soap.sforce.com fieldsToNull,omitempty"`
+	ID           string       `xml:"urn:sobject.partner.soap.sforce.com Id"`
+	Fields       []fieldValue `xml:",any"`
+}
+
+type fieldValue struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+func newMasterRecord(objectName, recordID string) masterRecord {
+	return masterRecord{
+		Type: objectName,
+		ID:   recordID,
+	}
+}
+
+// setFields adds field values in a stable order, null values clear the field.
+func (r *masterRecord) setFields(values map[string]any) {
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		value := values[name]
+		if value == nil {
+			r.FieldsToNull = append(r.FieldsToNull, name)
+
+			continue
+		}
+
+		r.Fields = append(r.Fields, fieldValue{
+			XMLName: xml.Name{Local: name},
+			Value:   fmt.Sprint(value),
+		})
+	}
+}
+
+type soapEnvelope struct {
+	XMLName xml.Name          `xml:"Envelope"`
+	Body    mergeResponseBody `xml:"Body"`
+}
+
+type mergeResponseBody struct {
+	Response struct {
+		Result mergeResult `xml:"result"`
+	} `xml:"mergeResponse"`
+}
+
+type mergeResult struct {
+	ID              string       `xml:"id"`
+	MergedRecordIds []string     `xml:"mergedRecordIds"`
+	Success         bool         `xml:"success"`
+	Errors          []mergeError `xml:"errors"`
+}
+
+type mergeError struct {
+	Message    string `xml:"message"`
+	StatusCode string `xml:"statusCode"`
+}
+
+func (r mergeResult) errors() []any {
+	errs := make([]any, len(r.Errors))
+	for index, mergeErr := range r.Errors {
+		errs[index] = fmt.Sprintf("%s: %s", mergeErr.StatusCode, mergeErr.Message)
+	}
+
+	return errs
+}
@@ -107,6 +107,10 @@ func (a *Adapter) DeleteMetadata(
 		return nil, err
 	}
 
+	if len(params.FieldGroups) != 0 || len(params.Objects) != 0 {
+		return nil, fmt.Errorf("%w: only custom fields can be deleted", common.ErrOperationNotSupportedForObject)
+	}
+
 	payload := NewDeleteCustomFieldsPayload(params)
 
 	response, err := performMetadataAPICall[DeleteMetadataResponse](ctx, a, payload)
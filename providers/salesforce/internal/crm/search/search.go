@@ -9,6 +9,10 @@ import (
 )
 
 func (s Strategy) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	if params.IsTextSearch() {
+		return s.textSearch(ctx, params)
+	}
+
 	// Additional parameter validation.
 	if params.Limit != 0 {
 		return nil, common.ErrPaginationControl
@@ -0,0 +1,277 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// nolint:lll
+// SOSL limits, see the "OFFSET" and "LIMIT" sections:
+// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_sosl_offset.htm
+const (
+	defaultTextSearchPageSize = 200
+	maxTextSearchResults      = 2000
+	maxTextSearchOffset       = 2000
+)
+
+// soslReservedCharacters must be escaped with a backslash inside the FIND clause.
+// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_sosl_find.htm
+const soslReservedCharacters = `?&|!{}[]()^~*:\"'+-`
+
+var ErrTextSearchScope = errors.New("text search scope is not supported")
+
+// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_sosl_in.htm
+var soslScopes = map[common.TextSearchScope]string{ // nolint:gochecknoglobals
+	"":                          "ALL FIELDS",
+	common.TextSearchScopeAll:   "ALL FIELDS",
+	common.TextSearchScopeName:  "NAME FIELDS",
+	common.TextSearchScopeEmail: "EMAIL FIELDS",
+	common.TextSearchScopePhone: "PHONE FIELDS",
+}
+
+// textSearch runs a SOSL query, the way the global search bar of Salesforce does.
+//
+// Every object is paged using its own OFFSET, up to the limit of 2000 records.
+// When several objects are searched, the next page continues only the objects whose page was full.
+func (s Strategy) textSearch(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	query, err := newSOSLQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.getSearchURL()
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("q", query.String())
+
+	rsp, err := s.clientCRM.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		getSearchRecords,
+		query.nextPage,
+		query.marshal,
+		params.Fields,
+	)
+}
+
+type soslQuery struct {
+	term    string
+	scope   string
+	objects []string
+	// fields requested for every object, keyed by the lowercase object name.
+	fields map[string][]string
+	// offsets of every object, keyed by the lowercase object name.
+	offsets map[string]int
+	limit   int
+	// multiObject tells that the next page token carries an offset for every object.
+	multiObject bool
+}
+
+func newSOSLQuery(params *common.SearchParams) (*soslQuery, error) {
+	scope, ok := soslScopes[params.Text.Scope]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrTextSearchScope, params.Text.Scope)
+	}
+
+	query := &soslQuery{
+		term:        params.Text.Term,
+		scope:       scope,
+		objects:     []string{params.ObjectName},
+		fields:      map[string][]string{strings.ToLower(params.ObjectName): params.Fields.List()},
+		offsets:     map[string]int{},
+		limit:       defaultTextSearchPageSize,
+		multiObject: len(params.Text.AdditionalObjects) != 0,
+	}
+
+	if params.Limit > 0 {
+		query.limit = int(min(params.Limit, maxTextSearchResults))
+	}
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.Text.AdditionalObjects)) {
+		query.objects = append(query.objects, objectName)
+		query.fields[strings.ToLower(objectName)] = params.Text.AdditionalObjects[objectName].List()
+	}
+
+	if len(params.NextPage) != 0 {
+		if !query.continueFrom(params.NextPage.String()) {
+			return nil, fmt.Errorf("%w: invalid next page token %q", common.ErrNextPageInvalid, params.NextPage)
+		}
+	}
+
+	return query, nil
+}
+
+// continueFrom applies the next page token, reporting whether it is valid.
+// A single object search uses the offset as the token, for example "200".
+// A search across objects lists the objects left to page with their offsets, for example "Account:200,Contact:400".
+func (q *soslQuery) continueFrom(token string) bool {
+	if !q.multiObject {
+		offset, err := strconv.Atoi(token)
+		if err != nil || offset < 0 {
+			return false
+		}
+
+		q.offsets[strings.ToLower(q.objects[0])] = offset
+
+		return true
+	}
+
+	objects := make([]string, 0, len(q.objects))
+
+	for pair := range strings.SplitSeq(token, ",") {
+		objectName, offsetStr, _ := strings.Cut(pair, ":")
+
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return false
+		}
+
+		index := slices.IndexFunc(q.objects, func(name string) bool {
+			return strings.EqualFold(name, objectName)
+		})
+		if index == -1 {
+			return false
+		}
+
+		objects = append(objects, q.objects[index])
+		q.offsets[strings.ToLower(objectName)] = offset
+	}
+
+	q.objects = objects
+
+	return true
+}
+
+// String returns the query, for example:
+//
+//	FIND {Acme} IN NAME FIELDS RETURNING Account(Id,Name LIMIT 200 OFFSET 200)
+func (q *soslQuery) String() string {
+	returning := make([]string, len(q.objects))
+
+	for index, objectName := range q.objects {
+		clause := fmt.Sprintf("%s(%s LIMIT %d", objectName,
+			strings.Join(withID(q.fields[strings.ToLower(objectName)]), ","), q.limit)
+
+		if offset := q.offsets[strings.ToLower(objectName)]; offset != 0 {
+			clause += fmt.Sprintf(" OFFSET %d", offset)
+		}
+
+		returning[index] = clause + ")"
+	}
+
+	return fmt.Sprintf("FIND {%s} IN %s RETURNING %s", escapeSOSL(q.term), q.scope, strings.Join(returning, ", "))
+}
+
+// nextPage continues every object whose page is full while its offset stays within the limit.
+func (q *soslQuery) nextPage(node *ajson.Node) (string, error) {
+	records, err := getSearchRecords(node)
+	if err != nil {
+		return "", err
+	}
+
+	counts := make(map[string]int)
+	for _, record := range records {
+		counts[strings.ToLower(q.objectNameOf(record))]++
+	}
+
+	pairs := make([]string, 0, len(q.objects))
+
+	for _, objectName := range q.objects {
+		key := strings.ToLower(objectName)
+
+		next := q.offsets[key] + q.limit
+		if counts[key] < q.limit || next > maxTextSearchOffset {
+			continue
+		}
+
+		if !q.multiObject {
+			return strconv.Itoa(next), nil
+		}
+
+		pairs = append(pairs, objectName+":"+strconv.Itoa(next))
+	}
+
+	return strings.Join(pairs, ","), nil
+}
+
+// marshal converts records of any searched object, each keeps the fields requested for its object.
+func (q *soslQuery) marshal(records []map[string]any, _ []string) ([]common.ReadResultRow, error) {
+	rows := make([]common.ReadResultRow, len(records))
+
+	for index, record := range records {
+		recordMap := common.ToStringMap(record)
+
+		objectName := q.objectNameOf(record)
+
+		id, _ := recordMap.GetCaseInsensitive("Id")
+		idStr, _ := id.(string)
+
+		rows[index] = common.ReadResultRow{
+			Fields:     common.ExtractLowercaseFieldsFromRaw(q.fields[strings.ToLower(objectName)], record),
+			Raw:        record,
+			Id:         idStr,
+			ObjectName: objectName,
+		}
+	}
+
+	return rows, nil
+}
+
+// objectNameOf returns the object of a record, which is the searched object unless the record tells otherwise.
+func (q *soslQuery) objectNameOf(record map[string]any) string {
+	if attributes, ok := record["attributes"].(map[string]any); ok {
+		if recordType, ok := attributes["type"].(string); ok {
+			return recordType
+		}
+	}
+
+	return q.objects[0]
+}
+
+func getSearchRecords(node *ajson.Node) ([]map[string]any, error) {
+	records, err := jsonquery.New(node).ArrayOptional("searchRecords")
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonquery.Convertor.ArrayToMap(records)
+}
+
+func escapeSOSL(term string) string {
+	var builder strings.Builder
+
+	for _, char := range term {
+		if strings.ContainsRune(soslReservedCharacters, char) {
+			builder.WriteRune('\\')
+		}
+
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
+
+func withID(fields []string) []string {
+	for _, field := range fields {
+		if strings.EqualFold(field, "Id") {
+			return fields
+		}
+	}
+
+	return append([]string{"Id"}, fields...)
+}
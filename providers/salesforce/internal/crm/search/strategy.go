@@ -30,3 +30,8 @@ func (s Strategy) getModuleURL(paths ...string) (*urlbuilder.URL, error) {
 func (s Strategy) getQueryURL() (*urlbuilder.URL, error) {
 	return urlbuilder.New(s.moduleInfo.BaseURL, crmcore.RestAPISuffix, "query")
 }
+
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_search.htm
+func (s Strategy) getSearchURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(s.moduleInfo.BaseURL, crmcore.RestAPISuffix, "search")
+}
@@ -66,6 +66,8 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		},
 	)
 
+	adapter.Reader = reader.NewNormalizingReader(adapter.Reader, adapter)
+
 	connector := funcName(adapter)
 
 	connector.Writer = writer.NewHTTPWriter(
@@ -0,0 +1,5 @@
+// Package pubsub holds the gRPC client of the Salesforce Pub/Sub API generated from pubsub_api.proto.
+// See https://developer.salesforce.com/docs/platform/pub-sub-api/overview.
+package pubsub
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pubsub_api.proto
@@ -0,0 +1,150 @@
+package salesforce
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testconn"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestMerge(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	payloadFirst := testutils.DataFromFile(t, "merge/accounts-first-payload.xml")
+	payloadSecond := testutils.DataFromFile(t, "merge/accounts-second-payload.xml")
+	responseFirst := testutils.DataFromFile(t, "merge/accounts-first-response.xml")
+	responseSecond := testutils.DataFromFile(t, "merge/accounts-second-response.xml")
+	responseEntityDeleted := testutils.DataFromFile(t, "merge/err-entity-deleted.xml")
+
+	tests := []testconn.TestCaseMerge{
+		{
+			Name:         "Winner is required",
+			Input:        common.MergeParams{ObjectName: "Account", LoserIds: []string{"001ak00000OQTifAAH"}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name:         "Losers are required",
+			Input:        common.MergeParams{ObjectName: "Account", WinnerId: "001ak00000OQTieAAH"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingMergeRecords},
+		},
+		{
+			Name: "Winner cannot be merged into itself",
+			Input: common.MergeParams{
+				ObjectName: "Account",
+				WinnerId:   "001ak00000OQTieAAH",
+				LoserIds:   []string{"001ak00000OQTieAAH"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMergeWinnerIsLoser},
+		},
+		{
+			Name: "Losers are merged two at a time",
+			Input: common.MergeParams{
+				ObjectName: "Account",
+				WinnerId:   "001ak00000OQTieAAH",
+				LoserIds:   []string{"001ak00000OQTifAAH", "001ak00000OQTigAAH", "001ak00000OQTihAAH"},
+				FieldValues: map[string]any{
+					"Phone": "(415) 555-0199",
+					"Fax":   nil,
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentXML(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/services/Soap/u/60.0"),
+						mockcond.BodyBytes(payloadFirst),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFirst),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/services/Soap/u/60.0"),
+						mockcond.BodyBytes(payloadSecond),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSecond),
+				}},
+			}.Server(),
+			Expected: &common.MergeResult{
+				Success:   true,
+				RecordId:  "001ak00000OQTieAAH",
+				MergedIds: []string{"001ak00000OQTifAAH", "001ak00000OQTigAAH", "001ak00000OQTihAAH"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Record level failure is reported",
+			Input: common.MergeParams{
+				ObjectName: "Account",
+				WinnerId:   "001ak00000OQTieAAH",
+				LoserIds:   []string{"001ak00000OQTifAAH"},
+			},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentXML(),
+				Always: mockserver.Response(http.StatusOK, responseEntityDeleted),
+			}.Server(),
+			Expected: &common.MergeResult{
+				Success:  false,
+				RecordId: "001ak00000OQTieAAH",
+				Errors:   []any{"ENTITY_IS_DELETED: entity is deleted"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Records merged before a failure are returned with the error",
+			Input: common.MergeParams{
+				ObjectName: "Account",
+				WinnerId:   "001ak00000OQTieAAH",
+				LoserIds:   []string{"001ak00000OQTifAAH", "001ak00000OQTigAAH", "001ak00000OQTihAAH"},
+				FieldValues: map[string]any{
+					"Phone": "(415) 555-0199",
+					"Fax":   nil,
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentXML(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/services/Soap/u/60.0"),
+						mockcond.BodyBytes(payloadFirst),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFirst),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/services/Soap/u/60.0"),
+						mockcond.BodyBytes(payloadSecond),
+					},
+					Then: mockserver.Response(http.StatusServiceUnavailable),
+				}},
+			}.Server(),
+			Expected: &common.MergeResult{
+				Success:   false,
+				RecordId:  "001ak00000OQTieAAH",
+				MergedIds: []string{"001ak00000OQTifAAH", "001ak00000OQTigAAH"},
+			},
+			ExpectedErrs: []error{common.ErrServer},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := common.WithAuthToken(t.Context(), "TEST_ACCESS_TOKEN")
+
+			tt.RunWithContext(t, ctx, func() (testconn.TestableMerger, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -146,9 +146,11 @@ type compositeResponseItem struct {
 //
 //nolint:lll
 type describeSObjectResult struct {
-	Name   string        `json:"name"`
-	Label  string        `json:"label"`
-	Fields []fieldResult `json:"fields" validate:"required"`
+	Name       string        `json:"name"`
+	Label      string        `json:"label"`
+	Createable bool          `json:"createable"`
+	Updateable bool          `json:"updateable"`
+	Fields     []fieldResult `json:"fields" validate:"required"`
 }
 
 // See https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_describesobjects_describesobjectresult.htm#field.
@@ -172,6 +174,7 @@ type fieldResult struct {
 	Custom            *bool `json:"custom,omitempty"`
 	Nillable          *bool `json:"nillable,omitempty"`
 	DefaultedOnCreate *bool `json:"defaultedOnCreate,omitempty"`
+	ExternalID        *bool `json:"externalId,omitempty"`
 }
 
 type picklistValue struct {
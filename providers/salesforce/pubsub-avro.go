@@ -0,0 +1,288 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	errUnexpectedAvroValue = errors.New("unexpected avro value")
+	errInvalidAvroSchema   = errors.New("invalid avro schema")
+)
+
+// avroType is the part of an Avro schema needed to turn decoded values into plain JSON values
+// and to resolve field bitmaps of change events.
+// https://avro.apache.org/docs/1.11.1/specification/
+type avroType struct {
+	// name is the full name of named types, otherwise the type itself, e.g. "string", "array" or "union".
+	name    string
+	fields  []avroField
+	items   *avroType
+	values  *avroType
+	members []*avroType
+}
+
+type avroField struct {
+	name string
+	kind *avroType
+}
+
+func parseAvroSchema(schemaJSON string) (*avroType, error) {
+	var definition any
+	if err := json.Unmarshal([]byte(schemaJSON), &definition); err != nil {
+		return nil, err
+	}
+
+	parser := avroParser{named: make(map[string]*avroType)}
+
+	return parser.parse(definition, "")
+}
+
+type avroParser struct {
+	// named registers records, enums and fixed types which may be referenced by name later in the schema.
+	named map[string]*avroType
+}
+
+func (p avroParser) parse(definition any, namespace string) (*avroType, error) {
+	switch definition := definition.(type) {
+	case string:
+		return p.reference(definition, namespace), nil
+	case []any:
+		union := &avroType{name: "union"}
+
+		for _, member := range definition {
+			memberType, err := p.parse(member, namespace)
+			if err != nil {
+				return nil, err
+			}
+
+			union.members = append(union.members, memberType)
+		}
+
+		return union, nil
+	case map[string]any:
+		return p.parseComplex(definition, namespace)
+	default:
+		return nil, fmt.Errorf("%w: unexpected definition %v", errInvalidAvroSchema, definition)
+	}
+}
+
+func (p avroParser) parseComplex(definition map[string]any, namespace string) (*avroType, error) {
+	kind, _ := definition["type"].(string)
+
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		return p.parseNamed(definition, kind, namespace)
+	case "array":
+		items, err := p.parse(definition["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &avroType{name: kind, items: items}, nil
+	case "map":
+		values, err := p.parse(definition["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &avroType{name: kind, values: values}, nil
+	default:
+		// Primitive type, possibly annotated with a logical type.
+		return p.parse(definition["type"], namespace)
+	}
+}
+
+func (p avroParser) parseNamed(definition map[string]any, kind, namespace string) (*avroType, error) {
+	name, _ := definition["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%w: %v type without a name", errInvalidAvroSchema, kind)
+	}
+
+	if ns, ok := definition["namespace"].(string); ok {
+		namespace = ns
+	}
+
+	fullName := qualifiedAvroName(name, namespace)
+	if index := strings.LastIndex(fullName, "."); index != -1 {
+		namespace = fullName[:index]
+	}
+
+	named := &avroType{name: fullName}
+	// Registered before the fields are parsed, as records may reference themselves.
+	p.named[fullName] = named
+
+	if kind != "record" && kind != "error" {
+		return named, nil
+	}
+
+	fields, _ := definition["fields"].([]any)
+	for _, field := range fields {
+		fieldDefinition, ok := field.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: field of %v is not an object", errInvalidAvroSchema, fullName)
+		}
+
+		fieldName, _ := fieldDefinition["name"].(string)
+
+		fieldType, err := p.parse(fieldDefinition["type"], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		named.fields = append(named.fields, avroField{name: fieldName, kind: fieldType})
+	}
+
+	return named, nil
+}
+
+func (p avroParser) reference(name, namespace string) *avroType {
+	if named, ok := p.named[qualifiedAvroName(name, namespace)]; ok {
+		return named
+	}
+
+	if named, ok := p.named[name]; ok {
+		return named
+	}
+
+	return &avroType{name: name}
+}
+
+func qualifiedAvroName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+
+	return namespace + "." + name
+}
+
+// plain removes the union wrappers which the decoder puts around every non-null union value,
+// e.g. {"string": "Acme"} becomes "Acme".
+func (t *avroType) plain(value any) any {
+	switch {
+	case t.members != nil:
+		return t.plainUnion(value)
+	case t.fields != nil:
+		record, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+
+		for _, field := range t.fields {
+			if fieldValue, present := record[field.name]; present {
+				record[field.name] = field.kind.plain(fieldValue)
+			}
+		}
+
+		return record
+	case t.items != nil:
+		items, ok := value.([]any)
+		if !ok {
+			return value
+		}
+
+		for index, item := range items {
+			items[index] = t.items.plain(item)
+		}
+
+		return items
+	case t.values != nil:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+
+		for key, entry := range entries {
+			entries[key] = t.values.plain(entry)
+		}
+
+		return entries
+	default:
+		return value
+	}
+}
+
+func (t *avroType) plainUnion(value any) any {
+	wrapper, ok := value.(map[string]any)
+	if !ok || len(wrapper) != 1 {
+		// Null member.
+		return value
+	}
+
+	for branch, branchValue := range wrapper {
+		for _, member := range t.members {
+			if member.name == branch {
+				return member.plain(branchValue)
+			}
+		}
+
+		return branchValue
+	}
+
+	return value
+}
+
+// record returns the record type, looking through a nullable union.
+func (t *avroType) record() *avroType {
+	if t.fields != nil {
+		return t
+	}
+
+	for _, member := range t.members {
+		if member.fields != nil {
+			return member
+		}
+	}
+
+	return nil
+}
+
+// fieldNames converts field bitmaps of the change event header into field names.
+// A bitmap is a hexadecimal number where bit N marks field N of the schema, e.g. "0x5".
+// Bitmaps of compound fields are prefixed by the index of the parent field, e.g. "3-0x1",
+// and resolve to "Name.FirstName", the notation of JSON change events.
+// https://developer.salesforce.com/docs/platform/pub-sub-api/guide/event-deserialization-considerations.html
+func (t *avroType) fieldNames(bitmaps []any) []any {
+	names := make([]any, 0, len(bitmaps))
+
+	for _, bitmapAny := range bitmaps {
+		bitmap, ok := bitmapAny.(string)
+		if !ok {
+			continue
+		}
+
+		parent, prefix := t, ""
+
+		if parentIndex, nested, found := strings.Cut(bitmap, "-"); found {
+			index, err := strconv.Atoi(parentIndex)
+			if err != nil || index < 0 || index >= len(t.fields) {
+				continue
+			}
+
+			parent = t.fields[index].kind.record()
+			if parent == nil {
+				continue
+			}
+
+			prefix = t.fields[index].name + "."
+			bitmap = nested
+		}
+
+		bits, ok := new(big.Int).SetString(strings.TrimPrefix(bitmap, "0x"), 16) // nolint:mnd
+		if !ok {
+			continue
+		}
+
+		for index, field := range parent.fields {
+			if bits.Bit(index) == 1 {
+				names = append(names, prefix+field.name)
+			}
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,401 @@
+package salesforce
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/pubsub"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// PubSubEndpoint is the global endpoint of the Salesforce Pub/Sub API.
+// https://developer.salesforce.com/docs/platform/pub-sub-api/guide/pub-sub-endpoints.html
+const PubSubEndpoint = "api.pubsub.salesforce.com:7443"
+
+const defaultPubSubBatchSize = 100
+
+var (
+	ErrPubSubTopic   = errors.New("pub/sub topic is required")
+	ErrPubSubHandler = errors.New("pub/sub event handler is required")
+	errTenantID      = errors.New("organization id is missing in the user info")
+)
+
+// ReplayID is the opaque position of an event in the event bus.
+// Persist it to resume a subscription after the last processed event.
+type ReplayID []byte
+
+// PubSubEvent is an event received from the Pub/Sub API.
+type PubSubEvent struct {
+	// Event is the decoded payload. Change data capture events carry the ChangeEventHeader,
+	// the same shape as events delivered by the Event Relay, while platform events hold their fields only.
+	Event CollapsedSubscriptionEvent
+	// EventID is the identifier assigned by the publisher.
+	EventID string
+	// SchemaID is the fingerprint of the Avro schema used to encode the event.
+	SchemaID string
+	// ReplayID locates the event in the stream.
+	ReplayID ReplayID
+}
+
+// PubSubHandler processes one event. Returning an error stops the subscription.
+type PubSubHandler func(ctx context.Context, event *PubSubEvent) error
+
+// PubSubSubscription describes where and from which point events are consumed.
+type PubSubSubscription struct {
+	// Topic is the channel to consume, for example "/data/ChangeEvents", "/data/AccountChangeEvent",
+	// a custom channel "/data/MyChannel__chn" or a platform event "/event/Order_Event__e".
+	Topic string
+	// ReplayID resumes the subscription after this event. It must be within the retention window of the event bus.
+	ReplayID ReplayID
+	// FromEarliest starts from the earliest retained event when no ReplayID is given.
+	// Otherwise, only new events are received.
+	FromEarliest bool
+	// BatchSize is the number of events requested at a time, the default is 100.
+	BatchSize int32
+	// Checkpoint is called after all events of a batch were handled, with the latest replay id of the stream.
+	// Keep-alive responses without events advance it too, so that the stored position doesn't expire.
+	Checkpoint func(ctx context.Context, replayID ReplayID) error
+}
+
+// PubSubOption configures the Pub/Sub client.
+type PubSubOption func(*pubSubOptions)
+
+type pubSubOptions struct {
+	endpoint    string
+	tenantID    string
+	dialOptions []grpc.DialOption
+}
+
+// WithPubSubEndpoint overrides the gRPC endpoint, PubSubEndpoint is used by default.
+func WithPubSubEndpoint(endpoint string) PubSubOption {
+	return func(options *pubSubOptions) {
+		options.endpoint = endpoint
+	}
+}
+
+// WithPubSubTenantID sets the organization id, which is otherwise fetched from the user info.
+func WithPubSubTenantID(tenantID string) PubSubOption {
+	return func(options *pubSubOptions) {
+		options.tenantID = tenantID
+	}
+}
+
+// WithPubSubDialOptions replaces the default TLS transport of the gRPC connection.
+func WithPubSubDialOptions(dialOptions ...grpc.DialOption) PubSubOption {
+	return func(options *pubSubOptions) {
+		options.dialOptions = dialOptions
+	}
+}
+
+// PubSubClient consumes change data capture and platform events over the Pub/Sub API.
+// It is an alternative to the Event Relay, events are pulled by the client instead of being pushed to AWS.
+//
+// Every call is authorized with the access token found in the context, see common.WithAuthToken.
+type PubSubClient struct {
+	conn        *grpc.ClientConn
+	client      pubsub.PubSubClient
+	instanceURL string
+	tenantID    string
+
+	mutex   sync.Mutex
+	schemas map[string]*pubSubSchema
+}
+
+// NewPubSubClient connects to the Pub/Sub API on behalf of the connected organization.
+// https://developer.salesforce.com/docs/platform/pub-sub-api/guide/intro.html
+func (c *Connector) NewPubSubClient(ctx context.Context, opts ...PubSubOption) (*PubSubClient, error) {
+	options := &pubSubOptions{
+		endpoint: PubSubEndpoint,
+		dialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})),
+		},
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.tenantID == "" {
+		tenantID, err := c.fetchTenantID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		options.tenantID = tenantID
+	}
+
+	conn, err := grpc.NewClient(options.endpoint, options.dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubSubClient{
+		conn:        conn,
+		client:      pubsub.NewPubSubClient(conn),
+		instanceURL: c.getModuleURL(),
+		tenantID:    options.tenantID,
+		schemas:     make(map[string]*pubSubSchema),
+	}, nil
+}
+
+type userInfoResponse struct {
+	OrganizationID string `json:"organization_id"`
+}
+
+func (c *Connector) fetchTenantID(ctx context.Context) (string, error) {
+	url, err := c.getDomainURL("services/oauth2/userinfo")
+	if err != nil {
+		return "", err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return "", err
+	}
+
+	info, err := common.UnmarshalJSON[userInfoResponse](rsp)
+	if err != nil {
+		return "", err
+	}
+
+	if info == nil || info.OrganizationID == "" {
+		return "", errTenantID
+	}
+
+	return info.OrganizationID, nil
+}
+
+// Close terminates the gRPC connection.
+func (p *PubSubClient) Close() error {
+	return p.conn.Close()
+}
+
+// Subscribe streams events of the topic to the handler until the context is canceled or an error occurs.
+// Events are requested in batches, the next batch is requested once the previous one is delivered.
+// https://developer.salesforce.com/docs/platform/pub-sub-api/references/methods/subscribe-rpc.html
+func (p *PubSubClient) Subscribe(ctx context.Context, subscription PubSubSubscription, handler PubSubHandler) error {
+	if subscription.Topic == "" {
+		return ErrPubSubTopic
+	}
+
+	if handler == nil {
+		return ErrPubSubHandler
+	}
+
+	batchSize := subscription.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPubSubBatchSize
+	}
+
+	rpcCtx, err := p.authorize(ctx)
+	if err != nil {
+		return err
+	}
+
+	stream, err := p.client.Subscribe(rpcCtx)
+	if err != nil {
+		return err
+	}
+
+	defer stream.CloseSend() // nolint:errcheck
+
+	if err = stream.Send(subscription.initialRequest(batchSize)); err != nil {
+		return p.streamError(ctx, err)
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return p.streamError(ctx, err)
+		}
+
+		for _, consumerEvent := range response.GetEvents() {
+			event, err := p.decodeEvent(rpcCtx, consumerEvent)
+			if err != nil {
+				return err
+			}
+
+			if err = handler(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		if subscription.Checkpoint != nil && len(response.GetLatestReplayId()) != 0 {
+			if err = subscription.Checkpoint(ctx, response.GetLatestReplayId()); err != nil {
+				return err
+			}
+		}
+
+		if response.GetPendingNumRequested() == 0 {
+			// Flow control: the server delivers no more than requested.
+			if err = stream.Send(&pubsub.FetchRequest{
+				TopicName:    subscription.Topic,
+				NumRequested: batchSize,
+			}); err != nil {
+				return p.streamError(ctx, err)
+			}
+		}
+	}
+}
+
+func (s PubSubSubscription) initialRequest(batchSize int32) *pubsub.FetchRequest {
+	request := &pubsub.FetchRequest{
+		TopicName:    s.Topic,
+		ReplayPreset: pubsub.ReplayPreset_LATEST,
+		NumRequested: batchSize,
+	}
+
+	switch {
+	case len(s.ReplayID) != 0:
+		request.ReplayPreset = pubsub.ReplayPreset_CUSTOM
+		request.ReplayId = s.ReplayID
+	case s.FromEarliest:
+		request.ReplayPreset = pubsub.ReplayPreset_EARLIEST
+	}
+
+	return request
+}
+
+// streamError reports cancellation of the subscription as the context error.
+func (p *PubSubClient) streamError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return fmt.Errorf("pub/sub subscription failed: %w", err)
+}
+
+// authorize attaches the session headers expected by the Pub/Sub API.
+// https://developer.salesforce.com/docs/platform/pub-sub-api/guide/supported-auth.html
+func (p *PubSubClient) authorize(ctx context.Context) (context.Context, error) {
+	accessToken, present := common.GetAuthToken(ctx)
+	if !present {
+		return nil, common.ErrMissingAccessToken
+	}
+
+	return metadata.AppendToOutgoingContext(ctx,
+		"accesstoken", accessToken.String(),
+		"instanceurl", p.instanceURL,
+		"tenantid", p.tenantID,
+	), nil
+}
+
+func (p *PubSubClient) decodeEvent(ctx context.Context, consumerEvent *pubsub.ConsumerEvent) (*PubSubEvent, error) {
+	schemaID := consumerEvent.GetEvent().GetSchemaId()
+
+	schema, err := p.schema(ctx, schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	native, _, err := schema.codec.NativeFromBinary(consumerEvent.GetEvent().GetPayload())
+	if err != nil {
+		return nil, fmt.Errorf("decoding event %s: %w", consumerEvent.GetEvent().GetId(), err)
+	}
+
+	record, err := schema.toEvent(native)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubSubEvent{
+		Event:    record,
+		EventID:  consumerEvent.GetEvent().GetId(),
+		SchemaID: schemaID,
+		ReplayID: consumerEvent.GetReplayId(),
+	}, nil
+}
+
+// schema returns the cached schema, fetching it on first use.
+// Schemas are immutable, a changed object definition gets a new schema id.
+func (p *PubSubClient) schema(ctx context.Context, schemaID string) (*pubSubSchema, error) {
+	p.mutex.Lock()
+	schema, found := p.schemas[schemaID]
+	p.mutex.Unlock()
+
+	if found {
+		return schema, nil
+	}
+
+	info, err := p.client.GetSchema(ctx, &pubsub.SchemaRequest{SchemaId: schemaID})
+	if err != nil {
+		return nil, fmt.Errorf("fetching schema %s: %w", schemaID, err)
+	}
+
+	schema, err = newPubSubSchema(info.GetSchemaJson())
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema %s: %w", schemaID, err)
+	}
+
+	p.mutex.Lock()
+	p.schemas[schemaID] = schema
+	p.mutex.Unlock()
+
+	return schema, nil
+}
+
+// toEvent converts the decoded record into the JSON representation of the event.
+func (s *pubSubSchema) toEvent(native any) (CollapsedSubscriptionEvent, error) {
+	record, ok := s.root.plain(native).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: event is not a record", errUnexpectedAvroValue)
+	}
+
+	// Unset fields are omitted, as they are in JSON events.
+	for name, value := range record {
+		if value == nil {
+			delete(record, name)
+		}
+	}
+
+	if header, ok := record[keyEventChangeEventHeader].(map[string]any); ok {
+		for _, key := range []string{"changedFields", "nulledFields", "diffFields"} {
+			if bitmaps, ok := header[key].([]any); ok {
+				header[key] = s.root.fieldNames(bitmaps)
+			}
+		}
+	}
+
+	// The round trip leaves only JSON types, which SubscriptionEvent expects.
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	var event CollapsedSubscriptionEvent
+	if err = json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+type pubSubSchema struct {
+	codec *goavro.Codec
+	root  *avroType
+}
+
+func newPubSubSchema(schemaJSON string) (*pubSubSchema, error) {
+	codec, err := goavro.NewCodec(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := parseAvroSchema(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pubSubSchema{
+		codec: codec,
+		root:  root,
+	}, nil
+}
@@ -0,0 +1,346 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/pubsub"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"github.com/go-test/deep"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestPubSubSubscribe(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	schemaJSON := string(testutils.DataFromFile(t, "pubsub/account-change-event-schema.json"))
+	payload := encodeAvro(t, schemaJSON, map[string]any{
+		"ChangeEventHeader": map[string]any{
+			"entityName":      "Account",
+			"recordIds":       []any{"001ak00000OQTieAAH"},
+			"changeType":      "UPDATE",
+			"changeOrigin":    "com/salesforce/api/rest/60.0",
+			"transactionKey":  "0004a5b7-3f0c-4a5a-9b3a-3c9f1f1f1f1f",
+			"sequenceNumber":  int32(1),
+			"commitTimestamp": int64(1735689600000),
+			"commitNumber":    int64(11853394416950),
+			"commitUser":      "005ak00000AbCdEAAV",
+			"nulledFields":    []any{"0x10"},
+			"diffFields":      []any{},
+			"changedFields":   []any{"0x42", "3-0x02"},
+		},
+		"Name":              goavro.Union("string", "Acme"),
+		"BillingAddress":    nil,
+		"ShippingAddress":   goavro.Union("com.sforce.eventbus.Address", map[string]any{"City": goavro.Union("string", "Paris")}),
+		"Description":       nil,
+		"NumberOfEmployees": nil,
+		"LastModifiedDate":  goavro.Union("long", int64(1735689600000)),
+	})
+
+	server := &pubSubStandIn{
+		schemaJSON: schemaJSON,
+		responses: []*pubsub.FetchResponse{{
+			Events: []*pubsub.ConsumerEvent{{
+				Event: &pubsub.ProducerEvent{
+					Id:       "event-1",
+					SchemaId: "schema-1",
+					Payload:  payload,
+				},
+				ReplayId: []byte("replay-1"),
+			}},
+			LatestReplayId:      []byte("replay-1"),
+			PendingNumRequested: 0,
+		}, {
+			// Keep-alive without events.
+			LatestReplayId:      []byte("replay-2"),
+			PendingNumRequested: 10,
+		}},
+	}
+
+	client := newTestPubSubClient(t, server, "https://example.my.salesforce.com")
+
+	ctx, cancel := context.WithCancel(common.WithAuthToken(t.Context(), "TEST_ACCESS_TOKEN"))
+	defer cancel()
+
+	var (
+		events      []*PubSubEvent
+		checkpoints []string
+	)
+
+	err := client.Subscribe(ctx, PubSubSubscription{
+		Topic:     "/data/AccountChangeEvent",
+		ReplayID:  ReplayID("replay-0"),
+		BatchSize: 10,
+		Checkpoint: func(ctx context.Context, replayID ReplayID) error {
+			checkpoints = append(checkpoints, string(replayID))
+			if len(checkpoints) == len(server.responses) {
+				cancel()
+			}
+
+			return nil
+		},
+	}, func(ctx context.Context, event *PubSubEvent) error {
+		events = append(events, event)
+
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+
+	expectedRequests := []*pubsub.FetchRequest{{
+		TopicName:    "/data/AccountChangeEvent",
+		ReplayPreset: pubsub.ReplayPreset_CUSTOM,
+		ReplayId:     []byte("replay-0"),
+		NumRequested: 10,
+	}, {
+		TopicName:    "/data/AccountChangeEvent",
+		NumRequested: 10,
+	}}
+
+	if len(server.requests) != len(expectedRequests) {
+		t.Fatalf("expected %v fetch requests, got %v", len(expectedRequests), len(server.requests))
+	}
+
+	for index, request := range server.requests {
+		if diff := deep.Equal(request, expectedRequests[index]); diff != nil {
+			t.Fatalf("fetch request %v mismatch: %v", index, diff)
+		}
+	}
+
+	expectedHeaders := map[string]string{
+		"accesstoken": "TEST_ACCESS_TOKEN",
+		"instanceurl": "https://example.my.salesforce.com",
+		"tenantid":    "00Dak00000TEST",
+	}
+	if diff := deep.Equal(server.headers, expectedHeaders); diff != nil {
+		t.Fatalf("session headers mismatch: %v", diff)
+	}
+
+	if diff := deep.Equal(checkpoints, []string{"replay-1", "replay-2"}); diff != nil {
+		t.Fatalf("checkpoints mismatch: %v", diff)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected one event, got %v", len(events))
+	}
+
+	expectedEvent := CollapsedSubscriptionEvent{
+		"ChangeEventHeader": map[string]any{
+			"entityName":      "Account",
+			"recordIds":       []any{"001ak00000OQTieAAH"},
+			"changeType":      "UPDATE",
+			"changeOrigin":    "com/salesforce/api/rest/60.0",
+			"transactionKey":  "0004a5b7-3f0c-4a5a-9b3a-3c9f1f1f1f1f",
+			"sequenceNumber":  float64(1),
+			"commitTimestamp": float64(1735689600000),
+			"commitNumber":    float64(11853394416950),
+			"commitUser":      "005ak00000AbCdEAAV",
+			"nulledFields":    []any{"Description"},
+			"diffFields":      []any{},
+			"changedFields":   []any{"Name", "LastModifiedDate", "ShippingAddress.City"},
+		},
+		"Name": "Acme",
+		"ShippingAddress": map[string]any{
+			"Street":     nil,
+			"City":       "Paris",
+			"PostalCode": nil,
+		},
+		"LastModifiedDate": float64(1735689600000),
+	}
+
+	if diff := deep.Equal(events[0].Event, expectedEvent); diff != nil {
+		t.Fatalf("event mismatch: %v", diff)
+	}
+
+	if string(events[0].ReplayID) != "replay-1" || events[0].EventID != "event-1" || events[0].SchemaID != "schema-1" {
+		t.Fatalf("unexpected event envelope: %+v", events[0])
+	}
+
+	// Decoded events are read like the ones delivered by the Event Relay.
+	list, err := events[0].Event.SubscriptionEventList()
+	if err != nil {
+		t.Fatalf("splitting event: %v", err)
+	}
+
+	updateEvent, ok := list[0].(common.SubscriptionUpdateEvent)
+	if !ok {
+		t.Fatalf("expected update event, got %T", list[0])
+	}
+
+	fields, err := updateEvent.UpdatedFields()
+	if err != nil {
+		t.Fatalf("reading updated fields: %v", err)
+	}
+
+	if diff := deep.Equal(fields, []string{"Name", "LastModifiedDate", "ShippingCity"}); diff != nil {
+		t.Fatalf("updated fields mismatch: %v", diff)
+	}
+}
+
+func TestPubSubSubscribeWithoutAccessToken(t *testing.T) {
+	t.Parallel()
+
+	client := newTestPubSubClient(t, &pubSubStandIn{}, "https://example.my.salesforce.com")
+
+	err := client.Subscribe(t.Context(), PubSubSubscription{Topic: "/data/ChangeEvents"},
+		func(ctx context.Context, event *PubSubEvent) error {
+			return nil
+		})
+	if !errors.Is(err, common.ErrMissingAccessToken) {
+		t.Fatalf("expected missing access token, got %v", err)
+	}
+}
+
+func TestPubSubTenantFromUserInfo(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodGET(),
+			mockcond.Path("/services/oauth2/userinfo"),
+		},
+		Then: mockserver.Response(http.StatusOK, []byte(`{"user_id":"005ak00000AbCdEAAV","organization_id":"00Dak00000USERINFO"}`)),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	client, err := connector.NewPubSubClient(t.Context(),
+		WithPubSubEndpoint("passthrough:///pubsub"),
+		WithPubSubDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	defer client.Close()
+
+	if client.tenantID != "00Dak00000USERINFO" {
+		t.Fatalf("unexpected tenant id %q", client.tenantID)
+	}
+}
+
+func newTestPubSubClient(t *testing.T, standIn *pubSubStandIn, instanceURL string) *PubSubClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20) // nolint:mnd
+	server := grpc.NewServer()
+	pubsub.RegisterPubSubServer(server, standIn)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	connector, err := constructTestConnector(instanceURL)
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	client, err := connector.NewPubSubClient(t.Context(),
+		WithPubSubTenantID("00Dak00000TEST"),
+		WithPubSubEndpoint("passthrough:///bufnet"),
+		WithPubSubDialOptions(
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func encodeAvro(t *testing.T, schemaJSON string, native map[string]any) []byte {
+	t.Helper()
+
+	codec, err := goavro.NewCodec(schemaJSON)
+	if err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+
+	data, err := codec.BinaryFromNative(nil, native)
+	if err != nil {
+		t.Fatalf("failed to encode event: %v", err)
+	}
+
+	return data
+}
+
+// pubSubStandIn replays prepared responses, one for every fetch request, and records what it receives.
+type pubSubStandIn struct {
+	pubsub.UnimplementedPubSubServer
+
+	schemaJSON string
+	responses  []*pubsub.FetchResponse
+
+	mutex    sync.Mutex
+	requests []*pubsub.FetchRequest
+	headers  map[string]string
+}
+
+func (s *pubSubStandIn) GetSchema(_ context.Context, request *pubsub.SchemaRequest) (*pubsub.SchemaInfo, error) {
+	return &pubsub.SchemaInfo{SchemaJson: s.schemaJSON, SchemaId: request.GetSchemaId()}, nil
+}
+
+func (s *pubSubStandIn) Subscribe(stream grpc.BidiStreamingServer[pubsub.FetchRequest, pubsub.FetchResponse]) error {
+	incoming, _ := metadata.FromIncomingContext(stream.Context())
+
+	s.mutex.Lock()
+	s.headers = map[string]string{}
+
+	for _, key := range []string{"accesstoken", "instanceurl", "tenantid"} {
+		if values := incoming.Get(key); len(values) != 0 {
+			s.headers[key] = values[0]
+		}
+	}
+	s.mutex.Unlock()
+
+	for _, response := range s.responses {
+		request, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		s.mutex.Lock()
+		s.requests = append(s.requests, &pubsub.FetchRequest{
+			TopicName:    request.GetTopicName(),
+			ReplayPreset: request.GetReplayPreset(),
+			ReplayId:     request.GetReplayId(),
+			NumRequested: request.GetNumRequested(),
+		})
+		s.mutex.Unlock()
+
+		if err = stream.Send(response); err != nil {
+			return err
+		}
+	}
+
+	<-stream.Context().Done()
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package salesforce
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.QuotaConnector = &Connector{}
+
+// QuotaScopeAPIUsage is the rolling 24-hour limit of API requests of the org.
+// Other limits are available on demand via Connector.Limits.
+const QuotaScopeAPIUsage = "api-usage"
+
+// extractQuota parses the Sforce-Limit-Info header, formatted as "api-usage=25/15000".
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/headers_api_usage.htm
+func extractQuota(rsp *http.Response, observedAt time.Time) []common.QuotaStatus {
+	var statuses []common.QuotaStatus
+
+	for _, entry := range strings.Split(rsp.Header.Get("Sforce-Limit-Info"), ",") {
+		scope, usage, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+
+		usedText, limitText, ok := strings.Cut(usage, "/")
+		if !ok {
+			continue
+		}
+
+		used, err := strconv.ParseInt(usedText, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		limit, err := strconv.ParseInt(limitText, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		statuses = append(statuses, common.QuotaStatus{
+			Scope:      scope,
+			Remaining:  limit - used,
+			Limit:      limit,
+			ObservedAt: observedAt,
+		})
+	}
+
+	return statuses
+}
@@ -16,15 +16,17 @@ const defaultSOQLPageSize = 2000
 
 // Read reads data from Salesforce. By default, it will read all rows (backfill). However, if Since is set,
 // it will read only rows that have been updated since the specified time.
+// Values are normalized on request, see common.ReadParams.NormalizeValues.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
-	if err := config.ValidateParams(true); err != nil {
-		return nil, err
-	}
-
 	if c.isPardotModule() {
 		return c.pardotAdapter.Read(ctx, config)
 	}
 
+	return c.crmReader.Read(ctx, config)
+}
+
+// readCRM reads a page of records using SOQL, the params are validated by the crmReader.
+func (c *Connector) readCRM(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	url, err := c.buildReadURL(config)
 	if err != nil {
 		return nil, err
@@ -59,7 +61,12 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 		return nil, err
 	}
 
-	url.WithQueryParam("q", makeSOQL(config, c.GetTimestampColumn(common.ObjectName(config.ObjectName))).String())
+	soql, err := makeSOQL(config, c.GetTimestampColumn(common.ObjectName(config.ObjectName)))
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("q", soql.String())
 
 	return url, nil
 }
@@ -67,16 +74,19 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 // makeSOQL returns the SOQL query for the desired read operation.
 // The timestampColumn parameter specifies which field to use for Since/Until filtering
 // (typically "SystemModstamp").
-func makeSOQL(params common.ReadParams, timestampColumn string) *core.SOQLBuilder {
+func makeSOQL(params common.ReadParams, timestampColumn string) (*core.SOQLBuilder, error) {
 	fields := associations.FieldsForSelectQueryRead(&params)
 	soql := (&core.SOQLBuilder{}).SelectFields(fields).From(params.ObjectName)
-	addWhereClauses(soql, params, timestampColumn)
 
-	return soql
+	if err := addWhereClauses(soql, params, timestampColumn); err != nil {
+		return nil, err
+	}
+
+	return soql, nil
 }
 
 // addWhereClauses adds WHERE clauses to the SOQL query based on the config.
-func addWhereClauses(soql *core.SOQLBuilder, config common.ReadParams, timestampColumn string) {
+func addWhereClauses(soql *core.SOQLBuilder, config common.ReadParams, timestampColumn string) error {
 	// If Since is not set, then we're doing a backfill. We read all rows (in pages)
 	if !config.Since.IsZero() {
 		soql.Where(timestampColumn + " > " + datautils.Time.FormatRFC3339inUTC(config.Since))
@@ -96,17 +106,27 @@ func addWhereClauses(soql *core.SOQLBuilder, config common.ReadParams, timestamp
 
 	if config.BuilderFilter != nil {
 		for _, ff := range config.BuilderFilter.FieldFilters {
-			if ff.Operator != common.FilterOperatorEQ {
-				continue
+			switch ff.Operator {
+			case common.FilterOperatorEQ:
+				soql.Where(buildSOQLEqCondition(ff.FieldName, ff.Value))
+			case common.FilterOperatorIN:
+				values, err := ff.InValues()
+				if err != nil {
+					return err
+				}
+
+				soql.Where(buildSOQLInCondition(ff.FieldName, values))
+			default:
+				return fmt.Errorf("%w: filter operator %q", common.ErrOperationNotSupportedForObject, ff.Operator)
 			}
-
-			soql.Where(buildSOQLEqCondition(ff.FieldName, ff.Value))
 		}
 	}
 
 	if config.PageSize > 0 {
 		soql.Limit(int64(config.PageSize))
 	}
+
+	return nil
 }
 
 // DeployApexTriggersForFilteredRead builds and deploys filtered-read apex triggers
@@ -136,17 +156,29 @@ func (c *Connector) DefaultPageSize() int {
 }
 
 // buildSOQLEqCondition builds a SOQL equality condition for a field and value.
-// String values are single-quoted and escaped to prevent SOQL injection.
 func buildSOQLEqCondition(fieldName string, value any) string {
+	return fmt.Sprintf("%s = %s", fieldName, formatSOQLValue(value))
+}
+
+// buildSOQLInCondition builds a SOQL condition matching any of the values.
+func buildSOQLInCondition(fieldName string, values []any) string {
+	formatted := make([]string, len(values))
+	for index, value := range values {
+		formatted[index] = formatSOQLValue(value)
+	}
+
+	return fmt.Sprintf("%s IN (%s)", fieldName, strings.Join(formatted, ","))
+}
+
+// formatSOQLValue formats a literal of a SOQL condition.
+// String values are single-quoted and escaped to prevent SOQL injection.
+func formatSOQLValue(value any) string {
 	switch v := value.(type) {
 	case string:
-		escaped := strings.ReplaceAll(v, `\`, `\\`)
-		escaped = strings.ReplaceAll(escaped, "'", `\'`)
-
-		return fmt.Sprintf("%s = '%s'", fieldName, escaped)
+		return "'" + core.EscapeSOQLString(v) + "'"
 	case bool:
-		return fmt.Sprintf("%s = %t", fieldName, v)
+		return fmt.Sprintf("%t", v)
 	default:
-		return fmt.Sprintf("%s = %v", fieldName, v)
+		return fmt.Sprintf("%v", v)
 	}
 }
@@ -24,6 +24,7 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	responseUnknownObject := testutils.DataFromFile(t, "unknown-object.json")
 	responseLeadsFirstPage := testutils.DataFromFile(t, "read-list-leads.json")
 	responseListContacts := testutils.DataFromFile(t, "read-list-contacts.json")
+	responseOrgMeta := testutils.DataFromFile(t, "metadata/read/organization-sampled.json")
 	responseOpportunityWithAccount := testutils.DataFromFile(t, "read-opportunity-with-account.json")
 	responseOpportunityWithContacts := testutils.DataFromFile(t, "read-opportunity-with-contacts.json")
 
@@ -141,6 +142,42 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Values are normalized using the object metadata",
+			Input: common.ReadParams{
+				ObjectName:      "Organization",
+				Fields:          connectors.Fields("Latitude", "PreferencesConsentManagementEnabled"),
+				NormalizeValues: true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.Path("/services/data/v60.0/query"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"totalSize":1,"done":true,"records":[{
+						"Id":"00Dak00000Ct7DhEAJ","Latitude":"48.85","PreferencesConsentManagementEnabled":"true"
+					}]}`),
+				}, {
+					If:   mockcond.Path("/services/data/v60.0/composite"),
+					Then: mockserver.Response(http.StatusOK, responseOrgMeta),
+				}},
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetRead,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"latitude":                            48.85,
+						"preferencesconsentmanagementenabled": true,
+					},
+					Raw: map[string]any{
+						"Latitude": "48.85",
+					},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Read Opportunity with Accounts association - AccountId added to SOQL and association extracted",
 			Input: common.ReadParams{
@@ -69,11 +69,12 @@ func (c *Connector) buildReadByIdentifierURL(config recordsByIDsParams) (*urlbui
 		return nil, err
 	}
 
-	query := makeSOQL(config.ReadParams, c.GetTimestampColumn(common.ObjectName(config.ObjectName))).
-		WithIDs(config.RecordIdentifiers.List()).
-		String()
+	soql, err := makeSOQL(config.ReadParams, c.GetTimestampColumn(common.ObjectName(config.ObjectName)))
+	if err != nil {
+		return nil, err
+	}
 
-	url.WithQueryParam("q", query)
+	url.WithQueryParam("q", soql.WithIDs(config.RecordIdentifiers.List()).String())
 
 	return url, nil
 }
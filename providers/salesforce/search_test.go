@@ -6,6 +6,8 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/search"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
 	"github.com/amp-labs/connectors/test/utils/testconn"
@@ -20,6 +22,8 @@ func TestSearch(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	responseListContacts := testutils.DataFromFile(t, "read-list-contacts.json")
 	responseOpportunityWithAccount := testutils.DataFromFile(t, "read-opportunity-with-account.json")
 	responseOpportunityWithContacts := testutils.DataFromFile(t, "read-opportunity-with-contacts.json")
+	responseTextSearchAccounts := testutils.DataFromFile(t, "search/sosl-accounts.json")
+	responseTextSearchAccountsContacts := testutils.DataFromFile(t, "search/sosl-accounts-contacts.json")
 
 	tests := []testconn.TestCaseSearch{
 		{
@@ -281,6 +285,187 @@ func TestSearch(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Text search requires a term",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text:       &common.TextSearch{Term: " "},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingSearchTerm},
+		},
+		{
+			Name: "Text search scope must be known",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text:       &common.TextSearch{Term: "Acme", Scope: "title"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{search.ErrTextSearchScope},
+		},
+		{
+			Name: "Text search escapes reserved characters and pages by offset",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text:       &common.TextSearch{Term: "Acme & Co. (EU)", Scope: common.TextSearchScopeName},
+				Limit:      2,
+				NextPage:   "2",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/services/data/v60.0/search"),
+					mockcond.QueryParam("q",
+						`FIND {Acme \& Co. \(EU\)} IN NAME FIELDS RETURNING Account(Id,Name LIMIT 2 OFFSET 2)`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseTextSearchAccounts),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetRead,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "Acme & Co. (EU)"},
+					Raw:    map[string]any{"Id": "001ak00000OQTieAAH"},
+					Id:     "001ak00000OQTieAAH",
+				}, {
+					Fields: map[string]any{"name": "Acme & Co. (EU) Holdings"},
+					Raw:    map[string]any{"Id": "001ak00000OQTifAAH"},
+					Id:     "001ak00000OQTifAAH",
+				}},
+				NextPage: "4",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Text search across objects tags rows with their object",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text: &common.TextSearch{
+					Term:              "Paris",
+					AdditionalObjects: map[string]datautils.StringSet{"Contact": connectors.Fields("Email")},
+				},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/services/data/v60.0/search"),
+					mockcond.QueryParam("q",
+						"FIND {Paris} IN ALL FIELDS RETURNING Account(Id,Name LIMIT 200), Contact(Id,Email LIMIT 200)"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseTextSearchAccountsContacts),
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "Paris Bakery"},
+					Raw: map[string]any{
+						"attributes": map[string]any{
+							"type": "Account",
+							"url":  "/services/data/v60.0/sobjects/Account/001ak00000OQTieAAH",
+						},
+						"Id":   "001ak00000OQTieAAH",
+						"Name": "Paris Bakery",
+					},
+					Id:         "001ak00000OQTieAAH",
+					ObjectName: "Account",
+				}, {
+					Fields: map[string]any{"email": "paris@example.com"},
+					Raw: map[string]any{
+						"attributes": map[string]any{
+							"type": "Contact",
+							"url":  "/services/data/v60.0/sobjects/Contact/003ak00000GkLmNAAV",
+						},
+						"Id":    "003ak00000GkLmNAAV",
+						"Email": "paris@example.com",
+					},
+					Id:         "003ak00000GkLmNAAV",
+					ObjectName: "Contact",
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Text search across objects pages every object whose page is full",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text: &common.TextSearch{
+					Term:              "Paris",
+					AdditionalObjects: map[string]datautils.StringSet{"Contact": connectors.Fields("Email")},
+				},
+				Limit: 1,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/services/data/v60.0/search"),
+					mockcond.QueryParam("q",
+						"FIND {Paris} IN ALL FIELDS RETURNING Account(Id,Name LIMIT 1), Contact(Id,Email LIMIT 1)"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseTextSearchAccountsContacts),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "Account:1,Contact:1",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Text search across objects continues only the objects left in the token",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text: &common.TextSearch{
+					Term:              "Paris",
+					AdditionalObjects: map[string]datautils.StringSet{"Contact": connectors.Fields("Email")},
+				},
+				Limit:    1,
+				NextPage: "Contact:1",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/services/data/v60.0/search"),
+					mockcond.QueryParam("q",
+						"FIND {Paris} IN ALL FIELDS RETURNING Contact(Id,Email LIMIT 1 OFFSET 1)"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"searchRecords":[]}`),
+			}.Server(),
+			Comparator: testconn.ComparatorPagination,
+			Expected: &common.ReadResult{
+				Rows:     0,
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Text search across objects rejects a token of an object not searched",
+			Input: common.SearchParams{
+				ObjectName: "Account",
+				Fields:     connectors.Fields("Name"),
+				Text: &common.TextSearch{
+					Term:              "Paris",
+					AdditionalObjects: map[string]datautils.StringSet{"Contact": connectors.Fields("Email")},
+				},
+				NextPage: "Lead:200",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrNextPageInvalid},
+		},
 	}
 
 	for _, tt := range tests {
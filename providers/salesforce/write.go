@@ -2,7 +2,13 @@ package salesforce
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/jsonquery"
 	"github.com/spyzhov/ajson"
@@ -20,28 +26,35 @@ func (c *Connector) BatchWrite(ctx context.Context, params *common.BatchWritePar
 //
 //nolint:cyclop
 func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
-	if err := config.ValidateParams(); err != nil {
-		return nil, err
-	}
-
+	// Account Engagement has no upsert endpoint, its writer rejects an upsert key.
 	if c.isPardotModule() {
 		return c.pardotAdapter.Write(ctx, config)
 	}
 
-	url, err := c.getRestApiURL("sobjects", config.ObjectName)
+	if err := config.ValidateUpsertParams(); err != nil {
+		return nil, err
+	}
+
+	link, err := c.getRestApiURL("sobjects", config.ObjectName)
 	if err != nil {
 		return nil, err
 	}
 
-	if config.RecordId != "" {
-		url.AddPath(config.RecordId)
+	switch {
+	case config.IsUpsert():
+		// Upsert matches the record by an external ID field.
+		// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/dome_upsert.htm
+		link.AddPath(config.UpsertKey.Field, url.PathEscape(fmt.Sprint(config.UpsertKey.Value)))
+		link.WithQueryParam("_HttpMethod", "PATCH")
+	case config.RecordId != "":
+		link.AddPath(config.RecordId)
 		// Salesforce allows for PATCH method override
-		url.WithQueryParam("_HttpMethod", "PATCH")
+		link.WithQueryParam("_HttpMethod", "PATCH")
 	}
 
 	headers := common.TransformWriteHeaders(config.Headers, common.HeaderModeOverwrite)
 
-	rsp, err := c.Client.Post(ctx, url.String(), config.RecordData, headers...)
+	rsp, err := c.Client.Post(ctx, link.String(), config.RecordData, headers...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +64,13 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
+	if config.IsUpsert() {
+		rslt.Outcome, err = parseUpsertOutcome(rsp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if config.RecordId != "" && rslt.Success && rslt.RecordId == "" {
 		rslt.RecordId = config.RecordId
 	}
@@ -58,6 +78,70 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 	return rslt, nil
 }
 
+// SupportsUpsert reports whether Write honors common.WriteParams.UpsertKey.
+// Salesforce upserts CRM objects which can be both created and updated,
+// matching records by the Id field or any field marked as an external ID.
+// Account Engagement has no upsert endpoint.
+func (c *Connector) SupportsUpsert(ctx context.Context, objectName string, field string) (bool, error) {
+	if c.isPardotModule() {
+		return false, nil
+	}
+
+	link, err := c.getRestApiURL("sobjects", objectName, "describe")
+	if err != nil {
+		return false, err
+	}
+
+	rsp, err := c.Client.Get(ctx, link.String())
+	if err != nil {
+		var httpErr *common.HTTPError
+		if errors.As(err, &httpErr) && httpErr.Status == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	object, err := common.UnmarshalJSON[describeSObjectResult](rsp)
+	if err != nil {
+		return false, err
+	}
+
+	if !object.Createable || !object.Updateable {
+		return false, nil
+	}
+
+	for _, candidate := range object.Fields {
+		if strings.EqualFold(candidate.Name, field) {
+			return candidate.Type == "id" || (candidate.ExternalID != nil && *candidate.ExternalID), nil
+		}
+	}
+
+	return false, nil
+}
+
+var _ connectors.UpsertConnector = &Connector{}
+
+// parseUpsertOutcome tells whether the upsert created the record.
+// Updates may respond with an empty body.
+func parseUpsertOutcome(rsp *common.JSONHTTPResponse) (common.WriteOutcome, error) {
+	body, ok := rsp.Body()
+	if !ok {
+		return common.WriteOutcomeUpdated, nil
+	}
+
+	created, err := jsonquery.New(body).BoolWithDefault("created", rsp.Code == http.StatusCreated)
+	if err != nil {
+		return "", err
+	}
+
+	if created {
+		return common.WriteOutcomeCreated, nil
+	}
+
+	return common.WriteOutcomeUpdated, nil
+}
+
 // parseWriteResult parses the response from writing to Salesforce API. A 2xx return type is assumed.
 func parseWriteResult(rsp *common.JSONHTTPResponse) (*common.WriteResult, error) {
 	body, ok := rsp.Body()
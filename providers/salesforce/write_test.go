@@ -18,6 +18,8 @@ func TestWrite(t *testing.T) { // nolint:funlen,cyclop
 	responseInvalidFieldUpsert := testutils.DataFromFile(t, "invalid-field-upsert.json")
 	responseCreateOK := testutils.DataFromFile(t, "create-ok.json")
 	responseOKWithErrors := testutils.DataFromFile(t, "success-with-errors.json")
+	responseUpsertCreated := testutils.DataFromFile(t, "upsert-created.json")
+	responseUpsertUpdated := testutils.DataFromFile(t, "upsert-updated.json")
 
 	tests := []testconn.TestCaseWrite{
 		{
@@ -110,6 +112,60 @@ func TestWrite(t *testing.T) { // nolint:funlen,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Upsert key cannot be combined with record ID",
+			Input: common.WriteParams{
+				ObjectName: "account", RecordId: "001ak00000OQTieAAH", RecordData: "dummy",
+				UpsertKey: &common.UpsertKey{Field: "External_Id__c", Value: "ext-1"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUpsertKeyWithRecordID},
+		},
+		{
+			Name: "Upsert by external ID creates a record",
+			Input: common.WriteParams{
+				ObjectName: "account", RecordData: "dummy",
+				UpsertKey: &common.UpsertKey{Field: "External_Id__c", Value: "ext 1"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/services/data/v60.0/sobjects/account/External_Id__c/ext 1"),
+					mockcond.QueryParam("_HttpMethod", "PATCH"),
+				},
+				Then: mockserver.Response(http.StatusCreated, responseUpsertCreated),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "001ak00000OQTieAAH",
+				Errors:   []any{},
+				Outcome:  common.WriteOutcomeCreated,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Upsert by external ID updates a record",
+			Input: common.WriteParams{
+				ObjectName: "account", RecordData: "dummy",
+				UpsertKey: &common.UpsertKey{Field: "External_Id__c", Value: 42},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/services/data/v60.0/sobjects/account/External_Id__c/42"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseUpsertUpdated),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "001ak00000OQTieAAH",
+				Errors:   []any{},
+				Outcome:  common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -145,6 +201,16 @@ func TestWritePardot(t *testing.T) { // nolint:funlen,cyclop
 			Server:       mockserver.Dummy(),
 			ExpectedErrs: []error{common.ErrMissingRecordData},
 		},
+		{
+			Name: "Prospects cannot be upserted",
+			Input: common.WriteParams{
+				ObjectName: "prospects",
+				RecordData: map[string]any{"firstName": "Ann"},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "ann@example.com"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
 		{
 			Name:  "Create a prospect",
 			Input: common.WriteParams{ObjectName: "proSPEcTs", RecordData: "dummy"},
@@ -208,3 +274,103 @@ func TestWritePardot(t *testing.T) { // nolint:funlen,cyclop
 		})
 	}
 }
+
+func TestSupportsUpsert(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	describeAccount := `{"name":"Account","createable":true,"updateable":true,"fields":[
+		{"name":"Id","type":"id"},
+		{"name":"Name","type":"string","externalId":false},
+		{"name":"External_Id__c","type":"string","externalId":true}
+	]}`
+
+	tests := []supportsUpsertTestCase{
+		{
+			Name:  "External ID field is an upsert key",
+			Input: upsertKeyField{objectName: "Account", field: "external_id__c"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodGET(),
+					mockcond.Path("/services/data/v60.0/sobjects/Account/describe"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, describeAccount),
+			}.Server(),
+			Expected: true,
+		},
+		{
+			Name:  "Id is an upsert key",
+			Input: upsertKeyField{objectName: "Account", field: "Id"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/sobjects/Account/describe"),
+				Then:  mockserver.ResponseString(http.StatusOK, describeAccount),
+			}.Server(),
+			Expected: true,
+		},
+		{
+			Name:  "Field which is not an external ID is not an upsert key",
+			Input: upsertKeyField{objectName: "Account", field: "Name"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/sobjects/Account/describe"),
+				Then:  mockserver.ResponseString(http.StatusOK, describeAccount),
+			}.Server(),
+			Expected: false,
+		},
+		{
+			Name:  "Object which cannot be created is not upserted",
+			Input: upsertKeyField{objectName: "AccountHistory", field: "Id"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/sobjects/AccountHistory/describe"),
+				Then: mockserver.ResponseString(http.StatusOK,
+					`{"name":"AccountHistory","createable":false,"updateable":false,"fields":[{"name":"Id","type":"id"}]}`),
+			}.Server(),
+			Expected: false,
+		},
+		{
+			Name:  "Unknown object is not upserted",
+			Input: upsertKeyField{objectName: "Butterfly", field: "Id"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/services/data/v60.0/sobjects/Butterfly/describe"),
+				Then: mockserver.ResponseString(http.StatusNotFound,
+					`[{"errorCode":"NOT_FOUND","message":"The requested resource does not exist"}]`),
+			}.Server(),
+			Expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+type upsertKeyField struct {
+	objectName string
+	field      string
+}
+
+type (
+	testCaseTypeSupportsUpsert = testconn.TestCase[upsertKeyField, bool]
+	supportsUpsertTestCase     testCaseTypeSupportsUpsert
+)
+
+func (c supportsUpsertTestCase) Run(t *testing.T, builder testconn.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	t.Cleanup(func() {
+		testCaseTypeSupportsUpsert(c).Close()
+	})
+
+	conn := builder.Build(t, c.Name)
+	output, err := conn.SupportsUpsert(t.Context(), c.Input.objectName, c.Input.field)
+	testCaseTypeSupportsUpsert(c).Validate(t, err, output)
+}
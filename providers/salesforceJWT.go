@@ -58,6 +58,7 @@ func init() {
 				Delete: true,
 			},
 			Delete: true,
+			Merge:  true,
 			Proxy:  true,
 			Read:   true,
 			// Subscribe (CDC / Event Relay) is supported in principle over JWT Bearer
@@ -99,6 +100,7 @@ func init() {
 						Delete: true,
 					},
 					Delete:    true,
+					Merge:     true,
 					Proxy:     true,
 					Read:      true,
 					Subscribe: true,
@@ -77,5 +77,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 		return nil, common.ErrUnsupportedModule
 	}
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
@@ -17,6 +17,10 @@ import (
 // with AND. Only equality is supported in common.SearchFilter today, which maps
 // cleanly onto the `field=value` form.
 func (c *Connector) Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	if params.IsTextSearch() {
+		return nil, fmt.Errorf("%w: text search", common.ErrOperationNotSupportedForObject)
+	}
+
 	url, err := c.constructSearchURL(params)
 	if err != nil {
 		return nil, err
@@ -72,7 +76,7 @@ func (c *Connector) constructSearchURL(params *common.SearchParams) (*urlbuilder
 
 // buildSysparmQuery converts a SearchFilter into a ServiceNow encoded-query string.
 // Multiple FieldFilters are AND-joined with `^`, matching the SearchFilter contract.
-// Only FilterOperatorEQ is supported because that is the only operator in common.
+// Only FilterOperatorEQ is supported.
 func buildSysparmQuery(filter *common.SearchFilter) (string, error) {
 	if filter == nil || len(filter.FieldFilters) == 0 {
 		return "", nil
@@ -22,6 +22,9 @@ type Connector struct {
 	// Require authenticated client
 	common.RequireAuthenticatedClient
 
+	// QuotaTracker records the rate limits reported with every response.
+	*common.QuotaTracker
+
 	// Supported operations
 	components.SchemaProvider
 	components.Reader
@@ -35,7 +38,13 @@ func NewConnector(params common.ConnectorParams) (*Connector, error) {
 }
 
 func constructor(base *components.Connector) (*Connector, error) {
-	connector := &Connector{Connector: base}
+	connector := &Connector{
+		Connector:    base,
+		QuotaTracker: common.NewQuotaTracker(extractQuota),
+	}
+
+	// Wrapped before any operation captures the client.
+	connector.HTTPClient().Client = connector.QuotaTracker.Client(connector.HTTPClient().Client)
 
 	connector.SchemaProvider = schema.NewObjectSchemaProvider(
 		connector.HTTPClient().Client,
@@ -88,6 +97,8 @@ func constructor(base *components.Connector) (*Connector, error) {
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
 
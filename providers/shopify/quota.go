@@ -0,0 +1,67 @@
+package shopify
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.QuotaConnector = &Connector{}
+
+// QuotaScopeGraphQLCost is the bucket of query cost points, which refills at the restore rate.
+const QuotaScopeGraphQLCost = "graphql-cost"
+
+type costExtensions struct {
+	Extensions struct {
+		Cost struct {
+			ThrottleStatus struct {
+				MaximumAvailable   float64 `json:"maximumAvailable"`
+				CurrentlyAvailable float64 `json:"currentlyAvailable"`
+				RestoreRate        float64 `json:"restoreRate"`
+			} `json:"throttleStatus"`
+		} `json:"cost"`
+	} `json:"extensions"`
+}
+
+// extractQuota parses extensions.cost of GraphQL responses.
+// Reset is when the bucket is full again at the restore rate.
+// https://shopify.dev/docs/api/usage/limits#graphql-admin-api-rate-limits
+func extractQuota(rsp *http.Response, observedAt time.Time) []common.QuotaStatus {
+	if !strings.Contains(rsp.Header.Get("Content-Type"), "json") {
+		return nil
+	}
+
+	body, err := common.PeekResponseBody(rsp)
+	if err != nil {
+		return nil
+	}
+
+	var cost costExtensions
+	if err = json.Unmarshal(body, &cost); err != nil {
+		return nil
+	}
+
+	throttle := cost.Extensions.Cost.ThrottleStatus
+	if throttle.MaximumAvailable == 0 {
+		return nil
+	}
+
+	status := common.QuotaStatus{
+		Scope:      QuotaScopeGraphQLCost,
+		Remaining:  int64(throttle.CurrentlyAvailable),
+		Limit:      int64(throttle.MaximumAvailable),
+		ObservedAt: observedAt,
+	}
+
+	if throttle.RestoreRate > 0 {
+		seconds := (throttle.MaximumAvailable - throttle.CurrentlyAvailable) / throttle.RestoreRate
+		status.Reset = observedAt.Add(time.Duration(math.Ceil(seconds)) * time.Second)
+	}
+
+	return []common.QuotaStatus{status}
+}
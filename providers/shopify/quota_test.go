@@ -0,0 +1,43 @@
+package shopify
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestExtractQuota(t *testing.T) {
+	t.Parallel()
+
+	body := `{"data":{},"extensions":{"cost":{"requestedQueryCost":12,"actualQueryCost":12,` +
+		`"throttleStatus":{"maximumAvailable":2000.0,"currentlyAvailable":1988,"restoreRate":100.0}}}}`
+
+	rsp := &http.Response{
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   io.NopCloser(strings.NewReader(body)),
+	}
+
+	observedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testutils.CheckOutput(t, "GraphQL cost",
+		[]common.QuotaStatus{{
+			Scope:      QuotaScopeGraphQLCost,
+			Remaining:  1988,
+			Limit:      2000,
+			Reset:      observedAt.Add(time.Second),
+			ObservedAt: observedAt,
+		}},
+		extractQuota(rsp, observedAt),
+	)
+
+	// The body remains readable for the response parser.
+	restored, err := io.ReadAll(rsp.Body)
+	if err != nil || string(restored) != body {
+		t.Errorf("body was not restored: %q, %v", restored, err)
+	}
+}
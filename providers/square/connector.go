@@ -62,5 +62,7 @@ func constructor(_ common.ConnectorParams, base *components.Connector) (*Connect
 		},
 	)
 
+	connector.Reader = reader.NewNormalizingReader(connector.Reader, connector.SchemaProvider)
+
 	return connector, nil
 }
@@ -56,12 +56,13 @@ func (e ApiKeyOptsAttachmentType) Valid() bool {
 
 // Defines values for AuthType.
 const (
-	ApiKey AuthType = "apiKey"
-	Basic  AuthType = "basic"
-	Custom AuthType = "custom"
-	Jwt    AuthType = "jwt"
-	None   AuthType = "none"
-	Oauth2 AuthType = "oauth2"
+	ApiKey   AuthType = "apiKey"
+	AwsSigV4 AuthType = "awsSigV4"
+	Basic    AuthType = "basic"
+	Custom   AuthType = "custom"
+	Jwt      AuthType = "jwt"
+	None     AuthType = "none"
+	Oauth2   AuthType = "oauth2"
 )
 
 // Valid indicates whether the value is a known member of the AuthType enum.
@@ -69,6 +70,8 @@ func (e AuthType) Valid() bool {
 	switch e {
 	case ApiKey:
 		return true
+	case AwsSigV4:
+		return true
 	case Basic:
 		return true
 	case Custom:
@@ -105,6 +108,63 @@ func (e CustomAuthInputFieldType) Valid() bool {
 	}
 }
 
+// Defines values for JwtOptsAlgorithm.
+const (
+	ES256 JwtOptsAlgorithm = "ES256"
+	ES384 JwtOptsAlgorithm = "ES384"
+	HS256 JwtOptsAlgorithm = "HS256"
+	HS384 JwtOptsAlgorithm = "HS384"
+	HS512 JwtOptsAlgorithm = "HS512"
+	PS256 JwtOptsAlgorithm = "PS256"
+	RS256 JwtOptsAlgorithm = "RS256"
+	RS384 JwtOptsAlgorithm = "RS384"
+	RS512 JwtOptsAlgorithm = "RS512"
+)
+
+// Valid indicates whether the value is a known member of the JwtOptsAlgorithm enum.
+func (e JwtOptsAlgorithm) Valid() bool {
+	switch e {
+	case ES256:
+		return true
+	case ES384:
+		return true
+	case HS256:
+		return true
+	case HS384:
+		return true
+	case HS512:
+		return true
+	case PS256:
+		return true
+	case RS256:
+		return true
+	case RS384:
+		return true
+	case RS512:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JwtOptsMode.
+const (
+	JwtBearerGrant   JwtOptsMode = "bearerGrant"
+	JwtSignedRequest JwtOptsMode = "signedRequest"
+)
+
+// Valid indicates whether the value is a known member of the JwtOptsMode enum.
+func (e JwtOptsMode) Valid() bool {
+	switch e {
+	case JwtBearerGrant:
+		return true
+	case JwtSignedRequest:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Oauth2OptsGrantType.
 const (
 	AuthorizationCode     Oauth2OptsGrantType = "authorizationCode"
@@ -241,6 +301,22 @@ type AuthHealthCheck struct {
 // AuthType The type of authentication required by the provider.
 type AuthType string
 
+// AwsSigV4Opts Configuration for AWS Signature Version 4. Must be provided if authType is awsSigV4.
+type AwsSigV4Opts struct {
+	// DocsURL URL with more information about creating AWS credentials for the provider.
+	DocsURL string `json:"docsURL,omitempty"`
+
+	// Region The AWS region requests are signed for, usually a catalog variable.
+	//
+	// Example: {{.region}}
+	Region string `json:"region" validate:"required"`
+
+	// Service The signing name of the AWS service.
+	//
+	// Example: execute-api
+	Service string `json:"service" validate:"required"`
+}
+
 // BasicAuthOpts Configuration for Basic Auth. Optional.
 type BasicAuthOpts struct {
 	// ApiKeyAsBasic If true, the provider uses an API key which then gets encoded as a basic auth user:pass string.
@@ -393,6 +469,62 @@ type CustomAuthQueryParam struct {
 	ValueTemplate string `json:"valueTemplate" skipSubstitutions:"true"`
 }
 
+// JwtOpts Configuration for JWT. Must be provided if authType is jwt.
+type JwtOpts struct {
+	// AccessTokenTTL Seconds an access token obtained with the bearer grant is considered valid, when the token response has no expires_in. Defaults to 30 minutes.
+	//
+	// Example: 1800
+	AccessTokenTTL int `json:"accessTokenTTL,omitempty"`
+
+	// Algorithm The JWS signing algorithm. It determines the key type: HS* use a shared secret, RS* and PS* an RSA private key, ES* an ECDSA private key.
+	Algorithm JwtOptsAlgorithm `json:"algorithm" validate:"required"`
+
+	// AssertionTTL Seconds until a signed JWT expires, used for the exp claim. Defaults to 3 minutes.
+	//
+	// Example: 180
+	AssertionTTL int `json:"assertionTTL,omitempty"`
+
+	// Audience The aud claim.
+	//
+	// Example: https://login.salesforce.com
+	Audience string `json:"audience,omitempty"`
+
+	// Claims Additional claims, represented as Golang text/template expressions evaluated with the connection's credential values. Only the backend will interpret these.
+	//
+	// Example: {"sub": "{{ .username }}"}
+	Claims map[string]string `json:"claims,omitempty" skipSubstitutions:"true"`
+
+	// DocsURL URL with more information about the JWT setup.
+	DocsURL string `json:"docsURL,omitempty"`
+
+	// HeaderPrefix The prefix of the Authorization header in signedRequest mode. Defaults to Bearer.
+	//
+	// Example: JWT
+	HeaderPrefix string `json:"headerPrefix,omitempty"`
+
+	// Issuer The iss claim, represented as a Golang text/template expression evaluated with the connection's credential values.
+	//
+	// Example: {{ .clientId }}
+	Issuer string `json:"issuer,omitempty" skipSubstitutions:"true"`
+
+	// Mode How the JWT is used. bearerGrant exchanges it at tokenURL for an access token (RFC 7523), signedRequest signs every request with a fresh JWT.
+	Mode JwtOptsMode `json:"mode" validate:"required"`
+
+	// QueryStringHash Whether signed requests carry the Atlassian Connect qsh claim, a hash of the request method, path and query.
+	QueryStringHash bool `json:"queryStringHash,omitempty"`
+
+	// TokenURL The token endpoint for the bearer grant. Must be provided if mode is bearerGrant.
+	//
+	// Example: https://{{.workspace}}.my.salesforce.com/services/oauth2/token
+	TokenURL string `json:"tokenURL,omitempty"`
+}
+
+// JwtOptsAlgorithm The JWS signing algorithm. It determines the key type: HS* use a shared secret, RS* and PS* an RSA private key, ES* an ECDSA private key.
+type JwtOptsAlgorithm string
+
+// JwtOptsMode How the JWT is used. bearerGrant exchanges it at tokenURL for an access token (RFC 7523), signedRequest signs every request with a fresh JWT.
+type JwtOptsMode string
+
 // Labels defines model for Labels.
 type Labels map[string]string
 
@@ -567,6 +699,9 @@ type ProviderInfo struct {
 	// AuthType The type of authentication required by the provider.
 	AuthType AuthType `json:"authType" validate:"required"`
 
+	// AwsSigV4Opts Configuration for AWS Signature Version 4. Must be provided if authType is awsSigV4.
+	AwsSigV4Opts *AwsSigV4Opts `json:"awsSigV4Opts,omitempty"`
+
 	// BaseURL The base URL for making API requests.
 	BaseURL string `json:"baseURL" validate:"required"`
 
@@ -580,9 +715,12 @@ type ProviderInfo struct {
 	// DisplayName The display name of the provider, if omitted, defaults to provider name.
 	//
 	// Example: Zendesk Chat
-	DisplayName string  `json:"displayName,omitempty"`
-	Labels      *Labels `json:"labels,omitempty"`
-	Media       *Media  `json:"media,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+
+	// JwtOpts Configuration for JWT. Must be provided if authType is jwt.
+	JwtOpts *JwtOpts `json:"jwtOpts,omitempty"`
+	Labels  *Labels  `json:"labels,omitempty"`
+	Media   *Media   `json:"media,omitempty"`
 
 	// Metadata Provider metadata that needs to be given by the user or fetched by the connector post authentication for the connector to work.
 	Metadata *ProviderMetadata `json:"metadata,omitempty"`
@@ -656,6 +794,7 @@ type Support struct {
 	BatchWrite       *BatchWriteSupport `json:"batchWrite,omitempty"`
 	BulkWrite        BulkWriteSupport   `json:"bulkWrite" validate:"required"`
 	Delete           bool               `json:"delete"`
+	Merge            bool               `json:"merge"`
 	Proxy            bool               `json:"proxy"`
 	Read             bool               `json:"read"`
 	Search           SearchSupport      `json:"search"`
@@ -360,6 +360,18 @@ type NewClientParams struct {
 	// CustomCreds is the custom auth credentials to use for the client. If the provider uses
 	// custom auth, this field must be set.
 	CustomCreds *CustomAuthParams
+
+	// JwtCreds are the JWT signing credentials to use for the client. If the provider uses
+	// JWT auth, this field must be set.
+	JwtCreds *JwtParams
+
+	// AwsSigV4Creds are the AWS credentials to use for the client. If the provider uses
+	// AWS SigV4 auth, this field must be set.
+	AwsSigV4Creds *AwsSigV4Params
+
+	// MTLS attaches a client certificate to every connection, in addition to the
+	// provider's auth type. It is optional.
+	MTLS *MTLSParams
 }
 
 // NewClient will create a new authenticated client based on the provider's auth type.
@@ -368,6 +380,17 @@ func (i *ProviderInfo) NewClient(ctx context.Context, params *NewClientParams) (
 		params = &NewClientParams{}
 	}
 
+	if params.MTLS != nil {
+		client, err := newMTLSClient(params.Client, params.MTLS)
+		if err != nil {
+			return nil, err
+		}
+
+		withCert := *params
+		withCert.Client = client
+		params = &withCert
+	}
+
 	switch i.AuthType {
 	case None:
 		return createUnauthenticatedClient(ctx, params.Client, params.Debug)
@@ -426,8 +449,26 @@ func (i *ProviderInfo) NewClient(ctx context.Context, params *NewClientParams) (
 		return createCustomHTTPClient(ctx, params.Client, params.Debug, params.OnUnauthorized,
 			params.IsUnauthorized, i, params.CustomCreds)
 	case Jwt:
-		// We shouldn't hit this case, because no providerInfo has auth type set to JWT yet.
-		fallthrough
+		if i.JwtOpts == nil {
+			return nil, fmt.Errorf("%w: jwt options not found", ErrClient)
+		}
+
+		if params.JwtCreds == nil {
+			return nil, fmt.Errorf("%w: jwt credentials not found", ErrClient)
+		}
+
+		return createJwtHTTPClient(ctx, params.Client, params.Debug, params.OnUnauthorized,
+			params.IsUnauthorized, i, params.JwtCreds)
+	case AwsSigV4:
+		if i.AwsSigV4Opts == nil {
+			return nil, fmt.Errorf("%w: aws sigv4 options not found", ErrClient)
+		}
+
+		if params.AwsSigV4Creds == nil {
+			return nil, fmt.Errorf("%w: aws credentials not found", ErrClient)
+		}
+
+		return createAwsSigV4HTTPClient(ctx, params.Client, i, params.AwsSigV4Creds)
 	default:
 		return nil, fmt.Errorf("%w: unsupported auth type %q", ErrClient, i.AuthType)
 	}
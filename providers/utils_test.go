@@ -244,7 +244,13 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 				BaseURL:     "https://api.hubapi.com",
 				DisplayName: "HubSpot",
 				Support: Support{
+					BulkWrite: BulkWriteSupport{
+						Insert: true,
+						Update: true,
+						Upsert: true,
+					},
 					Delete:    true,
+					Merge:     true,
 					Proxy:     true,
 					Read:      true,
 					Subscribe: true,
@@ -352,6 +358,12 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 				BaseURL:     "https://api.hubapi.com/crm",
 				DisplayName: "HubSpot CRM",
 				Support: Support{
+					BulkWrite: BulkWriteSupport{
+						Insert: true,
+						Update: true,
+						Upsert: true,
+						Delete: false,
+					},
 					BatchWrite: &BatchWriteSupport{
 						Create: BatchWriteSupportConfig{
 							DefaultRecordLimit: new(100), // nolint:mnd
@@ -365,6 +377,7 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 						},
 					},
 					Delete:    true,
+					Merge:     true,
 					Read:      true,
 					Subscribe: true,
 					Write:     true,
@@ -386,6 +399,12 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 				BaseURL:     "https://api.hubapi.com/crm",
 				DisplayName: "HubSpot CRM",
 				Support: Support{
+					BulkWrite: BulkWriteSupport{
+						Insert: true,
+						Update: true,
+						Upsert: true,
+						Delete: false,
+					},
 					BatchWrite: &BatchWriteSupport{
 						Create: BatchWriteSupportConfig{
 							DefaultRecordLimit: new(100), // nolint:mnd
@@ -399,6 +418,7 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 						},
 					},
 					Delete:    true,
+					Merge:     true,
 					Read:      true,
 					Subscribe: true,
 					Write:     true,
@@ -17,6 +17,9 @@ const apiVersion = "/api/v2"
 type Connector struct {
 	BaseURL string
 	Client  *common.JSONHTTPClient
+
+	// QuotaTracker records the rate limits reported with every response.
+	*common.QuotaTracker
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -43,6 +46,9 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		JSON: interpreter.NewFaultyResponder(errorFormats, statusCodeMapping),
 	}.Handle
 
+	conn.QuotaTracker = common.NewQuotaTracker(extractQuota)
+	conn.Client.HTTPClient.Client = conn.QuotaTracker.Client(conn.Client.HTTPClient.Client)
+
 	return conn, nil
 }
 
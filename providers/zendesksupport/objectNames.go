@@ -40,3 +40,13 @@ var objectsWithCustomFields = map[common.ModuleID]datautils.StringSet{ // nolint
 		objectNameRequests,
 	),
 }
+
+// upsertKeys lists fields by which the create_or_update endpoints match existing records.
+var upsertKeys = map[common.ModuleID]datautils.Map[string, datautils.StringSet]{ //nolint:gochecknoglobals
+	common.ModuleRoot: {
+		// https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-user
+		"users": datautils.NewStringSet("email", "external_id"),
+		// https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-or-update-organization
+		"organizations": datautils.NewStringSet("external_id"),
+	},
+}
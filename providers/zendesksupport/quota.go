@@ -0,0 +1,30 @@
+package zendesksupport
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.QuotaConnector = &Connector{}
+
+// QuotaScopeAccount is the per minute request limit of the Zendesk account.
+const QuotaScopeAccount = "account"
+
+// extractQuota parses the X-Rate-Limit headers, the reset comes from ratelimit-reset in seconds.
+// https://developer.zendesk.com/api-reference/introduction/rate-limits/
+func extractQuota(rsp *http.Response, observedAt time.Time) []common.QuotaStatus {
+	status, ok := common.QuotaFromHeaders(rsp.Header, common.RateLimitHeaders{
+		Limit:        "X-Rate-Limit",
+		Remaining:    "X-Rate-Limit-Remaining",
+		Reset:        "Ratelimit-Reset",
+		ResetIsDelay: true,
+	}, QuotaScopeAccount, observedAt)
+	if !ok {
+		return nil
+	}
+
+	return []common.QuotaStatus{status}
+}
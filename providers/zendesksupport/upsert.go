@@ -0,0 +1,95 @@
+package zendesksupport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+var _ connectors.UpsertConnector = &Connector{}
+
+// SupportsUpsert reports whether Write honors common.WriteParams.UpsertKey.
+// Users are matched by email or external ID, organizations by external ID.
+func (c *Connector) SupportsUpsert(_ context.Context, objectName string, field string) (bool, error) {
+	return c.supportsUpsert(objectName, field), nil
+}
+
+func (c *Connector) supportsUpsert(objectName string, field string) bool {
+	fields, ok := upsertKeys[common.ModuleRoot][objectName]
+
+	return ok && fields.Has(field)
+}
+
+// upsert writes via the create_or_update endpoint, which responds with 201 when the record was created.
+func (c *Connector) upsert(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
+	if !c.supportsUpsert(config.ObjectName, config.UpsertKey.Field) {
+		return nil, fmt.Errorf("%w: %s cannot be upserted by %s",
+			common.ErrOperationNotSupportedForObject, config.ObjectName, config.UpsertKey.Field)
+	}
+
+	url, err := urlbuilder.New(c.BaseURL, apiVersion, config.ObjectName, "create_or_update")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.Post(ctx, url.String(), upsertPayload(config))
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := common.WriteOutcomeUpdated
+	if res.Code == http.StatusCreated {
+		outcome = common.WriteOutcomeCreated
+	}
+
+	body, ok := res.Body()
+	if !ok {
+		return &common.WriteResult{
+			Success: true,
+			Outcome: outcome,
+		}, nil
+	}
+
+	result, err := constructWriteResult(config, body)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Outcome = outcome
+
+	return result, nil
+}
+
+// upsertPayload copies the upsert key into the record, as Zendesk matches records by the payload.
+// The record is nested under the singular object name, e.g. {"user": {...}}.
+func upsertPayload(config common.WriteParams) any {
+	payload, ok := config.RecordData.(map[string]any)
+	if !ok {
+		return config.RecordData
+	}
+
+	wrapper := naming.NewSingularString(config.ObjectName).String()
+
+	record, ok := payload[wrapper].(map[string]any)
+	if !ok {
+		return config.RecordData
+	}
+
+	if _, found := record[config.UpsertKey.Field]; found {
+		return config.RecordData
+	}
+
+	record = maps.Clone(record)
+	record[config.UpsertKey.Field] = config.UpsertKey.Value
+
+	payload = maps.Clone(payload)
+	payload[wrapper] = record
+
+	return payload
+}
@@ -11,10 +11,14 @@ import (
 )
 
 func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
-	if err := config.ValidateParams(); err != nil {
+	if err := config.ValidateUpsertParams(); err != nil {
 		return nil, err
 	}
 
+	if config.IsUpsert() {
+		return c.upsert(ctx, config)
+	}
+
 	url, err := c.getWriteURL(config.ObjectName)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,8 @@ func TestWriteZendeskSupportModule(t *testing.T) { // nolint:funlen,cyclop
 	responseRecordValidationError := testutils.DataFromFile(t, "record-validation.json")
 	createBrand := testutils.DataFromFile(t, "create-brand.json")
 
+	upsertUser := testutils.DataFromFile(t, "upsert-user.json")
+
 	tests := []testconn.TestCaseWrite{
 		{
 			Name:         "Write object must be included",
@@ -156,6 +158,69 @@ func TestWriteZendeskSupportModule(t *testing.T) { // nolint:funlen,cyclop
 			Expected:     &common.WriteResult{Success: true},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Tickets cannot be upserted",
+			Input: common.WriteParams{
+				ObjectName: "tickets",
+				RecordData: "dummy",
+				UpsertKey:  &common.UpsertKey{Field: "external_id", Value: "ext-1"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name: "Upsert creates a user matched by email",
+			Input: common.WriteParams{
+				ObjectName: "users",
+				RecordData: map[string]any{"user": map[string]any{"name": "Roger Wilco"}},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "roge@example.org"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/api/v2/users/create_or_update"),
+					mockcond.MethodPOST(),
+					mockcond.Body(`{"user":{"name":"Roger Wilco","email":"roge@example.org"}}`),
+				},
+				Then: mockserver.Response(http.StatusCreated, upsertUser),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "9873843",
+				Data: map[string]any{
+					"email": "roge@example.org",
+				},
+				Outcome: common.WriteOutcomeCreated,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Upsert updates a user matched by email",
+			Input: common.WriteParams{
+				ObjectName: "users",
+				RecordData: map[string]any{"user": map[string]any{"name": "Roger Wilco", "email": "roge@example.org"}},
+				UpsertKey:  &common.UpsertKey{Field: "email", Value: "roge@example.org"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/api/v2/users/create_or_update"),
+					mockcond.MethodPOST(),
+				},
+				Then: mockserver.Response(http.StatusOK, upsertUser),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "9873843",
+				Data: map[string]any{
+					"email": "roge@example.org",
+				},
+				Outcome: common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,84 @@
+package zoho
+
+import (
+	"context"
+	"maps"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+	"github.com/amp-labs/connectors/providers"
+)
+
+var _ connectors.UpsertConnector = &Connector{}
+
+// SupportsUpsert reports whether Write honors common.WriteParams.UpsertKey.
+// Zoho CRM checks duplicates by system defined unique fields, such as Email of leads and contacts,
+// or by custom fields marked as unique. Other modules have no upsert endpoint.
+func (c *Connector) SupportsUpsert(_ context.Context, objectName string, field string) (bool, error) {
+	return c.moduleID == providers.ModuleZohoCRM, nil
+}
+
+// upsertCRM inserts the record or updates the one whose field matches the upsert key.
+// https://www.zoho.com/crm/developer/docs/api/v6/upsert-records.html
+func (c *Connector) upsertCRM(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
+	record, ok := config.RecordData.(map[string]any)
+	if !ok {
+		return nil, common.ErrBadRequest
+	}
+
+	// Zoho matches the record by the values of the duplicate check fields in the payload.
+	if _, found := record[config.UpsertKey.Field]; !found {
+		record = maps.Clone(record)
+		record[config.UpsertKey.Field] = config.UpsertKey.Value
+	}
+
+	obj := naming.CapitalizeFirstLetterEveryWord(config.ObjectName)
+
+	url, err := c.getAPIURL(crmAPIVersion, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	url.AddPath("upsert")
+
+	resp, err := c.Client.Post(ctx, url.String(), map[string]any{
+		dataKey:                  []map[string]any{record},
+		"duplicate_check_fields": []string{config.UpsertKey.Field},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	node, ok := resp.Body()
+	if !ok {
+		logging.Logger(ctx).Error("failed to retrieve the upserted response data", "object", config.ObjectName)
+
+		return &common.WriteResult{Success: true}, nil
+	}
+
+	records, err := jsonquery.New(node).ArrayOptional(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id, data, err := constructResponse(records, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The action is either "insert" or "update".
+	outcome := common.WriteOutcomeUpdated
+	if action, _ := data["action"].(string); action == "insert" {
+		outcome = common.WriteOutcomeCreated
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: id,
+		Data:     data,
+		Outcome:  outcome,
+	}, nil
+}
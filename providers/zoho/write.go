@@ -50,16 +50,25 @@ const dataKey = "data"
 func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
 	ctx = logging.With(ctx, "connector", "zoho CRM", "module", c.moduleID)
 
-	if err := config.ValidateParams(); err != nil {
-		return nil, err
-	}
-
 	switch c.moduleID { // nolint: exhaustive
 	case providers.ModuleZohoDesk:
+		if err := config.ValidateParams(); err != nil {
+			return nil, err
+		}
+
 		return c.writeDesk(ctx, config)
 	case providers.ModuleZohoMail:
 		return c.mailAdapter.Write(ctx, config)
 	default:
+		// Only Zoho CRM has an upsert endpoint.
+		if err := config.ValidateUpsertParams(); err != nil {
+			return nil, err
+		}
+
+		if config.IsUpsert() {
+			return c.upsertCRM(ctx, config)
+		}
+
 		return c.writeCRM(ctx, config)
 	}
 }
@@ -17,6 +17,7 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 	unsupportedResponse := testutils.DataFromFile(t, "unsupportedread.json")
 	leadsWriteResponse := testutils.DataFromFile(t, "leads-write.json")
 	updateContactsResponse := testutils.DataFromFile(t, "updatecontact.json")
+	upsertLeadsResponse := testutils.DataFromFile(t, "upsert-leads.json")
 
 	tests := []testconn.TestCaseWrite{
 		{
@@ -116,6 +117,37 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Successfully upsert a lead by email",
+			Input: common.WriteParams{
+				ObjectName: "leads",
+				RecordData: map[string]any{
+					"Last_Name": "Daniel",
+				},
+				UpsertKey: &common.UpsertKey{Field: "Email", Value: "a.daly@zylker.com"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v6/Leads/upsert"),
+					mockcond.Body(`{"data":[{"Last_Name":"Daniel","Email":"a.daly@zylker.com"}],
+						"duplicate_check_fields":["Email"]}`),
+				},
+				Then: mockserver.Response(http.StatusOK, upsertLeadsResponse),
+			}.Server(),
+			Comparator: testconn.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "6493490000001504003",
+				Data: map[string]any{
+					"action":          "update",
+					"duplicate_field": "Email",
+				},
+				Outcome: common.WriteOutcomeUpdated,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
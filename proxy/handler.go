@@ -0,0 +1,368 @@
+// Package proxy serves an authenticated reverse proxy in front of connectors.
+//
+// Each route binds a connectors.ProxyConnector to a path prefix. Requests are forwarded to the
+// connector's proxy URL, and authentication is added by the connector's AuthenticatedHTTPClient,
+// so callers never see provider credentials.
+//
+// Usage:
+//
+//	handler, err := proxy.NewHandler([]proxy.Route{
+//		{Connector: hubspotConn, Deny: []string{"/oauth/**"}},
+//		{Module: providers.ModuleHubspotCRM, Connector: hubspotCRMConn, Allow: []string{"/objects/**"}},
+//	}, proxy.WithMaxRequestBytes(1<<20))
+//	http.ListenAndServe(":8080", handler)
+//
+// A request to /crm/objects/contacts is sent to the CRM module URL as /objects/contacts,
+// any other request goes to the provider URL of the route without a module.
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/gobwas/glob"
+)
+
+// Route exposes one connector through the proxy.
+type Route struct {
+	// Module is the first path segment under which the route is served, and it selects
+	// ProxyModuleConfig of the connector. The route without a module serves every other path
+	// using ProxyConfig.
+	Module common.ModuleID
+
+	Connector connectors.ProxyConnector
+
+	// Allow lists glob patterns of upstream paths which may be proxied, "*" matches one path segment
+	// and "**" any number of segments. When empty, every path is allowed.
+	Allow []string
+	// Deny lists glob patterns of upstream paths which are rejected, it takes precedence over Allow.
+	Deny []string
+}
+
+// Handler is an http.Handler proxying requests to the connectors of its routes.
+type Handler struct {
+	routes  map[common.ModuleID]*route
+	options *handlerOptions
+}
+
+type route struct {
+	module common.ModuleID
+	target *url.URL
+	client common.AuthenticatedHTTPClient
+	allow  []glob.Glob
+	deny   []glob.Glob
+	proxy  *httputil.ReverseProxy
+}
+
+// NewHandler creates a proxy handler. Proxy URLs are resolved once, a connector which
+// doesn't support proxying fails with common.ErrProxyNotApplicable.
+func NewHandler(routes []Route, opts ...Option) (*Handler, error) {
+	handler := &Handler{
+		routes:  make(map[common.ModuleID]*route, len(routes)),
+		options: newHandlerOptions(opts),
+	}
+
+	for _, definition := range routes {
+		if _, found := handler.routes[definition.Module]; found {
+			return nil, fmt.Errorf("%w: duplicate route for module %q", ErrInvalidRoute, definition.Module)
+		}
+
+		rt, err := newRoute(definition)
+		if err != nil {
+			return nil, err
+		}
+
+		rt.proxy = handler.newReverseProxy(rt)
+		handler.routes[definition.Module] = rt
+	}
+
+	return handler, nil
+}
+
+func newRoute(definition Route) (*route, error) {
+	if definition.Connector == nil {
+		return nil, fmt.Errorf("%w: connector is required", ErrInvalidRoute)
+	}
+
+	resolve := definition.Connector.ProxyConfig
+	if definition.Module != "" {
+		resolve = definition.Connector.ProxyModuleConfig
+	}
+
+	config, err := resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := url.Parse(config.URL)
+	if err != nil || target.Host == "" {
+		return nil, fmt.Errorf("%w: invalid URL %q", common.ErrProxyNotApplicable, config.URL)
+	}
+
+	allow, err := compilePatterns(definition.Allow)
+	if err != nil {
+		return nil, err
+	}
+
+	deny, err := compilePatterns(definition.Deny)
+	if err != nil {
+		return nil, err
+	}
+
+	return &route{
+		module: definition.Module,
+		target: target,
+		client: definition.Connector.HTTPClient().Client,
+		allow:  allow,
+		deny:   deny,
+	}, nil
+}
+
+func compilePatterns(patterns []string) ([]glob.Glob, error) {
+	compiled := make([]glob.Glob, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		matcher, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, fmt.Errorf("%w: pattern %q: %w", ErrInvalidRoute, pattern, err)
+		}
+
+		compiled = append(compiled, matcher)
+	}
+
+	return compiled, nil
+}
+
+// permits reports whether the upstream path may be proxied.
+func (r *route) permits(path string) bool {
+	for _, matcher := range r.deny {
+		if matcher.Match(path) {
+			return false
+		}
+	}
+
+	if len(r.allow) == 0 {
+		return true
+	}
+
+	for _, matcher := range r.allow {
+		if matcher.Match(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cleanPath resolves dot segments of the escaped path, so that routes and allow lists are matched
+// against the path which is forwarded. Escaped slashes stay within their segment, which is invalid
+// when it would traverse upwards once the provider decodes it.
+func cleanPath(escaped string) (string, bool) {
+	segments := make([]string, 0)
+
+	for segment := range strings.SplitSeq(strings.TrimPrefix(escaped, "/"), "/") {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return escaped, false
+		}
+
+		switch decoded {
+		case "", ".":
+			continue
+		case "..":
+			if len(segments) != 0 {
+				segments = segments[:len(segments)-1]
+			}
+
+			continue
+		}
+
+		if slices.Contains(strings.Split(decoded, "/"), "..") {
+			return escaped, false
+		}
+
+		segments = append(segments, segment)
+	}
+
+	cleaned := "/" + strings.Join(segments, "/")
+	if strings.HasSuffix(escaped, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+
+	return cleaned, true
+}
+
+// match finds the route of the request and the path relative to it.
+func (h *Handler) match(path string) (*route, string, bool) {
+	segment, rest, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+
+	if segment != "" {
+		if rt, found := h.routes[common.ModuleID(segment)]; found {
+			return rt, "/" + rest, true
+		}
+	}
+
+	rt, found := h.routes[""]
+
+	return rt, path, found
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	start := time.Now()
+	recorder := &statusRecorder{ResponseWriter: w}
+
+	escapedPath, valid := cleanPath(req.URL.EscapedPath())
+
+	rt, escapedPath, found := h.match(escapedPath)
+
+	path, err := url.PathUnescape(escapedPath)
+	if err != nil {
+		path = escapedPath
+	}
+
+	switch {
+	case !valid:
+		http.Error(recorder, "invalid path", http.StatusBadRequest)
+	case !found:
+		http.Error(recorder, "no route for path", http.StatusNotFound)
+	case !rt.permits(path):
+		http.Error(recorder, "path is not allowed", http.StatusForbidden)
+	case h.options.maxRequestBytes > 0 && req.ContentLength > h.options.maxRequestBytes:
+		http.Error(recorder, "request body too large", http.StatusRequestEntityTooLarge)
+	default:
+		if h.options.maxRequestBytes > 0 && req.Body != nil {
+			req.Body = http.MaxBytesReader(recorder, req.Body, h.options.maxRequestBytes)
+		}
+
+		out := req.Clone(req.Context())
+		out.URL.Path = path
+		out.URL.RawPath = escapedPath
+
+		rt.proxy.ServeHTTP(recorder, out)
+	}
+
+	h.audit(req, rt, path, recorder, time.Since(start))
+}
+
+func (h *Handler) newReverseProxy(rt *route) *httputil.ReverseProxy {
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(rt.target)
+			pr.Out.Host = rt.target.Host
+			// The connector's client is an http.Client, which rejects server side request fields.
+			pr.Out.RequestURI = ""
+
+			// The connector authenticates the request, credentials of the caller must not reach the provider.
+			for _, name := range h.options.strippedRequestHeaders {
+				pr.Out.Header.Del(name)
+			}
+		},
+		Transport: roundTripper{client: rt.client},
+		// Negative value flushes after each write, so that streamed responses are not buffered.
+		FlushInterval: -1,
+		ModifyResponse: func(rsp *http.Response) error {
+			for _, name := range h.options.redactedResponseHeaders {
+				rsp.Header.Del(name)
+			}
+
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			if maxBytesErr := new(http.MaxBytesError); errors.As(err, &maxBytesErr) {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+
+				return
+			}
+
+			logging.Logger(req.Context()).Error("proxy request failed",
+				"module", rt.module, "path", req.URL.Path, "error", err)
+
+			http.Error(w, "bad gateway", http.StatusBadGateway)
+		},
+	}
+}
+
+// audit logs every request, including rejected ones. Query strings and headers are left out
+// as they may carry sensitive values.
+func (h *Handler) audit(req *http.Request, rt *route, path string, recorder *statusRecorder, elapsed time.Duration) {
+	if !h.options.audit {
+		return
+	}
+
+	values := []any{
+		"method", req.Method,
+		"path", path,
+		"status", recorder.Status(),
+		"responseBytes", recorder.written,
+		"duration", elapsed,
+		"remoteAddr", req.RemoteAddr,
+	}
+
+	if rt != nil {
+		values = append(values, "module", rt.module, "host", rt.target.Host)
+	}
+
+	logging.Logger(req.Context()).Info("proxy request", values...)
+}
+
+type roundTripper struct {
+	client common.AuthenticatedHTTPClient
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.client.Do(req)
+}
+
+// statusRecorder captures the status and size of the response for audit logs.
+// Unwrap lets http.ResponseController reach the flusher of the underlying writer.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status  int
+	written int64
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+
+	n, err := r.ResponseWriter.Write(data)
+	r.written += int64(n)
+
+	return n, err
+}
+
+func (r *statusRecorder) Flush() {
+	_ = http.NewResponseController(r.ResponseWriter).Flush()
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Status returns the response status, http.StatusOK if nothing was written.
+func (r *statusRecorder) Status() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+
+	return r.status
+}
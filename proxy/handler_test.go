@@ -0,0 +1,202 @@
+// nolint
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConnector struct {
+	url       string
+	moduleURL string
+	client    common.AuthenticatedHTTPClient
+}
+
+func (c testConnector) String() string                         { return "test" }
+func (c testConnector) Provider() providers.Provider           { return "test" }
+func (c testConnector) JSONHTTPClient() *common.JSONHTTPClient { return nil }
+func (c testConnector) HTTPClient() *common.HTTPClient {
+	return &common.HTTPClient{Client: c.client}
+}
+
+func (c testConnector) ProxyConfig() (*connectors.ProxyConfig, error) {
+	return &connectors.ProxyConfig{URL: c.url}, nil
+}
+
+func (c testConnector) ProxyModuleConfig() (*connectors.ProxyConfig, error) {
+	return &connectors.ProxyConfig{URL: c.moduleURL}, nil
+}
+
+// bearerClient stands in for a connector's authenticated client.
+type bearerClient struct{}
+
+func (bearerClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer provider-token")
+
+	return http.DefaultClient.Do(req)
+}
+
+func (bearerClient) CloseIdleConnections() {}
+
+func newTestHandler(t *testing.T, opts ...Option) *Handler {
+	t.Helper()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("X-Internal", "value")
+		_, _ = io.WriteString(w, strings.Join([]string{
+			r.URL.EscapedPath(), r.Header.Get("Authorization"), r.Header.Get("Cookie"), string(body),
+		}, "|"))
+	}))
+	t.Cleanup(upstream.Close)
+
+	conn := testConnector{url: upstream.URL + "/api", moduleURL: upstream.URL + "/crm/v3", client: bearerClient{}}
+
+	handler, err := NewHandler([]Route{
+		{Connector: conn, Deny: []string{"/admin/**"}},
+		{Module: "crm", Connector: conn, Allow: []string{"/objects/*"}},
+	}, opts...)
+	require.NoError(t, err)
+
+	return handler
+}
+
+func TestHandlerRouting(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestHandler(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		body   string
+	}{
+		{name: "Provider route", path: "/users/1", status: http.StatusOK, body: "/api/users/1|Bearer provider-token||"},
+		{name: "Module route", path: "/crm/objects/contacts", status: http.StatusOK, body: "/crm/v3/objects/contacts|Bearer provider-token||"},
+		{name: "Denied path", path: "/admin/keys", status: http.StatusForbidden},
+		{name: "Path outside allow list", path: "/crm/owners", status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer caller-token")
+			req.Header.Set("Cookie", "caller=1")
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.body, rec.Body.String())
+				assert.Empty(t, rec.Header().Get("Set-Cookie"))
+				assert.Equal(t, "value", rec.Header().Get("X-Internal"))
+			}
+		})
+	}
+}
+
+func TestHandlerPathTraversal(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestHandler(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		body   string
+	}{
+		{name: "Escape from module allow list", path: "/crm/objects/../owners", status: http.StatusForbidden},
+		{name: "Escape into denied provider path", path: "/crm/objects/../../admin/keys", status: http.StatusForbidden},
+		{name: "Encoded escape into denied path", path: "/users/%2e%2e/admin/keys", status: http.StatusForbidden},
+		{name: "Dot segments are resolved", path: "/crm/objects/./contacts", status: http.StatusOK,
+			body: "/crm/v3/objects/contacts|Bearer provider-token||"},
+		{name: "Dots within a segment are kept", path: "/users/1..2", status: http.StatusOK,
+			body: "/api/users/1..2|Bearer provider-token||"},
+		{name: "Escaped slashes are forwarded escaped", path: "/files/a%2Fb", status: http.StatusOK,
+			body: "/api/files/a%2Fb|Bearer provider-token||"},
+		{name: "Escaped traversal within a segment is rejected", path: "/users/a%2F..%2Fadmin",
+			status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.status, rec.Code)
+
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandlerRedactsResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestHandler(t, WithRedactedResponseHeaders("x-internal"))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("X-Internal"))
+}
+
+func TestHandlerRequestSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestHandler(t, WithMaxRequestBytes(4))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("small")))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// Without Content-Length the limit is enforced while streaming the body.
+	req := httptest.NewRequest(http.MethodPost, "/users", io.NopCloser(strings.NewReader("streamed body")))
+	req.ContentLength = -1
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("tiny")))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/api/users|Bearer provider-token||tiny", rec.Body.String())
+}
+
+func TestNewHandlerInvalidRoutes(t *testing.T) {
+	t.Parallel()
+
+	conn := testConnector{url: "https://example.com", moduleURL: "https://example.com", client: bearerClient{}}
+
+	_, err := NewHandler([]Route{{Connector: conn}, {Connector: conn}})
+	require.ErrorIs(t, err, ErrInvalidRoute)
+
+	_, err = NewHandler([]Route{{Connector: conn, Allow: []string{"[unterminated"}}})
+	require.ErrorIs(t, err, ErrInvalidRoute)
+
+	_, err = NewHandler([]Route{{Connector: testConnector{url: "not a url"}}})
+	require.ErrorIs(t, err, common.ErrProxyNotApplicable)
+}
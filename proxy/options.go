@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrInvalidRoute is returned by NewHandler for misconfigured routes.
+var ErrInvalidRoute = errors.New("invalid proxy route")
+
+// defaultMaxRequestBytes limits request bodies unless WithMaxRequestBytes is given.
+const defaultMaxRequestBytes = 10 << 20
+
+// Option configures the Handler.
+type Option func(*handlerOptions)
+
+type handlerOptions struct {
+	maxRequestBytes         int64
+	strippedRequestHeaders  []string
+	redactedResponseHeaders []string
+	audit                   bool
+}
+
+func newHandlerOptions(opts []Option) *handlerOptions {
+	options := &handlerOptions{
+		maxRequestBytes: defaultMaxRequestBytes,
+		strippedRequestHeaders: []string{
+			"Authorization",
+			"Proxy-Authorization",
+			"Cookie",
+		},
+		redactedResponseHeaders: []string{
+			"Set-Cookie",
+		},
+		audit: true,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	for i, name := range options.strippedRequestHeaders {
+		options.strippedRequestHeaders[i] = http.CanonicalHeaderKey(name)
+	}
+
+	for i, name := range options.redactedResponseHeaders {
+		options.redactedResponseHeaders[i] = http.CanonicalHeaderKey(name)
+	}
+
+	return options
+}
+
+// WithMaxRequestBytes limits the size of request bodies, larger requests fail with 413.
+// Zero or a negative value disables the limit. The default is 10 MiB.
+func WithMaxRequestBytes(limit int64) Option {
+	return func(options *handlerOptions) {
+		options.maxRequestBytes = limit
+	}
+}
+
+// WithStrippedRequestHeaders removes additional caller headers before the request is forwarded.
+// Authorization, Proxy-Authorization and Cookie are always removed.
+func WithStrippedRequestHeaders(names ...string) Option {
+	return func(options *handlerOptions) {
+		options.strippedRequestHeaders = append(options.strippedRequestHeaders, names...)
+	}
+}
+
+// WithRedactedResponseHeaders removes additional provider headers from responses.
+// Set-Cookie is always removed.
+func WithRedactedResponseHeaders(names ...string) Option {
+	return func(options *handlerOptions) {
+		options.redactedResponseHeaders = append(options.redactedResponseHeaders, names...)
+	}
+}
+
+// WithAuditLog enables or disables the audit log entry written for each request.
+// Entries go to logging.Logger of the request context and are enabled by default.
+func WithAuditLog(enabled bool) Option {
+	return func(options *handlerOptions) {
+		options.audit = enabled
+	}
+}
@@ -0,0 +1,57 @@
+// Encrypts and decrypts credential files read by credscanning.
+//
+//	CREDS_PASSPHRASE=... go run ./scripts/creds encrypt hubspot-creds.json
+//	CREDS_KEY_FILE=key.txt go run ./scripts/creds decrypt hubspot-creds.json.enc
+//
+// Encryption writes <file>.enc next to the plaintext file, which can then be deleted.
+// Decryption prints the plaintext to stdout.
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/scanning"
+	"github.com/amp-labs/connectors/common/scanning/credscanning"
+)
+
+func main() {
+	if len(os.Args) != 3 { // nolint:mnd
+		log.Fatal("usage: creds encrypt|decrypt <file>")
+	}
+
+	command, filePath := os.Args[1], os.Args[2]
+	key := credscanning.EncryptionKeyFromENV()
+
+	if key.Passphrase == "" && key.KeyFile == "" {
+		log.Fatal("set CREDS_PASSPHRASE or CREDS_KEY_FILE")
+	}
+
+	switch command {
+	case "encrypt":
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = scanning.EncryptFile(filePath+credscanning.EncryptedFileExtension, data, key); err != nil {
+			log.Fatal(err)
+		}
+	case "decrypt":
+		if !strings.HasSuffix(filePath, credscanning.EncryptedFileExtension) {
+			log.Fatalf("expected a %s file", credscanning.EncryptedFileExtension)
+		}
+
+		data, err := scanning.DecryptFile(filePath, key)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if _, err = os.Stdout.Write(data); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("unknown command %q", command)
+	}
+}
@@ -77,12 +77,17 @@ var readers = []scanning.Reader{
 		KeyName:  MetadataFieldName,
 	},
 	credscanning.Fields.Provider.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ClientId.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ClientSecret.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Scopes.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.State.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Username.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Password.GetJSONReader(DefaultCredsFile),
+}
+
+// secretFields are read from the creds file, or from the keyring or command
+// configured by the CREDS_KEYRING and CREDS_COMMAND environment variables.
+var secretFields = []credscanning.Field{
+	credscanning.Fields.ClientId,
+	credscanning.Fields.ClientSecret,
+	credscanning.Fields.Scopes,
+	credscanning.Fields.State,
+	credscanning.Fields.Username,
+	credscanning.Fields.Password,
 }
 
 // OAuthApp is a simple OAuth app that can be used to get an OAuth token.
@@ -297,6 +302,13 @@ func setup() *OAuthApp {
 
 	provider := registry.MustString(credscanning.Fields.Provider.Name)
 
+	secretSource := credscanning.WithSecretSourcesFromENV(credscanning.JSONFileSource{FilePath: DefaultCredsFile})
+	for _, field := range secretFields {
+		if err := registry.AddReader(secretSource.Reader(field, provider)); err != nil {
+			return nil
+		}
+	}
+
 	providerInfo, err := providers.ReadInfo(provider, paramsbuilder.NewCatalogVariables(metadata)...)
 	if err != nil {
 		slog.Error("failed to read provider config", "error", err)
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/amp-labs/connectors/common/paramsbuilder"
 	"github.com/amp-labs/connectors/common/scanning"
@@ -53,31 +54,25 @@ const (
 
 var registry = scanning.NewRegistry()
 
-var readers = []scanning.Reader{
-	&scanning.JSONReader{
-		FilePath: DefaultCredsFile,
-		JSONPath: "$['metadata']",
-		KeyName:  MetadataFieldName,
-	},
-	&scanning.JSONReader{
-		FilePath: DefaultCredsFile,
-		JSONPath: "$['secrets']",
-		KeyName:  SecretsFieldName,
-	},
-	credscanning.Fields.Provider.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ClientId.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ClientSecret.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Scopes.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.AccessToken.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.RefreshToken.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Expiry.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ExpiryFormat.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ApiKey.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Username.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Password.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.ApiSecret.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Token.GetJSONReader(DefaultCredsFile),
-	credscanning.Fields.Module.GetJSONReader(DefaultCredsFile),
+// Credentials may also be kept encrypted in creds.json.enc, unlocked by
+// the CREDS_PASSPHRASE or CREDS_KEY_FILE environment variable, or as variables in creds.env.
+// Secrets missing from the file are read from the CREDS_KEYRING or CREDS_COMMAND sources.
+var readerFields = []credscanning.Field{
+	{Name: MetadataFieldName, PathJSON: "metadata"},
+	{Name: SecretsFieldName, PathJSON: "secrets"},
+	credscanning.Fields.ClientId,
+	credscanning.Fields.ClientSecret,
+	credscanning.Fields.Scopes,
+	credscanning.Fields.AccessToken,
+	credscanning.Fields.RefreshToken,
+	credscanning.Fields.Expiry,
+	credscanning.Fields.ExpiryFormat,
+	credscanning.Fields.ApiKey,
+	credscanning.Fields.Username,
+	credscanning.Fields.Password,
+	credscanning.Fields.ApiSecret,
+	credscanning.Fields.Token,
+	credscanning.Fields.Module,
 }
 
 var debug = flag.Bool("debug", false, "Enable debug logging")
@@ -85,13 +80,24 @@ var debug = flag.Bool("debug", false, "Enable debug logging")
 func main() {
 	flag.Parse()
 
-	err := registry.AddReaders(readers...)
-	if err != nil {
+	credsFile := credsFilePath()
+	source := credscanning.NewFileSource(credsFile)
+
+	if err := registry.AddReader(source.Reader(credscanning.Fields.Provider, "")); err != nil {
 		panic(err)
 	}
 
 	provider := registry.MustString(credscanning.Fields.Provider.Name)
 
+	// Secrets missing from the file may come from the keyring or command configured by the environment.
+	secretSource := credscanning.WithSecretSourcesFromENV(source)
+
+	for _, field := range readerFields {
+		if err := registry.AddReader(secretSource.Reader(field, provider)); err != nil {
+			panic(err)
+		}
+	}
+
 	metadata, err := registry.GetMap(MetadataFieldName)
 	if err != nil {
 		slog.Warn("no connector metadata, ensure that the provider info doesn't have any {{variables}}")
@@ -127,7 +133,7 @@ func main() {
 		CatalogVariables: catalogVariables,
 		Debug:            *debug,
 		Registry:         registry,
-		CredsFilePath:    DefaultCredsFile,
+		CredsFilePath:    credsFile,
 		Metadata:         metadataMap.ConvertStrMap(),
 		Secrets:          secretsMap.ConvertStrMap(),
 	})
@@ -135,6 +141,26 @@ func main() {
 	proxy.Start(ctx, DefaultPort)
 }
 
+// credsFilePath falls back to the encrypted credentials file, or to the .env file, when there is no plaintext one.
+func credsFilePath() string {
+	if _, err := os.Stat(DefaultCredsFile); err == nil {
+		return DefaultCredsFile
+	}
+
+	alternatives := []string{
+		DefaultCredsFile + credscanning.EncryptedFileExtension,
+		strings.TrimSuffix(DefaultCredsFile, ".json") + credscanning.DotEnvFileExtension,
+	}
+
+	for _, alternative := range alternatives {
+		if _, err := os.Stat(alternative); err == nil {
+			return alternative
+		}
+	}
+
+	return DefaultCredsFile
+}
+
 func createProviderProxy(
 	ctx context.Context, info *providers.ProviderInfo, factory proxyserv.Factory,
 ) *proxyserv.Proxy {
@@ -162,6 +188,8 @@ func createProviderProxy(
 		return factory.CreateProxyBasic(ctx)
 	case providers.Custom:
 		return factory.CreateProxyCustom(ctx)
+	case providers.AwsSigV4:
+		return factory.CreateProxyAwsSigV4(ctx)
 	default:
 		log.Fatalf("Unsupported auth type: %s", info.AuthType)
 	}
@@ -0,0 +1,62 @@
+package proxyserv
+
+import (
+	"context"
+	"log"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/scanning"
+	"github.com/amp-labs/connectors/common/scanning/credscanning"
+	"github.com/amp-labs/connectors/generic"
+	"github.com/amp-labs/connectors/providers"
+)
+
+func (f Factory) CreateProxyAwsSigV4(ctx context.Context) *Proxy {
+	params := createAwsSigV4Params(f.Registry)
+	providerInfo := getProviderConfig(f.Provider, f.CatalogVariables)
+	httpClient := setupAwsSigV4HTTPClient(ctx, providerInfo, params, f.Metadata)
+	baseURL := f.getBaseURL()
+
+	return newProxy(baseURL, httpClient)
+}
+
+// createAwsSigV4Params reads the access key ID as username and the secret access key as password.
+// The token, when present, is the session token of temporary credentials.
+func createAwsSigV4Params(registry scanning.Registry) *providers.AwsSigV4Params {
+	accessKeyID := registry.MustString(credscanning.Fields.Username.Name)
+	secretAccessKey := registry.MustString(credscanning.Fields.Password.Name)
+
+	if len(accessKeyID) == 0 || len(secretAccessKey) == 0 {
+		log.Fatalf("Missing AWS access key ID or secret access key")
+	}
+
+	sessionToken, _ := registry.GetString(credscanning.Fields.Token.Name)
+
+	return &providers.AwsSigV4Params{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+	}
+}
+
+func setupAwsSigV4HTTPClient(
+	ctx context.Context, prov *providers.ProviderInfo, creds *providers.AwsSigV4Params,
+	metadata map[string]string,
+) common.AuthenticatedHTTPClient {
+	client, err := prov.NewClient(ctx, &providers.NewClientParams{
+		AwsSigV4Creds: creds,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	cc, err := generic.NewConnector(prov.Name,
+		generic.WithAuthenticatedClient(client),
+		generic.WithMetadata(metadata),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return cc.HTTPClient().Client
+}
@@ -0,0 +1,37 @@
+// Package testcommonmodel holds checks shared by the tests of common models.
+package testcommonmodel
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/commonmodel"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertMappingsReferenceCanonicalFields checks that every mapping references canonical objects and fields,
+// maps the id of every object, and that every provider is in the catalog.
+func AssertMappingsReferenceCanonicalFields(t *testing.T, model *commonmodel.Model) {
+	t.Helper()
+
+	for provider, mapping := range model.Mappings {
+		_, err := providers.ReadInfo(provider)
+		require.NoError(t, err, provider)
+
+		for objectName, object := range mapping {
+			definition, ok := model.Object(objectName)
+			require.True(t, ok, "%s: unknown object %s", provider, objectName)
+			assert.NotEmpty(t, object.ObjectName, "%s: %s", provider, objectName)
+
+			_, ok = object.Fields["id"]
+			assert.True(t, ok, "%s: %s must map id", provider, objectName)
+
+			for fieldName, field := range object.Fields {
+				_, ok := definition.Field(fieldName)
+				assert.True(t, ok, "%s: %s has unknown field %s", provider, objectName, fieldName)
+				assert.NotEmpty(t, field.Field, "%s: %s.%s", provider, objectName, fieldName)
+			}
+		}
+	}
+}
@@ -169,6 +169,7 @@ func ComparatorSubsetWrite(_ string, actual, expected *common.WriteResult) *test
 	result := testutils.NewCompareResult()
 	result.Assert("Success", expected.Success, actual.Success)
 	result.Assert("RecordId", expected.RecordId, actual.RecordId)
+	result.Assert("Outcome", expected.Outcome, actual.Outcome)
 	result.Merge(mockutils.WriteResultComparator.SubsetData(actual, expected))
 	result.Merge(mockutils.ErrorNormalizedComparator.EachErrorEquals(actual.Errors, expected.Errors))
 
@@ -59,6 +59,11 @@ type TestableDeleter interface {
 	Delete(ctx context.Context, params common.DeleteParams) (*common.DeleteResult, error)
 }
 
+// TestableMerger is the minimal interface for a connector that can merge records.
+type TestableMerger interface {
+	Merge(ctx context.Context, params common.MergeParams) (*common.MergeResult, error)
+}
+
 // TestableSearcher is the minimal interface for a connector that can search records.
 type TestableSearcher interface {
 	Search(ctx context.Context, params *common.SearchParams) (*common.SearchResult, error)
@@ -0,0 +1,31 @@
+package testconn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+type (
+	mergeType = TestCase[common.MergeParams, *common.MergeResult]
+	// TestCaseMerge is a test suite useful for testing connectors.MergeConnector interface.
+	TestCaseMerge mergeType
+)
+
+// Run provides a procedure to test connectors.MergeConnector.
+func (m TestCaseMerge) Run(t *testing.T, builder ConnectorBuilder[TestableMerger]) {
+	m.RunWithContext(t, t.Context(), builder)
+}
+
+// RunWithContext provides a procedure to test connectors.MergeConnector.
+func (m TestCaseMerge) RunWithContext(t *testing.T, ctx context.Context, builder ConnectorBuilder[TestableMerger]) {
+	t.Helper()
+	t.Cleanup(func() {
+		mergeType(m).Close()
+	})
+
+	conn := builder.Build(t, m.Name)
+	output, err := conn.Merge(ctx, mergeType(m).PrepareInput())
+	mergeType(m).Validate(t, err, output)
+}
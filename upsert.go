@@ -0,0 +1,108 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Upsert writes the record matched by params.UpsertKey, or creates one when no record matches.
+// The result's Outcome tells which of the two happened.
+//
+// Connectors implementing UpsertConnector use the provider's upsert endpoint.
+// Otherwise, the record is looked up via SearchConnector and then updated or created.
+// Unlike native upserts the lookup is not atomic, concurrent upserts of the same key may create duplicates.
+// Returns common.ErrOperationNotSupportedForObject when neither is available.
+func Upsert(ctx context.Context, conn WriteConnector, params common.WriteParams) (*common.WriteResult, error) {
+	if params.UpsertKey == nil {
+		return nil, common.ErrMissingUpsertKey
+	}
+
+	if err := params.ValidateUpsertParams(); err != nil {
+		return nil, err
+	}
+
+	if upserter, ok := conn.(UpsertConnector); ok {
+		supported, err := upserter.SupportsUpsert(ctx, params.ObjectName, params.UpsertKey.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		if supported {
+			return upserter.Write(ctx, params)
+		}
+	}
+
+	searcher, ok := conn.(SearchConnector)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s cannot upsert %s by %s", common.ErrOperationNotSupportedForObject,
+			conn.Provider(), params.ObjectName, params.UpsertKey.Field)
+	}
+
+	return upsertWithSearch(ctx, conn, searcher, params)
+}
+
+func upsertWithSearch(
+	ctx context.Context, writer WriteConnector, searcher SearchConnector, params common.WriteParams,
+) (*common.WriteResult, error) {
+	key := params.UpsertKey
+
+	matches, err := searcher.Search(ctx, &common.SearchParams{
+		ObjectName: params.ObjectName,
+		Fields:     Fields(key.Field),
+		Filter: common.SearchFilter{
+			FieldFilters: []common.FieldFilter{{
+				FieldName: key.Field,
+				Operator:  common.FilterOperatorEQ,
+				Value:     key.Value,
+			}},
+		},
+		// Limit is left unset, as not every connector lets the page size be controlled.
+		// The first page is enough to tell that the key is not unique.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matches.Data) > 1 {
+		return nil, fmt.Errorf("%w: %s=%v", common.ErrUpsertKeyNotUnique, key.Field, key.Value)
+	}
+
+	write := params
+	write.UpsertKey = nil
+	outcome := common.WriteOutcomeCreated
+
+	if len(matches.Data) == 1 {
+		// Without the id the write would create a duplicate instead of updating the match.
+		if matches.Data[0].Id == "" {
+			return nil, fmt.Errorf("%w: record matched by %s=%v has no id",
+				common.ErrMissingExpectedValues, key.Field, key.Value)
+		}
+
+		write.RecordId = matches.Data[0].Id
+		outcome = common.WriteOutcomeUpdated
+	} else {
+		// A created record must carry the key, so that the next upsert finds it.
+		record, err := params.GetRecord()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := record[key.Field]; !found {
+			record = maps.Clone(record)
+			record[key.Field] = key.Value
+			write.RecordData = record
+		}
+	}
+
+	result, err := writer.Write(ctx, write)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Outcome = outcome
+
+	return result, nil
+}
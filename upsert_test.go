@@ -0,0 +1,231 @@
+// nolint
+package connectors_test
+
+import (
+	"cmp"
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upsertingConnector upserts natively when supported says so.
+type upsertingConnector struct {
+	*mock.Connector
+
+	supported bool
+	written   *common.WriteParams
+}
+
+func (c *upsertingConnector) SupportsUpsert(context.Context, string, string) (bool, error) {
+	return c.supported, nil
+}
+
+func (c *upsertingConnector) Write(_ context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	if err := params.ValidateUpsertParams(); err != nil {
+		return nil, err
+	}
+
+	c.written = &params
+
+	return &common.WriteResult{Success: true, RecordId: "7", Outcome: common.WriteOutcomeUpdated}, nil
+}
+
+// searchingConnector finds the given rows for every search.
+type searchingConnector struct {
+	*mock.Connector
+
+	rows     []common.ReadResultRow
+	searched *common.SearchParams
+}
+
+func (c *searchingConnector) Search(_ context.Context, params *common.SearchParams) (*common.SearchResult, error) {
+	c.searched = params
+
+	return &common.SearchResult{Rows: int64(len(c.rows)), Data: c.rows, Done: true}, nil
+}
+
+// searchingNonUpsertingConnector can search, but cannot upsert natively.
+type searchingNonUpsertingConnector struct {
+	*searchingConnector
+}
+
+func (c *searchingNonUpsertingConnector) SupportsUpsert(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+// recordingWriter remembers the write params and reports the written record id.
+func recordingWriter(t *testing.T, written **common.WriteParams) mock.Option {
+	t.Helper()
+
+	return mock.WithWrite(func(_ context.Context, params common.WriteParams) (*common.WriteResult, error) {
+		*written = &params
+
+		return &common.WriteResult{Success: true, RecordId: cmp.Or(params.RecordId, "new")}, nil
+	})
+}
+
+func newMockConnector(t *testing.T, opts ...mock.Option) *mock.Connector {
+	t.Helper()
+
+	conn, err := mock.NewConnector(opts...)
+	require.NoError(t, err)
+
+	return conn
+}
+
+func upsertParams() common.WriteParams {
+	return common.WriteParams{
+		ObjectName: "contacts",
+		RecordData: map[string]any{"name": "Jon Snow"},
+		UpsertKey:  &common.UpsertKey{Field: "email", Value: "jon@winterfell.com"},
+	}
+}
+
+func TestUpsertRequiresKey(t *testing.T) {
+	t.Parallel()
+
+	params := upsertParams()
+	params.UpsertKey = nil
+
+	_, err := connectors.Upsert(t.Context(), newMockConnector(t), params)
+	require.ErrorIs(t, err, common.ErrMissingUpsertKey)
+}
+
+func TestUpsertNative(t *testing.T) {
+	t.Parallel()
+
+	conn := &upsertingConnector{Connector: newMockConnector(t), supported: true}
+
+	result, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.NoError(t, err)
+	assert.Equal(t, common.WriteOutcomeUpdated, result.Outcome)
+
+	require.NotNil(t, conn.written)
+	assert.Equal(t, upsertParams().UpsertKey, conn.written.UpsertKey, "native upsert receives the key")
+	assert.Empty(t, conn.written.RecordId)
+}
+
+func TestUpsertSearchUpdatesMatch(t *testing.T) {
+	t.Parallel()
+
+	var written *common.WriteParams
+
+	conn := &searchingConnector{
+		Connector: newMockConnector(t, recordingWriter(t, &written)),
+		rows:      []common.ReadResultRow{{Id: "7", Fields: map[string]any{"email": "jon@winterfell.com"}}},
+	}
+
+	result, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.NoError(t, err)
+	assert.Equal(t, common.WriteOutcomeUpdated, result.Outcome)
+	assert.Equal(t, "7", result.RecordId)
+
+	require.NotNil(t, conn.searched)
+	assert.Equal(t, []common.FieldFilter{{
+		FieldName: "email",
+		Operator:  common.FilterOperatorEQ,
+		Value:     "jon@winterfell.com",
+	}}, conn.searched.Filter.FieldFilters)
+
+	require.NotNil(t, written)
+	assert.Nil(t, written.UpsertKey)
+	assert.Equal(t, "7", written.RecordId)
+	assert.Equal(t, map[string]any{"name": "Jon Snow"}, written.RecordData)
+}
+
+func TestUpsertSearchCreatesRecordWithKey(t *testing.T) {
+	t.Parallel()
+
+	var written *common.WriteParams
+
+	conn := &searchingConnector{Connector: newMockConnector(t, recordingWriter(t, &written))}
+
+	result, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.NoError(t, err)
+	assert.Equal(t, common.WriteOutcomeCreated, result.Outcome)
+	assert.Equal(t, "new", result.RecordId)
+
+	require.NotNil(t, written)
+	assert.Empty(t, written.RecordId)
+	assert.EqualValues(t, map[string]any{"name": "Jon Snow", "email": "jon@winterfell.com"}, written.RecordData)
+}
+
+func TestUpsertSearchWhenNativeIsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	var written *common.WriteParams
+
+	conn := &searchingNonUpsertingConnector{&searchingConnector{
+		Connector: newMockConnector(t, recordingWriter(t, &written)),
+		rows:      []common.ReadResultRow{{Id: "7"}},
+	}}
+
+	result, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.NoError(t, err)
+	assert.Equal(t, common.WriteOutcomeUpdated, result.Outcome)
+
+	require.NotNil(t, written)
+	assert.Nil(t, written.UpsertKey, "key must not reach a connector that cannot upsert")
+}
+
+func TestUpsertSearchKeyNotUnique(t *testing.T) {
+	t.Parallel()
+
+	var written *common.WriteParams
+
+	conn := &searchingConnector{
+		Connector: newMockConnector(t, recordingWriter(t, &written)),
+		rows:      []common.ReadResultRow{{Id: "7"}, {Id: "8"}},
+	}
+
+	_, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.ErrorIs(t, err, common.ErrUpsertKeyNotUnique)
+	assert.Nil(t, written, "nothing must be written")
+}
+
+func TestUpsertSearchMatchWithoutID(t *testing.T) {
+	t.Parallel()
+
+	var written *common.WriteParams
+
+	conn := &searchingConnector{
+		Connector: newMockConnector(t, recordingWriter(t, &written)),
+		rows:      []common.ReadResultRow{{Fields: map[string]any{"email": "jon@winterfell.com"}}},
+	}
+
+	_, err := connectors.Upsert(t.Context(), conn, upsertParams())
+	require.ErrorIs(t, err, common.ErrMissingExpectedValues)
+	assert.Nil(t, written, "a duplicate must not be created")
+}
+
+func TestUpsertUnsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		conn connectors.WriteConnector
+	}{
+		{
+			name: "Connector can neither upsert nor search",
+			conn: newMockConnector(t),
+		},
+		{
+			name: "Connector cannot upsert the key and cannot search",
+			conn: &upsertingConnector{Connector: newMockConnector(t), supported: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := connectors.Upsert(t.Context(), tt.conn, upsertParams())
+			require.ErrorIs(t, err, common.ErrOperationNotSupportedForObject)
+		})
+	}
+}